@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/can3p/gogo/util/transact"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq" // postgres db driver
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const megabyte = 1024 * 1024
+
+func main() { //nolint:typecheck
+	db := sqlx.MustConnect("postgres", os.Getenv("DATABASE_URL")+"?sslmode=disable")
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.Printf("Error closing database: %v", err)
+		}
+	}()
+
+	username := flag.String("username", "", "user to set the quota for, default quota is changed if empty")
+	quotaMB := flag.Int64("mb", -1, "quota in megabytes, negative value resets user quota to the default one")
+
+	flag.Parse()
+
+	ctx := context.Background()
+
+	err := transact.Transact(db, func(tx *sql.Tx) error {
+		if *username == "" {
+			if *quotaMB < 0 {
+				log.Fatalf("default quota cannot be negative")
+			}
+
+			settings, err := core.SystemSettings().One(ctx, tx)
+
+			if err != nil {
+				return err
+			}
+
+			settings.DefaultMediaQuotaBytes = *quotaMB * megabyte
+			_, err = settings.Update(ctx, tx, boil.Whitelist(core.SystemSettingColumns.DefaultMediaQuotaBytes))
+
+			return err
+		}
+
+		user, err := core.Users(
+			core.UserWhere.Username.EQ(*username),
+		).One(ctx, tx)
+
+		if err != nil {
+			return err
+		}
+
+		if *quotaMB < 0 {
+			user.MediaQuotaBytes = null.Int64{}
+		} else {
+			user.MediaQuotaBytes = null.Int64From(*quotaMB * megabyte)
+		}
+
+		_, err = user.Update(ctx, tx, boil.Whitelist(core.UserColumns.MediaQuotaBytes))
+
+		return err
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
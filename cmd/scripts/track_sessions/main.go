@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/can3p/pcom/pkg/auth"
+	"github.com/can3p/pcom/pkg/pgsession"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq" // postgres db driver
+)
+
+// the sessions are ended through their records only, the ones the users
+// have logged in with before the records were kept need them as well
+func main() { //nolint:typecheck
+	db := sqlx.MustConnect("postgres", os.Getenv("DATABASE_URL")+"?sslmode=disable")
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.Printf("Error closing database: %v", err)
+		}
+	}()
+
+	store := pgsession.NewStore(db, []byte(os.Getenv("SESSION_SALT")))
+
+	count, err := auth.TrackStoredSessions(context.Background(), db, store)
+
+	if err != nil {
+		panic(err)
+	}
+
+	log.Printf("Tracked %d sessions", count)
+}
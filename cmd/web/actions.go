@@ -13,6 +13,8 @@ import (
 	"github.com/can3p/gogo/util/transact"
 	"github.com/can3p/pcom/pkg/auth"
 	"github.com/can3p/pcom/pkg/feedops"
+	"github.com/can3p/pcom/pkg/feedops/rules"
+	"github.com/can3p/pcom/pkg/forms/validation"
 	"github.com/can3p/pcom/pkg/media/server"
 	"github.com/can3p/pcom/pkg/model/core"
 	"github.com/can3p/pcom/pkg/postops"
@@ -26,7 +28,7 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
-func setupActions(r *gin.RouterGroup, db *sqlx.DB, mediaStorage server.MediaStorage) {
+func setupActions(r *gin.RouterGroup, db *sqlx.DB, mediaStorage server.MediaStorage, deleteMedia postops.MediaDeleter) {
 	r.POST("/remove_from_whitelist", func(c *gin.Context) {
 		userData := auth.GetUserData(c)
 		dbUser := userData.DBUser
@@ -293,6 +295,18 @@ func setupActions(r *gin.RouterGroup, db *sqlx.DB, mediaStorage server.MediaStor
 		reportSuccess(c)
 	})
 
+	r.POST("/cancel_account_deletion", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+		dbUser := userData.DBUser
+
+		if err := auth.CancelAccountDeletion(c, db, dbUser); err != nil {
+			reportError(c, fmt.Sprintf("Operation Failed: %s", err.Error()))
+			return
+		}
+
+		reportSuccess(c)
+	})
+
 	r.POST("/dismiss_prompt", func(c *gin.Context) {
 		userData := auth.GetUserData(c)
 		dbUser := userData.DBUser
@@ -356,6 +370,164 @@ func setupActions(r *gin.RouterGroup, db *sqlx.DB, mediaStorage server.MediaStor
 		reportSuccess(c)
 	})
 
+	r.POST("/toggle_full_article", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+
+		var input struct {
+			SubscriptionID string `json:"id"`
+			Enabled        string `json:"enabled"`
+		}
+
+		if err := c.BindJSON(&input); err != nil {
+			reportError(c, fmt.Sprintf("Bad input: %s", err.Error()))
+			return
+		}
+
+		if input.SubscriptionID == "" {
+			reportError(c, "No subscription found")
+			return
+		}
+
+		err := feedops.SetFetchFullArticle(c, db, userData.DBUser.ID, input.SubscriptionID, input.Enabled == "true")
+
+		if err != nil {
+			reportError(c, fmt.Sprintf("Operation Failed: %s", err.Error()))
+			return
+		}
+
+		reportSuccess(c)
+	})
+
+	r.POST("/set_subscription_folder", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+
+		var input struct {
+			SubscriptionID string `json:"id"`
+			Folder         string `json:"folder"`
+		}
+
+		if err := c.BindJSON(&input); err != nil {
+			reportError(c, fmt.Sprintf("Bad input: %s", err.Error()))
+			return
+		}
+
+		if input.SubscriptionID == "" {
+			reportError(c, "No subscription found")
+			return
+		}
+
+		if err := validation.ValidateMinMax("folder", input.Folder, 0, 50); err != nil {
+			reportError(c, err.Error())
+			return
+		}
+
+		err := transact.Transact(db, func(tx *sql.Tx) error {
+			return feedops.SetSubscriptionFolder(c, tx, userData.DBUser.ID, input.SubscriptionID, input.Folder)
+		})
+
+		if err != nil {
+			reportError(c, fmt.Sprintf("Operation Failed: %s", err.Error()))
+			return
+		}
+
+		reportSuccess(c)
+	})
+
+	r.POST("/remove_feed_folder", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+
+		var input struct {
+			FolderID string `json:"id"`
+		}
+
+		if err := c.BindJSON(&input); err != nil {
+			reportError(c, fmt.Sprintf("Bad input: %s", err.Error()))
+			return
+		}
+
+		if input.FolderID == "" {
+			reportError(c, "No folder found")
+			return
+		}
+
+		if err := feedops.RemoveFeedFolder(c, db, userData.DBUser.ID, input.FolderID); err != nil {
+			reportError(c, fmt.Sprintf("Operation Failed: %s", err.Error()))
+			return
+		}
+
+		reportSuccess(c)
+	})
+
+	r.POST("/mark_folder_read", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+
+		var input struct {
+			FolderID string `json:"id"`
+		}
+
+		if err := c.BindJSON(&input); err != nil {
+			reportError(c, fmt.Sprintf("Bad input: %s", err.Error()))
+			return
+		}
+
+		if input.FolderID == "" {
+			reportError(c, "No folder found")
+			return
+		}
+
+		if err := feedops.MarkFolderRead(c, db, userData.DBUser.ID, input.FolderID); err != nil {
+			reportError(c, fmt.Sprintf("Operation Failed: %s", err.Error()))
+			return
+		}
+
+		reportSuccess(c)
+	})
+
+	r.POST("/remove_feed_rule", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+
+		var input struct {
+			RuleID string `json:"id"`
+		}
+
+		if err := c.BindJSON(&input); err != nil {
+			reportError(c, fmt.Sprintf("Bad input: %s", err.Error()))
+			return
+		}
+
+		if input.RuleID == "" {
+			reportError(c, "No rule found")
+			return
+		}
+
+		_, err := core.UserFeedRules(
+			core.UserFeedRuleWhere.ID.EQ(input.RuleID),
+			core.UserFeedRuleWhere.UserID.EQ(userData.DBUser.ID),
+		).DeleteAll(c, db)
+
+		if err != nil {
+			reportError(c, fmt.Sprintf("Operation Failed: %s", err.Error()))
+			return
+		}
+
+		reportSuccess(c)
+	})
+
+	r.POST("/rerun_feed_rules", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+
+		err := transact.Transact(db, func(tx *sql.Tx) error {
+			return rules.Rerun(c, tx, userData.DBUser.ID)
+		})
+
+		if err != nil {
+			reportError(c, fmt.Sprintf("Operation Failed: %s", err.Error()))
+			return
+		}
+
+		reportSuccess(c)
+	})
+
 	r.POST("/dissmiss_rss_item", func(c *gin.Context) {
 		userData := auth.GetUserData(c)
 
@@ -542,6 +714,36 @@ func setupActions(r *gin.RouterGroup, db *sqlx.DB, mediaStorage server.MediaStor
 		})
 	})
 
+	r.POST("/delete_media", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+
+		var input struct {
+			Fname string `json:"fname"`
+		}
+
+		if err := c.BindJSON(&input); err != nil {
+			reportError(c, fmt.Sprintf("Bad input: %s", err.Error()))
+			return
+		}
+
+		if input.Fname == "" {
+			reportError(c, "No file found")
+			return
+		}
+
+		err := postops.DeleteMedia(c, db, deleteMedia, userData.DBUser.ID, input.Fname)
+
+		if err == sql.ErrNoRows {
+			reportError(c, "No file found")
+			return
+		} else if err != nil {
+			reportError(c, fmt.Sprintf("Operation Failed: %s", err.Error()))
+			return
+		}
+
+		reportSuccess(c)
+	})
+
 	// XXX: this endpoint should be rebuilt to generate archive asyncronously
 	r.POST("/settings/export", func(c *gin.Context) {
 		userData := auth.GetUserData(c)
@@ -21,13 +21,13 @@ func setupApi(r *gin.RouterGroup, db *sqlx.DB, sender sender.Sender, mediaStorag
 	r.POST("/posts", func(c *gin.Context) {
 		userData := auth.GetAPIUserData(c)
 
-		ginhelpers.API(c, web.ApiNewPost(c, db, sender, userData.DBUser, links.MediaReplacer))
+		ginhelpers.API(c, web.ApiNewPost(c, db, sender, userData.DBUser, links.SignedMediaReplacer))
 	})
 
 	r.POST("/posts/:id", func(c *gin.Context) {
 		userData := auth.GetAPIUserData(c)
 
-		ginhelpers.API(c, web.ApiEditPost(c, db, sender, userData.DBUser, links.MediaReplacer, c.Param("id")))
+		ginhelpers.API(c, web.ApiEditPost(c, db, sender, userData.DBUser, links.SignedMediaReplacer, c.Param("id")))
 	})
 
 	r.DELETE("/posts/:id", func(c *gin.Context) {
@@ -31,6 +31,13 @@ function addNewItem(inner, fragmentItemsContainer, currentImg, currentCaption, s
   currentImg.className = "d-block w-auto m-auto img-gallery"
   itemContainer.appendChild(currentImg)
 
+  // default caption of the upload comes as a title
+  if (currentCaption.length === 0 && currentImg.title) {
+    let p = document.createElement("p")
+    p.textContent = currentImg.title
+    currentCaption = [p]
+  }
+
   if (currentCaption.length > 0) {
     let captionContainer = document.createElement("div")
     captionContainer.className = "carousel-caption d-none d-md-block"
@@ -4,6 +4,7 @@ import { bootstrapTextareaMarkdown } from "@can3p/headless-mde/headless";
 export default class extends Controller {
   static values = {
     upload: String,
+    describe: Boolean,
   }
 
   connect() {
@@ -72,7 +73,7 @@ export default class extends Controller {
     if (this.uploadValue) {
       let upload = this.element.querySelector("input[type=file]")
 
-      const uploadFile = async(file) => {
+      const uploadFile = async(file, description) => {
         const formData = new FormData();
 
         let headers = { }
@@ -91,6 +92,11 @@ export default class extends Controller {
 
         formData.append('file', file);
 
+        if (description) {
+          formData.append('alt_text', description.altText);
+          formData.append('caption', description.caption);
+        }
+
         try {
         let response = await fetch(this.uploadValue, {
             method: 'POST',
@@ -110,6 +116,13 @@ export default class extends Controller {
         }
       }
 
+      const describeFile = (file) => {
+        let altText = window.prompt(`Describe ${file.name} for people who cannot see it (alt text)`, "") ?? ""
+        let caption = window.prompt(`Caption for ${file.name}, leave empty to skip`, "") ?? ""
+
+        return { altText, caption }
+      }
+
       const uploadFiles = (files) => {
         textarea.focus();
         this.element.classList.add("mdeditor--loading")
@@ -117,7 +130,9 @@ export default class extends Controller {
         let promises = [];
 
         for (let file of files) {
-          if (!file.type.startsWith('image/')){ continue }
+          // video and audio are inserted the same way, a clip alone in
+          // the paragraph is rendered as a player
+          if (!['image/', 'video/', 'audio/'].some((prefix) => file.type.startsWith(prefix))){ continue }
 
           if (cursor.position.line.text) {
             cursor.insert('\n'); // wrap to next line if some line is not empty
@@ -126,10 +141,15 @@ export default class extends Controller {
           const loadingPlaceholder = `[uploading (${file.name})...${Math.random()}]`;
           cursor.insertAndScrollIntoView(loadingPlaceholder);
 
-          let prom = uploadFile(file).then((url) => {
-            cursor.replace(loadingPlaceholder, `![${file.name}](${url})`);
+          // the description is stored with the upload and is used
+          // whenever the markdown has no alt text, hence the empty one there
+          let description = this.describeValue ? describeFile(file) : null
+          let alt = description ? "" : file.name
+
+          let prom = uploadFile(file, description).then((url) => {
+            cursor.replace(loadingPlaceholder, `![${alt}](${url})`);
           }).catch((e) => {
-            console.log("image upload failure:" , e)
+            console.log("media upload failure:" , e)
           });
 
           promises.push(prom)
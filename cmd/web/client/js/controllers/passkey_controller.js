@@ -0,0 +1,152 @@
+import { Controller } from "@hotwired/stimulus"
+import { bodyHeaders, reloadPage, reportError } from "../lib"
+
+// passkey controller talks to the browser authenticator api, the server speaks
+// json with base64url encoded binary fields while the browser wants array buffers
+export default class extends Controller {
+  static values = {
+    begin: String,
+    finish: String,
+  }
+
+  static targets = ["name", "remember"]
+
+  connect() {
+    if (!window.PublicKeyCredential) {
+      this.element.classList.add("d-none")
+    }
+  }
+
+  async register(event) {
+    event.preventDefault()
+
+    try {
+      const options = await this.post(this.beginValue)
+      const publicKey = options.publicKey
+
+      publicKey.challenge = decode(publicKey.challenge)
+      publicKey.user.id = decode(publicKey.user.id)
+      publicKey.excludeCredentials = (publicKey.excludeCredentials || []).map(decodeDescriptor)
+
+      const credential = await navigator.credentials.create({ publicKey })
+
+      if (!credential) {
+        return
+      }
+
+      const name = this.hasNameTarget ? this.nameTarget.value : ""
+
+      await this.post(this.finishURL({ name }), {
+        id: credential.id,
+        rawId: encode(credential.rawId),
+        type: credential.type,
+        response: {
+          clientDataJSON: encode(credential.response.clientDataJSON),
+          attestationObject: encode(credential.response.attestationObject),
+          transports: credential.response.getTransports ? credential.response.getTransports() : [],
+        },
+      })
+
+      reloadPage()
+    } catch (err) {
+      reportPasskeyError(err)
+    }
+  }
+
+  async login(event) {
+    event.preventDefault()
+
+    try {
+      const options = await this.post(this.beginValue)
+      const publicKey = options.publicKey
+
+      publicKey.challenge = decode(publicKey.challenge)
+      publicKey.allowCredentials = (publicKey.allowCredentials || []).map(decodeDescriptor)
+
+      const credential = await navigator.credentials.get({ publicKey })
+
+      if (!credential) {
+        return
+      }
+
+      const params = {}
+
+      if (this.hasRememberTarget && this.rememberTarget.checked) {
+        params.remember = "true"
+      }
+
+      const result = await this.post(this.finishURL(params), {
+        id: credential.id,
+        rawId: encode(credential.rawId),
+        type: credential.type,
+        response: {
+          clientDataJSON: encode(credential.response.clientDataJSON),
+          authenticatorData: encode(credential.response.authenticatorData),
+          signature: encode(credential.response.signature),
+          userHandle: credential.response.userHandle ? encode(credential.response.userHandle) : null,
+        },
+      })
+
+      window.location.href = result.redirect
+    } catch (err) {
+      reportPasskeyError(err)
+    }
+  }
+
+  finishURL(params) {
+    const url = new URL(this.finishValue, window.location.origin)
+
+    for (let k in params) {
+      url.searchParams.set(k, params[k])
+    }
+
+    return url.toString()
+  }
+
+  async post(url, body) {
+    const response = await fetch(url, {
+      method: "POST",
+      headers: { ...bodyHeaders(), "Content-Type": "application/json" },
+      body: JSON.stringify(body || {}),
+    })
+
+    const payload = await response.json()
+
+    if (!response.ok) {
+      throw payload
+    }
+
+    return payload
+  }
+}
+
+function reportPasskeyError(err) {
+  // the user has closed the browser dialog
+  if (err instanceof DOMException && err.name === "NotAllowedError") {
+    return
+  }
+
+  reportError(err instanceof Error ? err.message : err)
+}
+
+function decodeDescriptor(descriptor) {
+  return { ...descriptor, id: decode(descriptor.id) }
+}
+
+function decode(value) {
+  const base64 = value.replace(/-/g, "+").replace(/_/g, "/")
+  const padded = base64 + "=".repeat((4 - base64.length % 4) % 4)
+
+  return Uint8Array.from(atob(padded), (c) => c.charCodeAt(0)).buffer
+}
+
+function encode(buffer) {
+  const bytes = new Uint8Array(buffer)
+  let binary = ""
+
+  for (let i = 0; i < bytes.length; i++) {
+    binary += String.fromCharCode(bytes[i])
+  }
+
+  return btoa(binary).replace(/\+/g, "-").replace(/\//g, "_").replace(/=+$/, "")
+}
@@ -42,6 +42,14 @@ window.Stimulus = Application.start()
 const context = require.context("./controllers", true, /\.js$/)
 Stimulus.load(definitionsFromContext(context))
 
+// throttled forms come back with 429 and the form explaining when to retry
+htmx.on('htmx:beforeSwap', function(evt) {
+  if (evt.detail.xhr.status === 429) {
+    evt.detail.shouldSwap = true;
+    evt.detail.isError = false;
+  }
+});
+
 // Handle htmx errors during page transitions
 htmx.on('htmx:responseError', function(evt) {
   const statusCode = evt.detail.xhr.status;
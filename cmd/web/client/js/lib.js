@@ -8,18 +8,7 @@ export async function runAction(name, dataset, extraFields, element) {
     payload = { ...payload, ...extraFields }
   }
 
-  let headers = {}
-
-  let addHeadersStr = document.body.getAttribute("hx-headers")
-
-  if (addHeadersStr) {
-    try {
-      let parsed = JSON.parse(addHeadersStr)
-      headers = { ...headers, ...parsed}
-    } catch(e) {
-      console.warn("failed to parse additional headers", e)
-    }
-  }
+  let headers = bodyHeaders()
 
   return new Promise((resolve, reject) => {
     htmx.ajax('POST', url, {
@@ -37,6 +26,24 @@ export async function runAction(name, dataset, extraFields, element) {
   })
 }
 
+// bodyHeaders returns the headers htmx sends with every request, csrf token included
+export function bodyHeaders() {
+  let headers = {}
+
+  let addHeadersStr = document.body.getAttribute("hx-headers")
+
+  if (addHeadersStr) {
+    try {
+      let parsed = JSON.parse(addHeadersStr)
+      headers = { ...headers, ...parsed}
+    } catch(e) {
+      console.warn("failed to parse additional headers", e)
+    }
+  }
+
+  return headers
+}
+
 export function reloadPage() {
   window.location.reload()
 }
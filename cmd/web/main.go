@@ -9,6 +9,7 @@ import (
 	"flag"
 	"fmt"
 	"html/template"
+	"io"
 	"io/fs"
 	"log"
 	"log/slog"
@@ -16,6 +17,7 @@ import (
 	_ "net/http/pprof"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 	_ "time/tzdata" // help go learn about timezones
@@ -27,10 +29,16 @@ import (
 	"github.com/can3p/pcom/pkg/admin"
 	"github.com/can3p/pcom/pkg/auth"
 	"github.com/can3p/pcom/pkg/feedops"
+	"github.com/can3p/pcom/pkg/feedops/reader"
+	"github.com/can3p/pcom/pkg/feedops/websub"
 	"github.com/can3p/pcom/pkg/forms"
+	"github.com/can3p/pcom/pkg/forms/values"
+	"github.com/can3p/pcom/pkg/linkpreview"
 	"github.com/can3p/pcom/pkg/links"
 	"github.com/can3p/pcom/pkg/mail/sender/dbsender"
 	"github.com/can3p/pcom/pkg/markdown"
+	"github.com/can3p/pcom/pkg/markdown/mdext/linkcard"
+	"github.com/can3p/pcom/pkg/media"
 	"github.com/can3p/pcom/pkg/media/server"
 	"github.com/can3p/pcom/pkg/media/server/storage/local"
 	"github.com/can3p/pcom/pkg/media/server/storage/s3"
@@ -46,6 +54,7 @@ import (
 	"github.com/can3p/pcom/pkg/util/ginhelpers/csp"
 	"github.com/can3p/pcom/pkg/util/ginhelpers/csrf"
 	"github.com/can3p/pcom/pkg/web"
+	"github.com/dustin/go-humanize"
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
 	"github.com/jmoiron/sqlx"
@@ -100,6 +109,15 @@ func main() {
 		enforceEnvVars(s3.RequiredEnv)
 	}
 
+	var oidcProvider *auth.OIDC
+	var oidcName string
+
+	if oidcConfig := auth.OIDCConfigFromEnv(); oidcConfig != nil {
+		enforceEnvVars(auth.OIDCRequiredEnv)
+		oidcProvider = auth.NewOIDC(*oidcConfig)
+		oidcName = oidcProvider.Name()
+	}
+
 	// fly.io does not have sslmode enabled
 	db := sqlx.MustConnect("postgres", os.Getenv("DATABASE_URL"))
 	defer func() {
@@ -146,13 +164,10 @@ func main() {
 	go feeder.RunPoller(ctx)
 
 	var mediaServer server.MediaServer
-	var mediaServerCleanup func()
-	var err error
 
-	mediaServer, mediaServerCleanup = server.New(mediaStorage,
-		server.WithClass("thumb", server.ClassParams{Width: 720, Height: 540}),
-		server.WithClass("full", server.ClassParams{Width: 1200, Height: 900}),
-		server.WithPermaCache(util.InCluster()),
+	baseMediaServer, mediaServerCleanup := server.New(mediaStorage,
+		server.WithClass("thumb", media.ThumbClass),
+		server.WithClass("full", media.FullClass),
 		server.WithClassResolver(func(c context.Context, req *http.Request) string {
 			// we know that the context is gin
 			ginCtx := c.(*gin.Context)
@@ -161,13 +176,30 @@ func main() {
 	)
 	defer mediaServerCleanup()
 
-	mediaServer = server.NewWrapper(mediaServer, MediaServerConcurrency)
-	mediaServer, err = server.NewCachingServer(mediaServer, mediaStorage, 0)
+	cachingMediaServer, err := server.NewCachingServer(server.NewWrapper(baseMediaServer, MediaServerConcurrency), mediaStorage, 0)
 
 	if err != nil {
 		panic(err)
 	}
 
+	mediaServer = cachingMediaServer
+
+	deleteMedia := func(ctx context.Context, fname string) error {
+		return cachingMediaServer.DeleteImage(ctx, fname, baseMediaServer.Classes())
+	}
+
+	go postops.RunMediaCollector(ctx, db, deleteMedia)
+
+	ffmpegPath := os.Getenv("FFMPEG_PATH")
+
+	if ffmpegPath == "" {
+		ffmpegPath = "ffmpeg"
+	}
+
+	go media.NewTranscoder(db, mediaStorage, ffmpegPath).Run(ctx)
+
+	go linkpreview.DefaultPreviewer(db, mediaStorage).Run(ctx)
+
 	if !util.InCluster() {
 		slog.SetLogLoggerLevel(slog.LevelDebug)
 	}
@@ -185,6 +217,10 @@ func main() {
 		SameSite: http.SameSiteLaxMode,
 	})
 
+	go auth.RunAccountDeleter(ctx, db, store, deleteMedia)
+
+	go auth.RunCleanup(ctx, db)
+
 	// developer timezone only messes things up
 	time.Local = time.UTC
 
@@ -209,7 +245,10 @@ func main() {
 
 	flag.Parse()
 
-	router.SetFuncMap(funcmap(staticAsset))
+	mediaMeta := media.MetaGetter(ctx, db)
+	linkPreviews := linkpreview.Getter(ctx, db)
+
+	router.SetFuncMap(funcmap(staticAsset, mediaMeta, linkPreviews))
 	router.LoadHTMLGlob(fmt.Sprintf("%s/*.html", *html))
 
 	//cache static forever
@@ -222,7 +261,80 @@ func main() {
 		router.Group("/static").Static("/", "dist")
 	}
 
-	router.GET("user-media/:fname/:class", func(c *gin.Context) {
+	// media cannot be cached by anyone unless it's referenced by a public post,
+	// hence the headers are decided per request
+	serveMedia := func(c *gin.Context, fname string, access postops.MediaAccess, privateMaxAge time.Duration) {
+		if access == postops.MediaAccessPublic {
+			if util.InCluster() {
+				c.Header("Cache-Control", server.PermaCacheHeader)
+			}
+		} else {
+			c.Header("Cache-Control", fmt.Sprintf("private, max-age=%d", int(privateMaxAge.Seconds())))
+		}
+
+		// video and audio have classes of their own
+		if class := c.Param("class"); class == media.ClipClassStream || class == media.ClipClassPoster {
+			upload, err := core.MediaUploads(
+				core.MediaUploadWhere.UploadedFname.EQ(fname),
+			).One(c, db)
+
+			if err != nil {
+				panic(err)
+			}
+
+			if err := media.ServeClip(c, mediaStorage, mediaServer, c.Request, c.Writer, upload, class); err != nil {
+				panic(err)
+			}
+
+			return
+		}
+
+		err := mediaServer.ServeImage(c, mediaServer, c.Request, c.Writer, fname)
+
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// the second value is false for unknown files
+	getMediaAccess := func(c *gin.Context, viewerID string, fname string) (postops.MediaAccess, bool) {
+		access, err := postops.GetMediaAccess(c, db, viewerID, fname)
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return postops.MediaAccessDenied, false
+		} else if err != nil {
+			panic(err)
+		}
+
+		return access, true
+	}
+
+	// signed links are rendered for those who can see the content
+	// and are used where the session is not available, e.g. emails or feed readers
+	router.GET("signed-media/:exp/:sig/:fname/:class", func(c *gin.Context) {
+		fname := c.Param("fname")
+
+		validUntil := links.VerifyMediaSignature(fname, c.Param("exp"), c.Param("sig"), time.Now())
+
+		if validUntil.IsZero() {
+			c.Status(http.StatusNotFound)
+			return
+		}
+
+		// the signature is a proof of access by itself, anonymous
+		// check only tells whether the file can be cached publicly
+		access, exists := getMediaAccess(c, "", fname)
+
+		if !exists {
+			c.Status(http.StatusNotFound)
+			return
+		}
+
+		serveMedia(c, fname, access, time.Until(validUntil))
+	})
+
+	// every image of the page goes through here, hence only the id of the viewer is resolved
+	router.GET("user-media/:fname/:class", sessions.Sessions(pgsession.SessionName, store), auth.AuthViewer, func(c *gin.Context) {
 		fname := c.Param("fname")
 
 		if fname == "" {
@@ -240,10 +352,56 @@ func main() {
 			return
 		}
 
-		err := mediaServer.ServeImage(c, mediaServer, c.Request, c.Writer, fname)
+		access, exists := getMediaAccess(c, auth.GetViewerID(c), fname)
+
+		if !exists || access == postops.MediaAccessDenied {
+			c.Status(http.StatusNotFound)
+			return
+		}
+
+		serveMedia(c, fname, access, 5*time.Minute)
+	})
+
+	// WebSub hubs know nothing about sessions or csrf tokens,
+	// the requests are authenticated by the topic and the payload signature
+	router.GET("/websub/:id", func(c *gin.Context) {
+		leaseSeconds, _ := strconv.Atoi(c.Query("hub.lease_seconds"))
+
+		err := feeder.VerifyIntent(c, c.Param("id"), c.Query("hub.mode"), c.Query("hub.topic"), leaseSeconds)
+
+		if errors.Is(err, websub.ErrUnknownSubscription) {
+			c.Status(http.StatusNotFound)
+			return
+		} else if err != nil {
+			_ = c.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+
+		c.String(http.StatusOK, c.Query("hub.challenge"))
+	})
+
+	router.POST("/websub/:id", func(c *gin.Context) {
+		body, err := io.ReadAll(io.LimitReader(c.Request.Body, reader.MaxFeedSize))
 
 		if err != nil {
-			panic(err)
+			_ = c.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+
+		err = feeder.HandlePush(c, c.Param("id"), c.GetHeader("X-Hub-Signature"), body)
+
+		switch {
+		case errors.Is(err, websub.ErrUnknownSubscription):
+			// tells the hub to drop the subscription
+			c.Status(http.StatusGone)
+		case errors.Is(err, websub.ErrInvalidSignature):
+			// the spec requires to acknowledge the delivery anyway
+			slog.Warn("websub payload with invalid signature", "feed_id", c.Param("id"))
+			c.Status(http.StatusAccepted)
+		case err != nil:
+			_ = c.AbortWithError(http.StatusInternalServerError, err)
+		default:
+			c.Status(http.StatusAccepted)
 		}
 	})
 
@@ -251,7 +409,7 @@ func main() {
 
 	setupApi(apiGroup, db, sender, mediaStorage)
 
-	r := router.Group("/", csp.Csp, sessions.Sessions("sess", store), func(c *gin.Context) { auth.Auth(c, db) })
+	r := router.Group("/", csp.Csp, sessions.Sessions(pgsession.SessionName, store), func(c *gin.Context) { auth.Auth(c, db) })
 
 	r.GET("/", func(c *gin.Context) {
 		userData := auth.GetUserData(c)
@@ -286,7 +444,7 @@ func main() {
 			panic(err)
 		}
 
-		c.HTML(http.StatusOK, "invite.html", web.Invite(c, db, invite, &userData))
+		c.HTML(http.StatusOK, "invite.html", web.Invite(c, db, invite, &userData, oidcName))
 	})
 
 	r.GET("/articles/:id", func(c *gin.Context) {
@@ -343,7 +501,92 @@ func main() {
 			sign = ""
 		}
 
-		c.HTML(http.StatusOK, "login.html", web.Login(c, db, &userData, returnUrl, sign))
+		c.HTML(http.StatusOK, "login.html", web.Login(c, db, &userData, returnUrl, sign, oidcName))
+	})
+
+	r.GET("/login/two_factor", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+
+		if userData.IsLoggedIn {
+			c.Redirect(http.StatusFound, links.DefaultAuthorizedHome())
+			return
+		}
+
+		pendingUser, err := auth.PendingSecondFactorUser(c, db)
+
+		if err != nil {
+			panic(err)
+		}
+
+		if pendingUser == nil {
+			c.Redirect(http.StatusFound, links.Link("login"))
+			return
+		}
+
+		returnUrl := c.Query("return_url")
+		sign := c.Query("sign")
+
+		if auth.HashValue(returnUrl) != sign {
+			returnUrl = ""
+			sign = ""
+		}
+
+		ginhelpers.HTML(c, "login_two_factor.html", web.LoginTwoFactor(c, db, &userData, pendingUser, returnUrl, sign))
+	})
+
+	r.GET("/login/email", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+
+		if userData.IsLoggedIn {
+			c.Redirect(http.StatusFound, links.DefaultAuthorizedHome())
+			return
+		}
+
+		returnUrl := c.Query("return_url")
+		sign := c.Query("sign")
+
+		if auth.HashValue(returnUrl) != sign {
+			returnUrl = ""
+			sign = ""
+		}
+
+		c.HTML(http.StatusOK, "login_email.html", web.LoginEmail(c, db, &userData, returnUrl, sign))
+	})
+
+	r.GET("/login/email/:token", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+
+		if userData.IsLoggedIn {
+			c.Redirect(http.StatusFound, links.DefaultAuthorizedHome())
+			return
+		}
+
+		returnUrl := c.Query("return_url")
+		sign := c.Query("sign")
+
+		if auth.HashValue(returnUrl) != sign {
+			returnUrl = ""
+			sign = ""
+		}
+
+		c.HTML(http.StatusOK, "login_link.html", web.LoginLink(c, db, &userData, c.Param("token"), returnUrl, sign))
+	})
+
+	r.GET("/forgot_password", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+
+		if userData.IsLoggedIn {
+			c.Redirect(http.StatusFound, links.DefaultAuthorizedHome())
+			return
+		}
+
+		c.HTML(http.StatusOK, "forgot_password.html", web.ForgotPassword(c, db, &userData))
+	})
+
+	r.GET("/reset_password/:token", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+
+		c.HTML(http.StatusOK, "reset_password.html", web.ResetPassword(c, db, &userData, c.Param("token")))
 	})
 
 	r.GET("/signup", func(c *gin.Context) {
@@ -364,6 +607,7 @@ func main() {
 			"User":             userData,
 			"RegistrationOpen": registrationOpen,
 			"Attribution":      attribution,
+			"OIDCName":         oidcName,
 		})
 	})
 
@@ -435,6 +679,8 @@ func main() {
 			links.AbsLink("user", username),
 			author,
 			userHome.MustGet().Posts,
+			mediaMeta,
+			linkPreviews,
 		)
 
 		c.Header("Content-Type", "text/xml")
@@ -510,8 +756,7 @@ func main() {
 		c.Header("Content-Type", "text/plain")
 
 		dbPost := post.MustGet().Post.Post
-
-		dbPost.Body = markdown.ReplaceImageUrls(dbPost.Body, links.MediaReplacer)
+		dbPost.Body = markdown.ReplaceImageUrls(dbPost.Body, links.PostMediaReplacer(dbPost.VisibilityRadius))
 
 		serialized := postops.SerializePost(dbPost)
 		c.String(http.StatusOK, string(serialized))
@@ -607,6 +852,8 @@ func main() {
 			links.AbsLink("feed", user.Username),
 			user,
 			posts,
+			mediaMeta,
+			linkPreviews,
 		)
 
 		c.Header("Content-Type", "text/xml")
@@ -622,9 +869,51 @@ func main() {
 	controls := r.Group("/controls", auth.EnforceAuth)
 	actions := controls.Group("/action", csrf.CheckCSRF)
 
-	actions.POST("/logout", auth.Logout)
+	actions.POST("/logout", func(c *gin.Context) {
+		auth.Logout(c, db)
+	})
+
+	// the accounts without a password confirm the changes with a fresh login
+	actions.POST("/log_in_again", func(c *gin.Context) {
+		auth.Logout(c, db)
+
+		returnURL := links.Link("settings")
+		c.Header("HX-Redirect", links.Link("login", "return_url", returnURL, "sign", auth.HashValue(returnURL)))
+	})
+
+	actions.POST("/revoke_session", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+
+		var input struct {
+			SessionID string `json:"sessionId"`
+		}
+
+		if err := c.BindJSON(&input); err != nil {
+			reportError(c, fmt.Sprintf("Bad input: %s", err.Error()))
+			return
+		}
+
+		if err := auth.RevokeSession(c, db, store, userData.DBUser.ID, input.SessionID); err != nil {
+			reportError(c, fmt.Sprintf("Failed operation: %s", err.Error()))
+			return
+		}
+
+		reportSuccess(c)
+	})
+
+	actions.POST("/revoke_other_sessions", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+
+		if err := auth.RevokeOtherSessions(c, db, store, userData.DBUser.ID); err != nil {
+			reportError(c, fmt.Sprintf("Failed operation: %s", err.Error()))
+			return
+		}
+
+		reportSuccess(c)
+	})
 
-	setupActions(actions, db, mediaStorage)
+	setupActions(actions, db, mediaStorage, deleteMedia)
+	setupPasskeyActions(actions, db)
 
 	controls.GET("/", func(c *gin.Context) {
 		userData := auth.GetUserData(c)
@@ -632,12 +921,24 @@ func main() {
 		ginhelpers.HTML(c, "controls.html", web.Controls(c, db, &userData))
 	})
 
+	controls.GET("/media", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+
+		ginhelpers.HTML(c, "media.html", web.MediaLibrary(c, db, &userData))
+	})
+
 	controls.GET("/settings", func(c *gin.Context) {
 		userData := auth.GetUserData(c)
 
 		ginhelpers.HTML(c, "settings.html", web.Settings(c, db, &userData))
 	})
 
+	controls.GET("/settings/security", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+
+		ginhelpers.HTML(c, "security.html", web.Security(c, db, &userData))
+	})
+
 	r.GET("/confirm_signup/:id", func(c *gin.Context) {
 		id := c.Param("id")
 		userData := auth.GetUserData(c)
@@ -671,6 +972,14 @@ func main() {
 		})
 	})
 
+	// works both for the logged in users and not, the old address
+	// might be confirmed from a device the user is not logged in on
+	r.GET("/confirm_email_change/:token", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+
+		c.HTML(http.StatusOK, "confirm_email_change.html", web.ConfirmEmailChange(c, db, &userData, c.Param("token")))
+	})
+
 	nonControlsForms := r.Group("/form", csrf.CheckCSRF)
 
 	nonControlsForms.POST("/login", func(c *gin.Context) {
@@ -680,11 +989,67 @@ func main() {
 			c.Redirect(http.StatusFound, links.DefaultAuthorizedHome())
 		}
 
-		form := forms.LoginFormNew()
+		form := forms.LoginFormNew(sender)
+
+		forms.ThrottledHandler(c, db, form)
+	})
+
+	nonControlsForms.POST("/login_two_factor", func(c *gin.Context) {
+		form := forms.LoginTwoFactorFormNew(sender)
+
+		forms.ThrottledHandler(c, db, form)
+	})
+
+	setupPasskeyLogin(nonControlsForms.Group("/passkey"), db, sender)
+
+	nonControlsForms.POST("/login_email", func(c *gin.Context) {
+		form := forms.LoginEmailFormNew(sender)
+
+		forms.ThrottledHandler(c, db, form)
+	})
+
+	nonControlsForms.POST("/confirm_email_change/:token", func(c *gin.Context) {
+		form := forms.ConfirmEmailChangeFormNew(sender, c.Param("token"))
+
+		gogoForms.DefaultHandler(c, db, form)
+	})
+
+	nonControlsForms.POST("/login_link/:token", func(c *gin.Context) {
+		form := forms.LoginLinkFormNew(c.Param("token"))
+
+		gogoForms.DefaultHandler(c, db, form)
+	})
+
+	if oidcProvider != nil {
+		setupOIDCLogin(r, nonControlsForms, db, sender, oidcProvider, func(c *gin.Context) bool {
+			systemSettings := core.SystemSettings().OneP(c, db)
+
+			return systemSettings.RegistrationOpen || forceOpenRegistation
+		})
+	}
+
+	nonControlsForms.POST("/forgot_password", func(c *gin.Context) {
+		form := forms.ForgotPasswordFormNew(sender, c.ClientIP())
 
 		gogoForms.DefaultHandler(c, db, form)
 	})
 
+	nonControlsForms.POST("/reset_password/:token", func(c *gin.Context) {
+		token := c.Param("token")
+
+		_, err := auth.FindPasswordReset(c, db, token)
+
+		if err == sql.ErrNoRows {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		} else if err != nil {
+			panic(err)
+		}
+
+		form := forms.ResetPasswordFormNew(store, token)
+		gogoForms.DefaultHandler(c, db, form)
+	})
+
 	nonControlsForms.POST("/accept_invite/:id", func(c *gin.Context) {
 		invitationID := c.Param("id")
 
@@ -701,7 +1066,7 @@ func main() {
 		}
 
 		form := forms.AcceptInviteFormNew(sender, invite)
-		gogoForms.DefaultHandler(c, db, form)
+		forms.ThrottledHandler(c, db, form)
 	})
 
 	nonControlsForms.POST("/signup", func(c *gin.Context) {
@@ -721,7 +1086,7 @@ func main() {
 		}
 		form := forms.SignupFormNew(sender)
 
-		gogoForms.DefaultHandler(c, db, form)
+		forms.ThrottledHandler(c, db, form)
 	})
 
 	nonControlsForms.POST("/signup_waiting_list", func(c *gin.Context) {
@@ -742,7 +1107,7 @@ func main() {
 
 		form := forms.SignupWaitingListFormNew(sender)
 
-		gogoForms.DefaultHandler(c, db, form)
+		forms.ThrottledHandler(c, db, form)
 	})
 
 	controlsForms := controls.Group("/form", csrf.CheckCSRF)
@@ -775,13 +1140,13 @@ func main() {
 		var err error
 
 		if postID == "" {
-			form, err = forms.NewPostFormNew(c, db, sender, dbUser, links.MediaReplacer, c.PostForm("prompt_id"))
+			form, err = forms.NewPostFormNew(c, db, sender, dbUser, links.SignedMediaReplacer, c.PostForm("prompt_id"), c.PostForm("rss_item_id"))
 
 			if err != nil {
 				panic(err)
 			}
 		} else {
-			form, err = forms.EditPostFormNew(c, db, sender, dbUser, links.MediaReplacer, postID)
+			form, err = forms.EditPostFormNew(c, db, sender, dbUser, links.SignedMediaReplacer, postID)
 
 			if err != nil {
 				if err == ginhelpers.ErrNotFound {
@@ -800,7 +1165,7 @@ func main() {
 		userData := auth.GetUserData(c)
 		dbUser := userData.DBUser
 
-		form := forms.NewCommentFormNew(sender, dbUser, c.PostForm("post_id"), links.MediaReplacer)
+		form := forms.NewCommentFormNew(sender, dbUser, c.PostForm("post_id"), links.SignedMediaReplacer)
 
 		gogoForms.DefaultHandler(c, db, form)
 	})
@@ -823,11 +1188,68 @@ func main() {
 		gogoForms.DefaultHandler(c, db, form)
 	})
 
+	controlsForms.POST("/enable_two_factor", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+		dbUser := userData.DBUser
+
+		form := forms.EnableTwoFactorFormNew(dbUser)
+
+		gogoForms.DefaultHandler(c, db, form)
+	})
+
+	controlsForms.POST("/manage_two_factor", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+		dbUser := userData.DBUser
+
+		form := forms.ManageTwoFactorFormNew(dbUser)
+
+		gogoForms.DefaultHandler(c, db, form)
+	})
+
+	controlsForms.POST("/change_email", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+		dbUser := userData.DBUser
+
+		form := forms.ChangeEmailFormNew(sender, dbUser)
+
+		gogoForms.DefaultHandler(c, db, form)
+	})
+
+	controlsForms.POST("/delete_account", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+		dbUser := userData.DBUser
+
+		form := forms.DeleteAccountFormNew(sender, dbUser)
+
+		gogoForms.DefaultHandler(c, db, form)
+	})
+
 	controlsForms.POST("/change_password", func(c *gin.Context) {
 		userData := auth.GetUserData(c)
 		dbUser := userData.DBUser
 
-		form := forms.ChangePasswordFormNew(dbUser)
+		form := forms.ChangePasswordFormNew(store, dbUser)
+
+		gogoForms.DefaultHandler(c, db, form)
+	})
+
+	controlsForms.POST("/media_description", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+		dbUser := userData.DBUser
+
+		upload, err := core.MediaUploads(
+			core.MediaUploadWhere.UserID.EQ(null.StringFrom(dbUser.ID)),
+			core.MediaUploadWhere.UploadedFname.EQ(c.PostForm("fname")),
+		).One(c, db)
+
+		if err == sql.ErrNoRows {
+			c.Status(http.StatusNotFound)
+			return
+		} else if err != nil {
+			panic(err)
+		}
+
+		form := forms.MediaDescriptionFormNew(dbUser, upload)
 
 		gogoForms.DefaultHandler(c, db, form)
 	})
@@ -859,7 +1281,28 @@ func main() {
 		userData := auth.GetUserData(c)
 		dbUser := userData.DBUser
 
-		form := forms.NewAddFeedForm(dbUser)
+		folders, err := feedops.GetFeedFolders(c, db, dbUser.ID)
+
+		if err != nil {
+			panic(err)
+		}
+
+		form := forms.NewAddFeedForm(dbUser, folders)
+
+		gogoForms.DefaultHandler(c, db, form)
+	})
+
+	controlsForms.POST("/add_feed_rule", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+		dbUser := userData.DBUser
+
+		feeds, err := feedops.GetRssFeeds(c, db, dbUser.ID)
+
+		if err != nil {
+			panic(err)
+		}
+
+		form := forms.NewAddFeedRuleForm(dbUser, feeds)
 
 		gogoForms.DefaultHandler(c, db, form)
 	})
@@ -882,10 +1325,11 @@ func main() {
 	}
 }
 
-func funcmap(staticAsset staticAssetFunc) template.FuncMap {
-	markdown := func(view types.HTMLView) func(s string, add ...string) template.HTML {
-		return func(s string, add ...string) template.HTML {
-			return markdown.ToEnrichedTemplate(s, view, links.MediaReplacer, func(in string, add2 ...string) string {
+func funcmap(staticAsset staticAssetFunc, mediaMeta types.MediaMetaGetter, linkPreviews types.LinkPreviewGetter) template.FuncMap {
+	// visibility is the one of the post the text belongs to
+	markdown := func(view types.HTMLView) func(s string, visibility core.PostVisibility, add ...string) template.HTML {
+		return func(s string, visibility core.PostVisibility, add ...string) template.HTML {
+			return markdown.ToEnrichedTemplate(s, view, links.PostMediaReplacer(visibility), mediaMeta, linkPreviews, func(in string, add2 ...string) string {
 				// ugly hack to handle cut links
 				if in == "single_post_special" {
 					args := []string{}
@@ -937,9 +1381,49 @@ func funcmap(staticAsset staticAssetFunc) template.FuncMap {
 		"markdown_comment":      markdown(types.ViewComment),
 		"markdown_article":      markdown(types.ViewArticle),
 
+		// urls attached to the posts get the same cards as the links in the text,
+		// empty result means there is no preview for the url yet
+		"linkCard": func(url string, visibility core.PostVisibility) template.HTML {
+			preview, ok := linkPreviews(url)
+
+			if !ok {
+				return ""
+			}
+
+			preview.URL = url
+
+			var buf bytes.Buffer
+			linkcard.WriteCard(&buf, types.ViewSinglePost, links.PostMediaReplacer(visibility), preview)
+
+			return template.HTML(buf.String())
+		},
+
+		// pages with media outside of markdown and any post need the same links
+		"signedMedia": func(fname string) string {
+			_, out := links.SignedMediaReplacer(fname)
+			return out
+		},
+
+		"formatBytes": func(b int64) string {
+			return humanize.IBytes(uint64(b))
+		},
+
 		"tzlist": func() []string {
 			return util.TimeZones
 		},
+
+		"feedRuleLabel": func(kind string, value fmt.Stringer) string {
+			switch kind {
+			case "field":
+				return values.FeedRuleFieldValues.Label(value.String())
+			case "match_type":
+				return values.FeedRuleMatchTypeValues.Label(value.String())
+			case "action":
+				return values.FeedRuleActionValues.Label(value.String())
+			}
+
+			panic("unknown feed rule label kind: " + kind)
+		},
 	}
 }
 
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"bytes"
+	"html/template"
+	"testing"
+	"time"
+
+	"github.com/can3p/pcom/pkg/links"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/pkg/postops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestDiscussedElsewhere(t *testing.T) {
+	tmpl := template.Must(template.New("").Funcs(funcmap(nil, nil, nil)).ParseGlob("client/html/*.html"))
+
+	render := func(posts []*postops.Post) string {
+		var buf bytes.Buffer
+
+		require.NoError(t, tmpl.ExecuteTemplate(&buf, "partial--discussed-elsewhere.html", map[string]any{
+			"Posts":  posts,
+			"Viewer": (*core.User)(nil),
+		}))
+
+		return buf.String()
+	}
+
+	post := &postops.Post{
+		Post: &core.Post{
+			ID:          "0192a0d2-7c4e-7d6a-9a4b-3f1c2d3e4f50",
+			Subject:     null.StringFrom("Same link"),
+			PublishedAt: null.TimeFrom(time.Now()),
+		},
+		Author: &core.User{Username: "alice"},
+	}
+
+	out := render([]*postops.Post{post})
+
+	assert.Contains(t, out, "Discussed elsewhere")
+	assert.Contains(t, out, `<a href="`+links.Link("user", "alice")+`">alice</a>`)
+	assert.Contains(t, out, `<a href="`+links.Link("post", post.ID)+`">Same link</a>`)
+
+	assert.NotContains(t, render(nil), "Discussed elsewhere", "the block is hidden without other posts")
+}
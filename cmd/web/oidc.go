@@ -0,0 +1,149 @@
+package main
+
+import (
+	"database/sql"
+	"log/slog"
+	"net/http"
+
+	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/auth"
+	"github.com/can3p/pcom/pkg/forms"
+	"github.com/can3p/pcom/pkg/links"
+	"github.com/can3p/pcom/pkg/util"
+	"github.com/can3p/pcom/pkg/util/ginhelpers"
+	"github.com/can3p/pcom/pkg/web"
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// setupOIDCLogin adds the login with the external identity provider. The users
+// we don't know yet either link the identity to their account or sign up
+func setupOIDCLogin(r *gin.RouterGroup, formsGroup *gin.RouterGroup, db *sqlx.DB, sender sender.Sender, provider *auth.OIDC, registrationOpen func(c *gin.Context) bool) {
+	r.GET("/login/oidc", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+
+		if userData.IsLoggedIn {
+			c.Redirect(http.StatusFound, links.DefaultAuthorizedHome())
+			return
+		}
+
+		returnUrl := c.Query("return_url")
+		sign := c.Query("sign")
+
+		if auth.HashValue(returnUrl) != sign {
+			returnUrl = ""
+			sign = ""
+		}
+
+		authURL, err := provider.BeginLogin(c, returnUrl, sign, c.Query("invite"))
+
+		if err != nil {
+			slog.Error("Failed to start the login with the identity provider", "err", err)
+			c.Redirect(http.StatusFound, links.Link("oidc_finish"))
+			return
+		}
+
+		c.Redirect(http.StatusFound, authURL)
+	})
+
+	r.GET("/login/oidc/callback", func(c *gin.Context) {
+		flow, err := provider.FinishLogin(c)
+
+		if err != nil {
+			if !errors.Is(err, auth.ErrOIDCFailed) {
+				slog.Error("Failed to finish the login with the identity provider", "err", err)
+			}
+
+			c.Redirect(http.StatusFound, links.Link("oidc_finish"))
+			return
+		}
+
+		user, err := auth.FindIdentityUser(c, db, flow.Identity)
+
+		if err == sql.ErrNoRows {
+			if err := auth.SaveOIDCFlow(c, flow); err != nil {
+				panic(err)
+			}
+
+			c.Redirect(http.StatusFound, links.Link("oidc_finish"))
+			return
+		} else if err != nil {
+			panic(err)
+		}
+
+		err = auth.LoginUser(c, db, user)
+
+		// the signed return url is passed along to the second step
+		if errors.Is(err, auth.ErrSecondFactorRequired) {
+			c.Redirect(http.StatusFound, links.Link("login_two_factor", "return_url", flow.ReturnURL, "sign", flow.Sign))
+			return
+		} else if err != nil {
+			panic(err)
+		}
+
+		if flow.ReturnURL != "" && auth.HashValue(flow.ReturnURL) == flow.Sign {
+			c.Redirect(http.StatusFound, util.SiteRoot()+flow.ReturnURL)
+			return
+		}
+
+		c.Redirect(http.StatusFound, links.DefaultAuthorizedHome())
+	})
+
+	r.GET("/login/oidc/finish", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+
+		if userData.IsLoggedIn {
+			c.Redirect(http.StatusFound, links.DefaultAuthorizedHome())
+			return
+		}
+
+		flow, err := auth.PendingOIDCFlow(c)
+
+		if err != nil {
+			panic(err)
+		}
+
+		ginhelpers.HTML(c, "oidc_finish.html", web.OIDCFinish(c, db, &userData, provider.Name(), flow, registrationOpen(c)))
+	})
+
+	formsGroup.POST("/oidc_link", func(c *gin.Context) {
+		flow, err := auth.PendingOIDCFlow(c)
+
+		if err != nil {
+			panic(err)
+		}
+
+		if flow == nil {
+			c.AbortWithStatus(http.StatusForbidden)
+			return
+		}
+
+		form := forms.OIDCLinkFormNew(sender, flow)
+
+		forms.ThrottledHandler(c, db, form)
+	})
+
+	formsGroup.POST("/oidc_signup", func(c *gin.Context) {
+		flow, err := auth.PendingOIDCFlow(c)
+
+		if err != nil {
+			panic(err)
+		}
+
+		if flow == nil {
+			c.AbortWithStatus(http.StatusForbidden)
+			return
+		}
+
+		invite, err := auth.OIDCSignupInvite(c, db, flow)
+
+		if err != nil {
+			panic(err)
+		}
+
+		form := forms.OIDCSignupFormNew(sender, flow, invite, registrationOpen(c))
+
+		forms.ThrottledHandler(c, db, form)
+	})
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/auth"
+	"github.com/can3p/pcom/pkg/links"
+	"github.com/can3p/pcom/pkg/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// setupPasskeyLogin adds the endpoints used by the browser to log in with a passkey,
+// both on its own and as the second factor after the password
+func setupPasskeyLogin(r *gin.RouterGroup, db *sqlx.DB, sender sender.Sender) {
+	r.POST("/login_begin", func(c *gin.Context) {
+		assertion, err := auth.BeginPasskeyLogin(c)
+
+		if err != nil {
+			reportError(c, fmt.Sprintf("Failed operation: %s", err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, assertion)
+	})
+
+	r.POST("/login_finish", func(c *gin.Context) {
+		if _, err := auth.FinishPasskeyLogin(c, db); err != nil {
+			reportPasskeyError(c, err)
+			return
+		}
+
+		reportPasskeyLogin(c)
+	})
+
+	r.POST("/second_factor_begin", func(c *gin.Context) {
+		assertion, err := auth.BeginPasskeySecondFactor(c, db)
+
+		if err != nil {
+			reportError(c, fmt.Sprintf("Failed operation: %s", err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, assertion)
+	})
+
+	r.POST("/second_factor_finish", func(c *gin.Context) {
+		if err := auth.FinishPasskeySecondFactor(c, db, sender, c.Query("remember") == "true"); err != nil {
+			reportPasskeyError(c, err)
+			return
+		}
+
+		reportPasskeyLogin(c)
+	})
+}
+
+func setupPasskeyActions(r *gin.RouterGroup, db *sqlx.DB) {
+	r.POST("/passkey_register_begin", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+
+		creation, err := auth.BeginPasskeyRegistration(c, db, userData.DBUser)
+
+		if err != nil {
+			reportError(c, fmt.Sprintf("Failed operation: %s", err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, creation)
+	})
+
+	r.POST("/passkey_register_finish", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+
+		if _, err := auth.FinishPasskeyRegistration(c, db, userData.DBUser, c.Query("name")); err != nil {
+			reportPasskeyError(c, err)
+			return
+		}
+
+		reportSuccess(c)
+	})
+
+	r.POST("/rename_passkey", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+
+		var input struct {
+			PasskeyID string `json:"passkeyId"`
+			Name      string `json:"name"`
+		}
+
+		if err := c.BindJSON(&input); err != nil {
+			reportError(c, fmt.Sprintf("Bad input: %s", err.Error()))
+			return
+		}
+
+		if err := auth.RenamePasskey(c, db, userData.DBUser, input.PasskeyID, input.Name); err != nil {
+			reportError(c, fmt.Sprintf("Failed operation: %s", err.Error()))
+			return
+		}
+
+		reportSuccess(c)
+	})
+
+	r.POST("/delete_passkey", func(c *gin.Context) {
+		userData := auth.GetUserData(c)
+
+		var input struct {
+			PasskeyID string `json:"passkeyId"`
+		}
+
+		if err := c.BindJSON(&input); err != nil {
+			reportError(c, fmt.Sprintf("Bad input: %s", err.Error()))
+			return
+		}
+
+		if err := auth.DeletePasskey(c, db, userData.DBUser, input.PasskeyID); err != nil {
+			reportError(c, fmt.Sprintf("Failed operation: %s", err.Error()))
+			return
+		}
+
+		reportSuccess(c)
+	})
+}
+
+// reportPasskeyError hides the details of failed verification,
+// they mean nothing to the user anyway
+func reportPasskeyError(c *gin.Context, err error) {
+	var throttled *auth.ThrottledError
+
+	if errors.As(err, &throttled) {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(throttled.RetryAfter.Seconds()))))
+		c.JSON(http.StatusTooManyRequests, gin.H{
+			"explanation": throttled.Error(),
+		})
+		return
+	}
+
+	if errors.Is(err, auth.ErrPasskeyFailed) {
+		reportError(c, "The passkey could not be verified, please try again")
+		return
+	}
+
+	reportError(c, fmt.Sprintf("Failed operation: %s", err.Error()))
+}
+
+func reportPasskeyLogin(c *gin.Context) {
+	returnURL := c.Query("return_url")
+	redirect := links.DefaultAuthorizedHome()
+
+	if returnURL != "" && auth.HashValue(returnURL) == c.Query("sign") {
+		redirect = util.SiteRoot() + returnURL
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"redirect": redirect,
+	})
+}
@@ -0,0 +1,22 @@
+
+-- +migrate Up
+alter table rss_feeds
+add column websub_hub_url text,
+add column websub_topic_url text,
+add column websub_secret text,
+add column websub_requested_at timestamp,
+add column websub_lease_expires_at timestamp,
+add column websub_last_push_at timestamp;
+
+create index idx_rss_feeds_websub_lease_expires_at on rss_feeds(websub_lease_expires_at);
+
+-- +migrate Down
+drop index if exists idx_rss_feeds_websub_lease_expires_at;
+
+alter table rss_feeds
+drop column websub_hub_url,
+drop column websub_topic_url,
+drop column websub_secret,
+drop column websub_requested_at,
+drop column websub_lease_expires_at,
+drop column websub_last_push_at;
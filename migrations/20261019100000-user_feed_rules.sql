@@ -0,0 +1,32 @@
+
+-- +migrate Up
+create type feed_rule_field as enum ('title', 'description', 'host');
+create type feed_rule_match_type as enum ('keyword', 'regex');
+create type feed_rule_action as enum ('dismiss', 'highlight', 'draft_post');
+
+-- rules without subscription apply to all the feeds of the user
+create table user_feed_rules (
+    id uuid not null primary key,
+    user_id uuid not null references users(id),
+    subscription_id uuid references user_feed_subscriptions(id) on delete cascade,
+    field feed_rule_field not null,
+    match_type feed_rule_match_type not null,
+    pattern text not null,
+    action feed_rule_action not null,
+    created_at timestamp not null,
+    updated_at timestamp not null
+);
+
+create index idx_user_feed_rules_user_id on user_feed_rules(user_id);
+
+alter table user_feed_items
+add column is_highlighted boolean not null default false;
+
+-- +migrate Down
+alter table user_feed_items drop column is_highlighted;
+
+drop table user_feed_rules;
+
+drop type feed_rule_action;
+drop type feed_rule_match_type;
+drop type feed_rule_field;
@@ -0,0 +1,18 @@
+
+-- +migrate Up
+alter table user_feed_subscriptions
+add column fetch_full_article boolean not null default false;
+
+-- full_content is extracted from the item page and is only
+-- populated if at least one subscriber asked for it
+alter table rss_items
+add column full_content text,
+add column full_content_error text;
+
+-- +migrate Down
+alter table rss_items
+drop column full_content,
+drop column full_content_error;
+
+alter table user_feed_subscriptions
+drop column fetch_full_article;
@@ -0,0 +1,22 @@
+
+-- +migrate Up
+create table user_feed_folders (
+    id uuid not null primary key,
+    user_id uuid not null references users(id),
+    name text not null,
+    created_at timestamp not null,
+    updated_at timestamp not null,
+    unique(user_id, name)
+);
+
+alter table user_feed_subscriptions
+add column folder_id uuid references user_feed_folders(id) on delete set null;
+
+create index idx_user_feed_subscriptions_folder_id on user_feed_subscriptions(folder_id);
+
+-- +migrate Down
+drop index if exists idx_user_feed_subscriptions_folder_id;
+
+alter table user_feed_subscriptions drop column folder_id;
+
+drop table user_feed_folders;
@@ -0,0 +1,22 @@
+-- +migrate Up
+alter table media_uploads
+add column size_bytes bigint not null default 0;
+
+create index idx_media_uploads_user_id on media_uploads(user_id);
+
+-- null means the default quota from system settings
+alter table users
+add column media_quota_bytes bigint;
+
+-- 1GB
+alter table system_settings
+add column default_media_quota_bytes bigint not null default 1073741824;
+
+-- +migrate Down
+alter table system_settings drop column default_media_quota_bytes;
+
+alter table users drop column media_quota_bytes;
+
+drop index if exists idx_media_uploads_user_id;
+
+alter table media_uploads drop column size_bytes;
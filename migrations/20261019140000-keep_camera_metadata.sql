@@ -0,0 +1,6 @@
+-- +migrate Up
+alter table users
+add column keep_camera_metadata bool not null default false;
+
+-- +migrate Down
+alter table users drop column keep_camera_metadata;
@@ -0,0 +1,11 @@
+-- +migrate Up
+alter table media_uploads
+add column width int,
+add column height int,
+add column blurhash varchar;
+
+-- +migrate Down
+alter table media_uploads
+drop column width,
+drop column height,
+drop column blurhash;
@@ -0,0 +1,18 @@
+-- +migrate Up
+alter table media_uploads
+add column alt_text varchar,
+add column caption varchar;
+
+create type alt_text_policy as enum ('off', 'warn', 'block');
+
+alter table users
+add column alt_text_policy alt_text_policy not null default 'off';
+
+-- +migrate Down
+alter table users drop column alt_text_policy;
+
+drop type alt_text_policy;
+
+alter table media_uploads
+drop column alt_text,
+drop column caption;
@@ -0,0 +1,24 @@
+-- +migrate Up
+create type media_kind as enum ('image', 'video', 'audio');
+create type media_rendition_status as enum ('pending', 'ready', 'failed');
+
+alter table media_uploads
+add column kind media_kind not null default 'image',
+add column rendition_status media_rendition_status,
+add column rendition_fname varchar,
+add column poster_fname varchar,
+add column rendition_error varchar;
+
+create index media_uploads_pending_renditions_idx on media_uploads (created_at)
+where rendition_status = 'pending';
+
+-- +migrate Down
+alter table media_uploads
+drop column kind,
+drop column rendition_status,
+drop column rendition_fname,
+drop column poster_fname,
+drop column rendition_error;
+
+drop type media_rendition_status;
+drop type media_kind;
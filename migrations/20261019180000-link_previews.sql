@@ -0,0 +1,54 @@
+-- +migrate Up
+create type link_preview_status as enum ('pending', 'ready', 'failed');
+
+create table link_previews (
+    id uuid not null primary key,
+    url_id uuid not null unique references normalized_urls(id) on delete cascade,
+    status link_preview_status not null,
+    title varchar,
+    description varchar,
+    site_name varchar,
+    image_fname varchar,
+    player_url varchar,
+    fetch_error varchar,
+    fetched_at timestamp,
+    created_at timestamp not null,
+    updated_at timestamp not null
+);
+
+create index link_previews_pending_idx on link_previews (created_at)
+where status = 'pending';
+
+-- preview images belong to the url, not to the user who posted it
+alter table media_uploads
+add column url_id uuid references normalized_urls(id);
+
+alter table media_uploads drop constraint media_uploads_owner_check;
+
+alter table media_uploads
+add constraint media_uploads_owner_check
+check (
+    (user_id is not null and rss_feed_id is null and url_id is null) or
+    (user_id is null and rss_feed_id is not null and url_id is null) or
+    (user_id is null and rss_feed_id is null and url_id is not null)
+);
+
+create index media_uploads_url_id_idx on media_uploads (url_id);
+
+-- +migrate Down
+drop index media_uploads_url_id_idx;
+
+alter table media_uploads drop constraint media_uploads_owner_check;
+
+alter table media_uploads drop column url_id;
+
+alter table media_uploads
+add constraint media_uploads_owner_check
+check (
+    (user_id is not null and rss_feed_id is null) or
+    (user_id is null and rss_feed_id is not null)
+);
+
+drop table link_previews;
+
+drop type link_preview_status;
@@ -0,0 +1,6 @@
+-- +migrate Up
+-- hashes are salted now, nothing can be looked up by them
+drop index users_pwdhash_idx;
+
+-- +migrate Down
+create index on users(pwdhash);
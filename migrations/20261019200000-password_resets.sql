@@ -0,0 +1,21 @@
+-- +migrate Up
+-- every request is logged, even for the unknown emails,
+-- to be able to rate limit them by email and by ip
+create table password_reset_requests (
+    id uuid not null primary key,
+    email varchar not null,
+    ip varchar not null,
+    user_id uuid references users(id) on delete cascade,
+    token_hash varchar unique,
+    expires_at timestamp,
+    used_at timestamp,
+    created_at timestamp not null,
+    updated_at timestamp not null
+);
+
+create index password_reset_requests_email_idx on password_reset_requests (email, created_at);
+create index password_reset_requests_ip_idx on password_reset_requests (ip, created_at);
+create index password_reset_requests_user_id_idx on password_reset_requests (user_id);
+
+-- +migrate Down
+drop table password_reset_requests;
@@ -0,0 +1,45 @@
+-- +migrate Up
+alter table users
+add column totp_secret varchar,
+add column totp_enabled_at timestamp,
+-- codes can be used only once, hence the last accepted time step is kept
+add column totp_last_step bigint;
+
+create table user_recovery_codes (
+    id uuid not null primary key,
+    user_id uuid not null references users(id) on delete cascade,
+    code_hash varchar not null,
+    used_at timestamp,
+    created_at timestamp not null,
+    updated_at timestamp not null
+);
+
+create index user_recovery_codes_user_id_idx on user_recovery_codes (user_id);
+
+create table user_remembered_devices (
+    id uuid not null primary key,
+    user_id uuid not null references users(id) on delete cascade,
+    token_hash varchar not null unique,
+    expires_at timestamp not null,
+    created_at timestamp not null,
+    updated_at timestamp not null
+);
+
+create index user_remembered_devices_user_id_idx on user_remembered_devices (user_id);
+
+alter table system_settings
+add column two_factor_required boolean not null default false,
+add column two_factor_remember_days int not null default 30;
+
+-- +migrate Down
+alter table system_settings
+drop column two_factor_required,
+drop column two_factor_remember_days;
+
+drop table user_remembered_devices;
+drop table user_recovery_codes;
+
+alter table users
+drop column totp_secret,
+drop column totp_enabled_at,
+drop column totp_last_step;
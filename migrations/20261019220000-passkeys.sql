@@ -0,0 +1,18 @@
+-- +migrate Up
+create table user_passkeys (
+    id uuid not null primary key,
+    user_id uuid not null references users(id) on delete cascade,
+    name varchar not null,
+    credential_id bytea not null unique,
+    -- the whole credential as the webauthn library serializes it,
+    -- including the public key and the sign counter
+    credential jsonb not null,
+    last_used_at timestamp,
+    created_at timestamp not null,
+    updated_at timestamp not null
+);
+
+create index user_passkeys_user_id_idx on user_passkeys (user_id);
+
+-- +migrate Down
+drop table user_passkeys;
@@ -0,0 +1,19 @@
+-- +migrate Up
+-- the session data is encrypted, this table keeps what the users
+-- need to recognize their sessions, session_key points to http_sessions.key
+create table user_sessions (
+    id uuid not null primary key,
+    user_id uuid not null references users(id) on delete cascade,
+    session_key varchar not null unique,
+    user_agent varchar not null default '',
+    -- only the network part of the address is kept
+    ip varchar not null default '',
+    last_seen_at timestamp not null,
+    created_at timestamp not null,
+    updated_at timestamp not null
+);
+
+create index user_sessions_user_id_idx on user_sessions (user_id);
+
+-- +migrate Down
+drop table user_sessions;
@@ -0,0 +1,32 @@
+-- +migrate Up
+-- rate limits are counted from these rows, which makes
+-- them work the same way no matter how many replicas are running
+create table auth_attempts (
+    id uuid not null primary key,
+    action varchar not null,
+    ip varchar not null,
+    -- email or whatever else identifies the target of the attempt
+    account varchar not null default '',
+    failed boolean not null default false,
+    created_at timestamp not null,
+    updated_at timestamp not null
+);
+
+create index auth_attempts_ip_idx on auth_attempts (action, ip, created_at);
+create index auth_attempts_account_idx on auth_attempts (action, account, created_at);
+
+create table login_lockouts (
+    id uuid not null primary key,
+    email varchar not null,
+    -- address of the last failed attempt
+    ip varchar not null,
+    locked_until timestamp not null,
+    created_at timestamp not null,
+    updated_at timestamp not null
+);
+
+create index login_lockouts_email_idx on login_lockouts (email, locked_until);
+
+-- +migrate Down
+drop table login_lockouts;
+drop table auth_attempts;
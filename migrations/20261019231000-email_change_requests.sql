@@ -0,0 +1,22 @@
+-- +migrate Up
+-- email is changed only after both the old and the new address
+-- have confirmed it, every address gets a token of its own
+create table email_change_requests (
+    id uuid not null primary key,
+    user_id uuid not null references users(id) on delete cascade,
+    old_email varchar not null,
+    new_email varchar not null,
+    old_token_hash varchar not null unique,
+    new_token_hash varchar not null unique,
+    old_confirmed_at timestamp,
+    new_confirmed_at timestamp,
+    completed_at timestamp,
+    expires_at timestamp not null,
+    created_at timestamp not null,
+    updated_at timestamp not null
+);
+
+create index email_change_requests_user_id_idx on email_change_requests (user_id);
+
+-- +migrate Down
+drop table email_change_requests;
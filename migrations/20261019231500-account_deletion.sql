@@ -0,0 +1,32 @@
+-- +migrate Up
+-- the account is deleted once the grace period is over, until then
+-- the user can cancel the deletion
+alter table users add column deletion_scheduled_at timestamp;
+
+create index users_deletion_scheduled_at_idx on users (deletion_scheduled_at)
+where deletion_scheduled_at is not null;
+
+-- comments that have replies from others stay in the thread as tombstones
+alter table post_comments
+    alter column user_id drop not null,
+    add column deleted_at timestamp;
+
+-- the subject of the entry may be gone already, hence no foreign keys
+create table admin_audit_entries (
+    id uuid not null primary key,
+    action varchar not null,
+    subject_id uuid not null,
+    subject varchar not null,
+    details jsonb not null,
+    created_at timestamp not null
+);
+
+create index admin_audit_entries_subject_id_idx on admin_audit_entries (subject_id);
+
+-- +migrate Down
+drop table admin_audit_entries;
+
+alter table post_comments drop column deleted_at;
+
+drop index users_deletion_scheduled_at_idx;
+alter table users drop column deletion_scheduled_at;
@@ -0,0 +1,17 @@
+-- +migrate Up
+-- single use links to log in without a password, the requests
+-- themselves are rate limited with auth_attempts
+create table login_links (
+    id uuid not null primary key,
+    user_id uuid not null references users(id) on delete cascade,
+    token_hash varchar not null unique,
+    expires_at timestamp not null,
+    used_at timestamp,
+    created_at timestamp not null,
+    updated_at timestamp not null
+);
+
+create index login_links_user_id_idx on login_links (user_id);
+
+-- +migrate Down
+drop table login_links;
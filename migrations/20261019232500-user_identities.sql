@@ -0,0 +1,20 @@
+-- +migrate Up
+-- accounts of the external identity providers linked to the users,
+-- the subject is only unique within the issuer
+create table user_identities (
+    id uuid not null primary key,
+    user_id uuid not null references users(id) on delete cascade,
+    issuer varchar not null,
+    subject varchar not null,
+    -- the email the provider had for the user at the last login
+    email varchar not null default '',
+    last_login_at timestamp,
+    created_at timestamp not null,
+    updated_at timestamp not null,
+    unique (issuer, subject)
+);
+
+create index user_identities_user_id_idx on user_identities (user_id);
+
+-- +migrate Down
+drop table user_identities;
@@ -0,0 +1,31 @@
+-- +migrate Up
+-- uploaded files referenced by the posts and the comments, the access
+-- to the media is checked with them instead of looking into the bodies
+create table media_references (
+    id uuid not null primary key,
+    fname varchar not null,
+    post_id uuid not null references posts(id) on delete cascade,
+    -- null for the references from the body of the post itself
+    comment_id uuid references post_comments(id) on delete cascade,
+    created_at timestamp not null,
+    updated_at timestamp not null
+);
+
+create index media_references_fname_idx on media_references (fname);
+create index media_references_post_id_idx on media_references (post_id);
+create index media_references_comment_id_idx on media_references (comment_id);
+
+-- the existing bodies are matched the way the access used to be checked,
+-- file names are uuids, so the substring match is good enough
+insert into media_references (id, fname, post_id, created_at, updated_at)
+select gen_random_uuid(), u.uploaded_fname, p.id, now(), now()
+from media_uploads u
+join posts p on strpos(p.body, u.uploaded_fname) > 0;
+
+insert into media_references (id, fname, post_id, comment_id, created_at, updated_at)
+select gen_random_uuid(), u.uploaded_fname, c.post_id, c.id, now(), now()
+from media_uploads u
+join post_comments c on strpos(c.body, u.uploaded_fname) > 0;
+
+-- +migrate Down
+drop table media_references;
@@ -0,0 +1,17 @@
+-- +migrate Up
+-- the transcoder claims the upload before it starts working on it, so that no
+-- lock is held while ffmpeg runs, claims of the instances that died get stale
+alter type media_rendition_status add value if not exists 'processing';
+
+alter table media_uploads
+add column claimed_at timestamp;
+
+create index media_uploads_claimed_renditions_idx on media_uploads (claimed_at)
+where claimed_at is not null;
+
+-- +migrate Down
+-- the enum value stays, postgres cannot drop it
+update media_uploads set rendition_status = 'pending' where rendition_status = 'processing';
+
+alter table media_uploads
+drop column claimed_at;
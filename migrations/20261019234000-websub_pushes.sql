@@ -0,0 +1,17 @@
+-- +migrate Up
+-- the payloads distributed by the hubs are stored as they come and processed
+-- by the poller, the hub should not wait for the articles and images to be fetched
+create table websub_pushes (
+    id uuid not null primary key,
+    feed_id uuid not null references rss_feeds(id) on delete cascade,
+    body bytea not null,
+    claimed_at timestamp,
+    created_at timestamp not null,
+    updated_at timestamp not null
+);
+
+create index websub_pushes_feed_id_idx on websub_pushes (feed_id);
+create index websub_pushes_created_at_idx on websub_pushes (created_at);
+
+-- +migrate Down
+drop table websub_pushes;
@@ -0,0 +1,9 @@
+-- +migrate Up
+-- the previewer claims the preview before it fetches the page, so that no
+-- lock is held during the fetch, claims of the instances that died get stale
+alter table link_previews
+add column claimed_at timestamp;
+
+-- +migrate Down
+alter table link_previews
+drop column claimed_at;
@@ -0,0 +1,38 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/google/uuid"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const AuditAccountDeleted = "account_deleted"
+
+// RecordAudit keeps the trace of the action for the admins, the subject
+// is described in words, since the record outlives the subject itself
+func RecordAudit(ctx context.Context, exec boil.ContextExecutor, action string, subjectID string, subject string, details any) error {
+	id, err := uuid.NewV7()
+
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(details)
+
+	if err != nil {
+		return err
+	}
+
+	entry := &core.AdminAuditEntry{
+		ID:        id.String(),
+		Action:    action,
+		SubjectID: subjectID,
+		Subject:   subject,
+		Details:   b,
+	}
+
+	return entry.Insert(ctx, exec, boil.Infer())
+}
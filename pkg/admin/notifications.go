@@ -6,6 +6,7 @@ import (
 	"log"
 	"net/mail"
 	"os"
+	"time"
 
 	"github.com/can3p/gogo/sender"
 	"github.com/can3p/pcom/pkg/links"
@@ -168,3 +169,42 @@ func NotifyThrowAwayEmailSignupAttempt(ctx context.Context, exec boil.ContextExe
 		log.Fatal(err)
 	}
 }
+
+func NotifyLoginLockout(ctx context.Context, exec boil.ContextExecutor, s sender.Sender, lockout *core.LoginLockout) {
+	mail := &sender.Mail{
+		From: mail.Address{
+			Address: os.Getenv("SENDER_ADDRESS"),
+			Name:    "Your pcom",
+		},
+		To: []mail.Address{
+			{
+				Address: NotifyAddress,
+			},
+		},
+		Subject: "Login lockout on pcom",
+		Text: fmt.Sprintf(`
+	Hi!
+
+	Logins have been blocked after too many failed attempts:
+
+	* Email: %s
+	* Last IP: %s
+	* Locked until: %s`, lockout.Email, lockout.IP, lockout.LockedUntil.UTC().Format(time.RFC3339)),
+		Html: fmt.Sprintf(`
+	<p>Hi!</p>
+
+	<p>Logins have been blocked after too many failed attempts:</p>
+
+	<ul>
+		<li>Email: %s</li>
+		<li>Last IP: %s</li>
+		<li>Locked until: %s</li>
+	</ul>`, lockout.Email, lockout.IP, lockout.LockedUntil.UTC().Format(time.RFC3339)),
+	}
+
+	err := s.Send(ctx, exec, lockout.ID, "admin_login_lockout", mail)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
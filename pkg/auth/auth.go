@@ -9,7 +9,9 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/can3p/gogo/sender"
@@ -30,8 +32,28 @@ import (
 
 const (
 	userkey = "user"
+	// the time the user has logged in, the proof of identity for the accounts without a password
+	loggedInAtKey = "logged_in_at"
+	// set for the users without the second factor when it's required
+	twoFactorSetupKey = "two_factor_setup"
+	// the id of the logged in user for the routes behind AuthViewer
+	viewerIDKey = "viewer_id"
 )
 
+// RecentLoginTTL is the time the login is good enough to confirm the
+// changes that would otherwise need the password
+const RecentLoginTTL = 10 * time.Minute
+
+func twoFactorSetupPaths() []string {
+	return []string{
+		links.Link("security"),
+		links.Link("form_enable_two_factor"),
+		links.Link("action", "passkey_register_begin"),
+		links.Link("action", "passkey_register_finish"),
+		links.Link("action", "logout"),
+	}
+}
+
 func Auth(c *gin.Context, db *sqlx.DB) {
 	session := sessions.Default(c)
 	user := session.Get(userkey)
@@ -44,11 +66,46 @@ func Auth(c *gin.Context, db *sqlx.DB) {
 
 	if err := pgsession.SetUser(c, db, user.(string)); err != nil {
 		log.Printf("Failed to save user to pgsession, auth won't work as expected: %s", err)
+		c.Next()
+
+		return
+	}
+
+	if err := trackSession(c, db, user.(string)); err != nil {
+		slog.Warn("Failed to track the session", "err", err)
+	}
+
+	if required, err := twoFactorRequired.get(c.Request.Context(), db); err != nil {
+		slog.Warn("Failed to check whether two factor auth is required", "err", err)
+	} else if required {
+		hasSecondFactor, err := HasSecondFactor(c.Request.Context(), db, pgsession.GetUser(c).DBUser)
+
+		if err != nil {
+			slog.Warn("Failed to check whether the user has the second factor", "err", err)
+		}
+
+		c.Set(twoFactorSetupKey, !hasSecondFactor)
+	}
+
+	c.Next()
+}
+
+// AuthViewer only resolves the id of the logged in user from the session.
+// The user is not loaded, the session is not tracked and the second factor is
+// not checked, it's meant for the routes hit as often as the media ones
+func AuthViewer(c *gin.Context) {
+	if userID, ok := sessions.Default(c).Get(userkey).(string); ok {
+		c.Set(viewerIDKey, userID)
 	}
 
 	c.Next()
 }
 
+// GetViewerID returns the user id resolved by AuthViewer, empty for the anonymous visitors
+func GetViewerID(c *gin.Context) string {
+	return c.GetString(viewerIDKey)
+}
+
 func AuthAPI(c *gin.Context, db *sqlx.DB) {
 	apiToken := c.GetHeader("Authorization")
 
@@ -90,6 +147,14 @@ func EnforceAuth(c *gin.Context) {
 		return
 	}
 
+	// the only thing users can do without the second factor
+	// once it's required is to set it up
+	if c.GetBool(twoFactorSetupKey) && !slices.Contains(twoFactorSetupPaths(), c.Request.URL.Path) {
+		c.Redirect(http.StatusFound, links.Link("security"))
+		c.Abort()
+		return
+	}
+
 	c.Next()
 }
 
@@ -117,54 +182,166 @@ func EnforceReferer(c *gin.Context) {
 	c.Next()
 }
 
-func CheckCredentials(c *gin.Context, db boil.ContextExecutor, email string, password string) error {
-	h := pgsession.HashUserPwd(email, password)
+// a hash of a password nobody has, to spend the same time
+// checking the credentials of the users that do not exist
+var missingUserHash = sync.OnceValue(func() string {
+	h, err := pgsession.HashPassword(uuid.NewString())
 
-	_, err := core.Users(
+	if err != nil {
+		panic(err)
+	}
+
+	return h
+})
+
+// findUserByCredentials returns the user along with the flag
+// telling whether the stored hash needs to be upgraded
+func findUserByCredentials(ctx context.Context, db boil.ContextExecutor, email string, password string) (*core.User, bool, error) {
+	user, err := core.Users(
 		core.UserWhere.Email.EQ(email),
-		core.UserWhere.Pwdhash.EQ(null.StringFrom(h)),
 		core.UserWhere.EmailConfirmedAt.IsNotNull(),
-	).One(c.Request.Context(), db)
+	).One(ctx, db)
+
+	if err == sql.ErrNoRows {
+		_, _, _ = pgsession.CheckPassword(email, password, missingUserHash())
+
+		return nil, false, ErrBadCredentials
+	}
 
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return errors.Errorf("Bad credentials")
-		}
+		return nil, false, err
+	}
 
-		return err
+	ok, rehash, err := pgsession.CheckPassword(email, password, user.Pwdhash.String)
+
+	if errors.Is(err, pgsession.ErrPasswordExpired) {
+		return nil, false, errors.Errorf("Your password has expired, please reset it")
+	}
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !ok {
+		return nil, false, ErrBadCredentials
+	}
+
+	return user, rehash, nil
+}
+
+// CheckCredentials returns the user along with the flag telling whether
+// the stored hash needs to be upgraded, the forms keep both to log the user
+// in without computing the hash once more
+func CheckCredentials(c *gin.Context, db boil.ContextExecutor, email string, password string) (*core.User, bool, error) {
+	return findUserByCredentials(c.Request.Context(), db, email, password)
+}
+
+// UpgradePasswordHash stores the password that has just been checked
+// against the legacy hash with the current parameters
+func UpgradePasswordHash(ctx context.Context, db boil.ContextExecutor, user *core.User, password string) error {
+	if err := SetPassword(ctx, db, user, password); err != nil {
+		return errors.Wrapf(err, "Failed to upgrade password hash")
 	}
 
 	return nil
 }
 
 func Login(c *gin.Context, db boil.ContextExecutor, email string, password string) error {
+	user, rehash, err := findUserByCredentials(c.Request.Context(), db, email, password)
+
+	if err != nil {
+		return err
+	}
+
+	if rehash {
+		if err := UpgradePasswordHash(c.Request.Context(), db, user, password); err != nil {
+			return err
+		}
+	}
+
+	return LoginUser(c, db, user)
+}
+
+// LoginUser starts the session of the user who has proven the first factor,
+// ErrSecondFactorRequired is returned if the second one is needed as well.
+// The failed attempts are forgotten only once the session has been started
+func LoginUser(c *gin.Context, db boil.ContextExecutor, user *core.User) error {
 	session := sessions.Default(c)
-	h := pgsession.HashUserPwd(email, password)
 
-	user, err := core.Users(
-		core.UserWhere.Email.EQ(email),
-		core.UserWhere.Pwdhash.EQ(null.StringFrom(h)),
-		core.UserWhere.EmailConfirmedAt.IsNotNull(),
-	).One(c.Request.Context(), db)
+	hasSecondFactor, err := HasSecondFactor(c.Request.Context(), db, user)
 
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return errors.Errorf("Bad credentials")
+		return err
+	}
+
+	if hasSecondFactor {
+		remembered, err := deviceRemembered(c, db, user)
+
+		if err != nil {
+			return err
 		}
 
-		panic(err)
+		if !remembered {
+			if err := startSecondFactor(c, user); err != nil {
+				return err
+			}
+
+			return ErrSecondFactorRequired
+		}
 	}
 
-	session.Set(userkey, user.ID)
+	setSessionUser(session, user.ID)
 
 	if err := session.Save(); err != nil {
 		return errors.Wrapf(err, "Failed to save session")
 	}
 
-	return nil
+	if err := trackSession(c, db, user.ID); err != nil {
+		return err
+	}
+
+	return RecordLoginSuccess(c.Request.Context(), db, c.ClientIP(), user.Email)
+}
+
+// setSessionUser logs the user in, the session remembers when it happened
+func setSessionUser(session sessions.Session, userID string) {
+	session.Set(userkey, userID)
+	session.Set(loggedInAtKey, time.Now().Unix())
 }
 
-func Logout(c *gin.Context) {
+// RecentlyLoggedIn tells whether the user of the session has logged in within RecentLoginTTL
+func RecentlyLoggedIn(c *gin.Context) bool {
+	loggedInAt, ok := sessions.Default(c).Get(loggedInAtKey).(int64)
+
+	return ok && time.Since(time.Unix(loggedInAt, 0)) < RecentLoginTTL
+}
+
+// HasPassword is false for the accounts created with the identity provider and
+// for the ones that have lost the legacy hash, they log in with the links,
+// the provider or the passkeys
+func HasPassword(user *core.User) bool {
+	return user.Pwdhash.String != ""
+}
+
+// SetPassword hashes and stores the new password of the user
+func SetPassword(ctx context.Context, db boil.ContextExecutor, user *core.User, password string) error {
+	h, err := pgsession.HashPassword(password)
+
+	if err != nil {
+		return err
+	}
+
+	user.Pwdhash = null.StringFrom(h)
+
+	_, err = user.Update(ctx, db, boil.Whitelist(
+		core.UserColumns.Pwdhash,
+		core.UserColumns.UpdatedAt,
+	))
+
+	return err
+}
+
+func Logout(c *gin.Context, db boil.ContextExecutor) {
 	session := sessions.Default(c)
 	user := session.Get(userkey)
 	c.Header("HX-Redirect", "/")
@@ -173,7 +350,13 @@ func Logout(c *gin.Context) {
 	if user == nil {
 		return
 	}
+
+	if err := forgetSession(c, db); err != nil {
+		slog.Warn("Failed to forget the session", "err", err)
+	}
+
 	session.Delete(userkey)
+	session.Delete(loggedInAtKey)
 	if err := session.Save(); err != nil {
 		return
 	}
@@ -262,11 +445,17 @@ func AcceptInvite(ctx context.Context, db boil.ContextExecutor, s sender.Sender,
 
 	email := invite.InvitationEmail.String
 
+	h, err := pgsession.HashPassword(password)
+
+	if err != nil {
+		return err
+	}
+
 	u := &core.User{
 		ID:                uuid.NewString(),
 		Email:             email,
 		Username:          username,
-		Pwdhash:           null.StringFrom(pgsession.HashUserPwd(email, password)),
+		Pwdhash:           null.StringFrom(h),
 		EmailConfirmedAt:  null.TimeFrom(time.Now()),
 		SignupAttribution: null.StringFrom("accepted_invite"),
 	}
@@ -275,24 +464,34 @@ func AcceptInvite(ctx context.Context, db boil.ContextExecutor, s sender.Sender,
 		return err
 	}
 
+	if err := useInvite(ctx, db, invite, u); err != nil {
+		return err
+	}
+
+	admin.NotifyNewUser(ctx, db, s, u)
+
+	return nil
+}
+
+// useInvite marks the invite as used by the new user and connects
+// the user with the inviter
+func useInvite(ctx context.Context, db boil.ContextExecutor, invite *core.UserInvitation, u *core.User) error {
 	invite.CreatedUserID = null.StringFrom(u.ID)
 
 	if _, err := invite.Update(ctx, db, boil.Infer()); err != nil {
 		return err
 	}
 
+	if _, _, err := userops.CreateConnection(ctx, db, invite.UserID, u.ID); err != nil {
+		return err
+	}
+
 	// give every new user one new invite to make things (slowly) spread
 	newInvite := &core.UserInvitation{
 		ID:     uuid.NewString(),
 		UserID: u.ID,
 	}
 
-	if _, _, err := userops.CreateConnection(ctx, db, invite.UserID, u.ID); err != nil {
-		return err
-	}
-
-	admin.NotifyNewUser(ctx, db, s, u)
-
 	return newInvite.Insert(ctx, db, boil.Infer())
 }
 
@@ -302,11 +501,17 @@ func Signup(ctx context.Context, db boil.ContextExecutor, sender sender.Sender,
 		return nil, errors.Errorf("Not enough data")
 	}
 
+	h, err := pgsession.HashPassword(password)
+
+	if err != nil {
+		return nil, err
+	}
+
 	u := &core.User{
 		ID:                uuid.NewString(),
 		Email:             email,
 		Username:          username,
-		Pwdhash:           null.StringFrom(pgsession.HashUserPwd(email, password)),
+		Pwdhash:           null.StringFrom(h),
 		EmailConfirmSeed:  null.StringFrom(uuid.NewString()),
 		SignupAttribution: null.NewString(attribution, attribution != ""),
 	}
@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/can3p/pcom/pkg/feedops/testutil"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/pkg/pgsession"
+	"github.com/can3p/pcom/testcontainers/postgres"
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestRecentlyLoggedIn(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+	exec := testDB.DB
+
+	// created with the identity provider
+	user, err := testutil.CreateUser(ctx, exec, "user@example.com")
+	require.NoError(t, err)
+	user.Pwdhash = null.String{}
+	assert.False(t, HasPassword(user))
+
+	require.NoError(t, SetPassword(ctx, exec, user, "the first password"))
+	assert.True(t, HasPassword(user))
+
+	browser := loggedInBrowser(t, exec, user)
+
+	browser.do(func(c *gin.Context) {
+		assert.True(t, RecentlyLoggedIn(c))
+
+		session := sessions.Default(c)
+		session.Set(loggedInAtKey, time.Now().Add(-RecentLoginTTL-time.Second).Unix())
+		require.NoError(t, session.Save())
+	})
+
+	browser.do(func(c *gin.Context) {
+		assert.False(t, RecentlyLoggedIn(c), "the login is too old to prove anything")
+
+		// the sessions started before the login time was kept
+		session := sessions.Default(c)
+		session.Delete(loggedInAtKey)
+		require.NoError(t, session.Save())
+	})
+
+	browser.do(func(c *gin.Context) {
+		assert.False(t, RecentlyLoggedIn(c))
+	})
+}
+
+func TestAuthViewer(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+	exec := testDB.DB
+
+	user, err := testutil.CreateUser(ctx, exec, "user@example.com")
+	require.NoError(t, err)
+
+	untrackedBrowser(t, exec, user).doViewer(func(c *gin.Context) {
+		assert.Equal(t, user.ID, GetViewerID(c))
+		assert.Nil(t, pgsession.GetUser(c), "the user is not loaded")
+	})
+
+	count, err := core.UserSessions().Count(ctx, exec)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count, "the session is not tracked")
+
+	newTestBrowser(t, exec).doViewer(func(c *gin.Context) {
+		assert.Empty(t, GetViewerID(c))
+	})
+}
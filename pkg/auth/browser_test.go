@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/pkg/pgsession"
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// recordingSender keeps the mails instead of sending them
+type recordingSender struct {
+	mails []*sender.Mail
+}
+
+func (s *recordingSender) Send(ctx context.Context, exec boil.ContextExecutor, uniqueID string, emailType string, mail *sender.Mail) error {
+	s.mails = append(s.mails, mail)
+	return nil
+}
+
+func newTestStore(db *sqlx.DB) pgsession.Store {
+	return pgsession.NewStore(db, []byte("test-session-key"))
+}
+
+// testBrowser runs the handlers within the session stored in the database,
+// the cookies are carried over from one request to the next one
+type testBrowser struct {
+	t       *testing.T
+	db      *sqlx.DB
+	store   pgsession.Store
+	cookies []*http.Cookie
+}
+
+func newTestBrowser(t *testing.T, db *sqlx.DB) *testBrowser {
+	return &testBrowser{t: t, db: db, store: newTestStore(db)}
+}
+
+// loggedInBrowser is the browser with the tracked session of the user
+func loggedInBrowser(t *testing.T, db *sqlx.DB, user *core.User) *testBrowser {
+	b := newTestBrowser(t, db)
+
+	b.do(func(c *gin.Context) {
+		require.NoError(t, LoginUser(c, db, user))
+	})
+
+	// the session is tracked by the next request
+	b.do(func(c *gin.Context) {
+		require.Equal(t, user.ID, sessions.Default(c).Get(userkey))
+	})
+
+	return b
+}
+
+// untrackedBrowser is the browser with the session the user has logged in
+// before the sessions were tracked, the next request starts tracking it
+func untrackedBrowser(t *testing.T, db *sqlx.DB, user *core.User) *testBrowser {
+	b := newTestBrowser(t, db)
+
+	b.do(func(c *gin.Context) {
+		session := sessions.Default(c)
+		session.Set(userkey, user.ID)
+		require.NoError(t, session.Save())
+	})
+
+	return b
+}
+
+func (b *testBrowser) do(handler func(c *gin.Context)) {
+	b.serve(func(c *gin.Context) { Auth(c, b.db) }, handler)
+}
+
+// doViewer runs the handler behind the middleware of the media routes
+func (b *testBrowser) doViewer(handler func(c *gin.Context)) {
+	b.serve(AuthViewer, handler)
+}
+
+func (b *testBrowser) serve(auth gin.HandlerFunc, handler func(c *gin.Context)) {
+	r := gin.New()
+	r.GET("/", sessions.Sessions(pgsession.SessionName, b.store), auth, handler)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "203.0.113.7:1234"
+	req.Header.Set("User-Agent", "Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Firefox/128.0")
+
+	for _, cookie := range b.cookies {
+		req.AddCookie(cookie)
+	}
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Less(b.t, w.Code, 400)
+
+	if cookies := w.Result().Cookies(); len(cookies) > 0 {
+		b.cookies = cookies
+	}
+}
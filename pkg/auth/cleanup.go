@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const cleanupEvery = time.Hour
+
+// authRecordsTTL is the longest time the records of the attempts
+// and the requests are looked at by the limits and the links
+func authRecordsTTL() time.Duration {
+	out := max(passwordResetWindow, passwordResetTTL, loginLinkTTL, loginLockoutTTL)
+
+	for _, limit := range attemptLimits {
+		out = max(out, limit.window)
+	}
+
+	return out
+}
+
+// Cleanup removes the records that have outlived their purpose
+func Cleanup(ctx context.Context, exec boil.ContextExecutor) error {
+	if err := DeleteEndedSessions(ctx, exec); err != nil {
+		return errors.Wrap(err, "failed to delete ended sessions")
+	}
+
+	now := time.Now()
+	before := now.Add(-authRecordsTTL())
+
+	if _, err := core.AuthAttempts(
+		core.AuthAttemptWhere.CreatedAt.LT(before),
+	).DeleteAll(ctx, exec); err != nil {
+		return errors.Wrap(err, "failed to delete auth attempts")
+	}
+
+	if _, err := core.LoginLockouts(
+		core.LoginLockoutWhere.LockedUntil.LT(now),
+	).DeleteAll(ctx, exec); err != nil {
+		return errors.Wrap(err, "failed to delete login lockouts")
+	}
+
+	if _, err := core.PasswordResetRequests(
+		core.PasswordResetRequestWhere.CreatedAt.LT(before),
+	).DeleteAll(ctx, exec); err != nil {
+		return errors.Wrap(err, "failed to delete password reset requests")
+	}
+
+	if _, err := core.LoginLinks(
+		core.LoginLinkWhere.CreatedAt.LT(before),
+	).DeleteAll(ctx, exec); err != nil {
+		return errors.Wrap(err, "failed to delete login links")
+	}
+
+	return nil
+}
+
+func RunCleanup(ctx context.Context, db *sqlx.DB) {
+	ticker := time.NewTicker(cleanupEvery)
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := Cleanup(ctx, db); err != nil {
+				slog.Warn("Failed to Cleanup", "err", err.Error())
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/can3p/pcom/pkg/feedops/testutil"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/testcontainers/postgres"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestCleanup(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+	exec := testDB.DB
+
+	user, err := testutil.CreateUser(ctx, exec, "user@example.com")
+	require.NoError(t, err)
+
+	now := time.Now()
+	old := now.Add(-authRecordsTTL() - time.Minute)
+
+	for _, createdAt := range []time.Time{old, now} {
+		attempt := &core.AuthAttempt{ID: uuid.NewString(), Action: AttemptLogin, IP: "203.0.113.7", CreatedAt: createdAt}
+		require.NoError(t, attempt.Insert(ctx, exec, boil.Infer()))
+
+		reset := &core.PasswordResetRequest{ID: uuid.NewString(), Email: user.Email, IP: "203.0.113.7", CreatedAt: createdAt}
+		require.NoError(t, reset.Insert(ctx, exec, boil.Infer()))
+
+		link := &core.LoginLink{ID: uuid.NewString(), UserID: user.ID, TokenHash: uuid.NewString(), ExpiresAt: createdAt.Add(loginLinkTTL), CreatedAt: createdAt}
+		require.NoError(t, link.Insert(ctx, exec, boil.Infer()))
+	}
+
+	for _, lockedUntil := range []time.Time{now.Add(-time.Minute), now.Add(time.Minute)} {
+		lockout := &core.LoginLockout{ID: uuid.NewString(), Email: user.Email, IP: "203.0.113.7", LockedUntil: lockedUntil}
+		require.NoError(t, lockout.Insert(ctx, exec, boil.Infer()))
+	}
+
+	require.NoError(t, Cleanup(ctx, exec))
+
+	attempts, err := core.AuthAttempts().All(ctx, exec)
+	require.NoError(t, err)
+	require.Len(t, attempts, 1)
+	assert.WithinDuration(t, now, attempts[0].CreatedAt, time.Second)
+
+	resets, err := core.PasswordResetRequests().All(ctx, exec)
+	require.NoError(t, err)
+	require.Len(t, resets, 1)
+	assert.WithinDuration(t, now, resets[0].CreatedAt, time.Second)
+
+	links, err := core.LoginLinks().All(ctx, exec)
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+	assert.WithinDuration(t, now, links[0].CreatedAt, time.Second)
+
+	lockouts, err := core.LoginLockouts().All(ctx, exec)
+	require.NoError(t, err)
+	require.Len(t, lockouts, 1)
+	assert.True(t, lockouts[0].LockedUntil.After(now), "the active lockout stays")
+}
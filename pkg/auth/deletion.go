@@ -0,0 +1,403 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	"github.com/can3p/gogo/sender"
+	"github.com/can3p/gogo/util/transact"
+	"github.com/can3p/pcom/pkg/admin"
+	"github.com/can3p/pcom/pkg/mail"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/pkg/pgsession"
+	"github.com/can3p/pcom/pkg/postops"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// AccountDeletionGracePeriod is the time the user has to change their mind
+const AccountDeletionGracePeriod = 14 * 24 * time.Hour
+
+const deleteAccountsEvery = time.Hour
+
+// AccountDeletionStats is what goes into the audit log once the account is gone
+type AccountDeletionStats struct {
+	Posts             int64 `json:"posts"`
+	Comments          int64 `json:"comments"`
+	CommentTombstones int64 `json:"comment_tombstones"`
+	MediaUploads      int64 `json:"media_uploads"`
+	Connections       int64 `json:"connections"`
+	APIKeys           int64 `json:"api_keys"`
+	FeedSubscriptions int64 `json:"feed_subscriptions"`
+	PendingEmails     int64 `json:"pending_emails"`
+}
+
+// ScheduleAccountDeletion marks the account for deletion once the grace period is over
+func ScheduleAccountDeletion(ctx context.Context, exec boil.ContextExecutor, s sender.Sender, user *core.User) error {
+	deleteAt := time.Now().Add(AccountDeletionGracePeriod)
+
+	user.DeletionScheduledAt = null.TimeFrom(deleteAt)
+
+	if _, err := user.Update(ctx, exec, boil.Whitelist(core.UserColumns.DeletionScheduledAt, core.UserColumns.UpdatedAt)); err != nil {
+		return err
+	}
+
+	return mail.AccountDeletionScheduled(ctx, exec, s, user, deleteAt)
+}
+
+func CancelAccountDeletion(ctx context.Context, exec boil.ContextExecutor, user *core.User) error {
+	user.DeletionScheduledAt = null.Time{}
+
+	_, err := user.Update(ctx, exec, boil.Whitelist(core.UserColumns.DeletionScheduledAt, core.UserColumns.UpdatedAt))
+
+	return err
+}
+
+// DeleteAccount removes the user along with everything they have created.
+// The rows go first, the files are deleted once the transaction is committed,
+// since a file without the row cannot be served anyway
+func DeleteAccount(ctx context.Context, db *sqlx.DB, store pgsession.Store, deleteFile postops.MediaDeleter, userID string, now time.Time) error {
+	var uploads core.MediaUploadSlice
+
+	err := transact.Transact(db, func(tx *sql.Tx) error {
+		// the deletion could have been cancelled in the meantime
+		user, err := core.Users(
+			core.UserWhere.ID.EQ(userID),
+			core.UserWhere.DeletionScheduledAt.LTE(null.TimeFrom(now)),
+			qm.For("UPDATE"),
+		).One(ctx, tx)
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		var stats *AccountDeletionStats
+
+		stats, uploads, err = deleteAccountData(ctx, tx, store, user)
+
+		if err != nil {
+			return err
+		}
+
+		if err := admin.RecordAudit(ctx, tx, admin.AuditAccountDeleted, user.ID, fmt.Sprintf("%s <%s>", user.Username, user.Email), stats); err != nil {
+			return err
+		}
+
+		_, err = user.Delete(ctx, tx)
+
+		return err
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, upload := range uploads {
+		// clips come with the files made by the transcoder
+		for _, fname := range []null.String{null.StringFrom(upload.UploadedFname), upload.RenditionFname, upload.PosterFname} {
+			if !fname.Valid {
+				continue
+			}
+
+			if err := deleteFile(ctx, fname.String); err != nil {
+				slog.Warn("Failed to delete the media of the deleted account", "fname", fname.String, "user_id", userID, "err", err.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
+func deleteAccountData(ctx context.Context, exec boil.ContextExecutor, store pgsession.Store, user *core.User) (*AccountDeletionStats, core.MediaUploadSlice, error) {
+	stats := &AccountDeletionStats{}
+
+	posts, err := core.Posts(
+		core.PostWhere.UserID.EQ(user.ID),
+	).All(ctx, exec)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, post := range posts {
+		if err := postops.DeletePost(ctx, exec, post.ID); err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to delete post %s", post.ID)
+		}
+	}
+
+	stats.Posts = int64(len(posts))
+
+	if err := deleteAccountComments(ctx, exec, user, stats); err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := core.PostPrompts(
+		qm.Expr(
+			core.PostPromptWhere.AskerID.EQ(user.ID),
+			qm.Or2(core.PostPromptWhere.RecipientID.EQ(user.ID)),
+		),
+	).DeleteAll(ctx, exec); err != nil {
+		return nil, nil, err
+	}
+
+	if err := deleteAccountConnections(ctx, exec, user, stats); err != nil {
+		return nil, nil, err
+	}
+
+	stats.APIKeys, err = core.UserAPIKeys(
+		core.UserAPIKeyWhere.UserID.EQ(user.ID),
+	).DeleteAll(ctx, exec)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := core.UserStyles(
+		core.UserStyleWhere.UserID.EQ(user.ID),
+	).DeleteAll(ctx, exec); err != nil {
+		return nil, nil, err
+	}
+
+	if err := deleteAccountFeeds(ctx, exec, user, stats); err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := core.UserInvitations(
+		core.UserInvitationWhere.UserID.EQ(user.ID),
+	).DeleteAll(ctx, exec); err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := core.UserInvitations(
+		core.UserInvitationWhere.CreatedUserID.EQ(null.StringFrom(user.ID)),
+	).UpdateAll(ctx, exec, core.M{core.UserInvitationColumns.CreatedUserID: nil}); err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := core.UserSignupRequests(
+		core.UserSignupRequestWhere.CreatedUserID.EQ(null.StringFrom(user.ID)),
+	).UpdateAll(ctx, exec, core.M{core.UserSignupRequestColumns.CreatedUserID: nil}); err != nil {
+		return nil, nil, err
+	}
+
+	uploads, err := core.MediaUploads(
+		core.MediaUploadWhere.UserID.EQ(null.StringFrom(user.ID)),
+	).All(ctx, exec)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := uploads.DeleteAll(ctx, exec); err != nil {
+		return nil, nil, err
+	}
+
+	stats.MediaUploads = int64(len(uploads))
+
+	if err := DeleteUserSessions(ctx, exec, store, user.ID); err != nil {
+		return nil, nil, err
+	}
+
+	// mail.Address has no json tags, hence the capitalized field name
+	stats.PendingEmails, err = core.OutgoingEmails(
+		core.OutgoingEmailWhere.Status.EQ(core.OutgoingEmailStatusNew),
+		qm.Where("payload->'To' @> jsonb_build_array(jsonb_build_object('Address', ?::text))", user.Email),
+	).DeleteAll(ctx, exec)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return stats, uploads, nil
+}
+
+// deleteAccountComments removes the comments of the user that nobody has replied to,
+// leaf first, whatever is left has replies from others and becomes a tombstone
+func deleteAccountComments(ctx context.Context, exec boil.ContextExecutor, user *core.User, stats *AccountDeletionStats) error {
+	for {
+		leaves, err := core.PostComments(
+			core.PostCommentWhere.UserID.EQ(null.StringFrom(user.ID)),
+			qm.Where("not exists (select 1 from post_comments replies where replies.parent_comment_id = post_comments.id)"),
+		).All(ctx, exec)
+
+		if err != nil {
+			return err
+		}
+
+		if len(leaves) == 0 {
+			break
+		}
+
+		if _, err := leaves.DeleteAll(ctx, exec); err != nil {
+			return err
+		}
+
+		for postID, count := range lo.CountValuesBy(leaves, func(c *core.PostComment) string { return c.PostID }) {
+			if _, err := exec.ExecContext(ctx, "update post_stats set comments_number = comments_number - $1 where post_id = $2", count, postID); err != nil {
+				return err
+			}
+		}
+
+		stats.Comments += int64(len(leaves))
+	}
+
+	// the bodies of the tombstones are gone, so are the files they referred to
+	if _, err := core.MediaReferences(
+		qm.Where("comment_id in (select id from post_comments where user_id = ?)", user.ID),
+	).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+
+	tombstones, err := core.PostComments(
+		core.PostCommentWhere.UserID.EQ(null.StringFrom(user.ID)),
+	).UpdateAll(ctx, exec, core.M{
+		core.PostCommentColumns.UserID:    nil,
+		core.PostCommentColumns.Body:      "",
+		core.PostCommentColumns.DeletedAt: time.Now(),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	stats.CommentTombstones = tombstones
+
+	return nil
+}
+
+func deleteAccountConnections(ctx context.Context, exec boil.ContextExecutor, user *core.User, stats *AccountDeletionStats) error {
+	mediationRequests, err := core.UserConnectionMediationRequests(
+		qm.Expr(
+			core.UserConnectionMediationRequestWhere.WhoUserID.EQ(user.ID),
+			qm.Or2(core.UserConnectionMediationRequestWhere.TargetUserID.EQ(user.ID)),
+		),
+	).All(ctx, exec)
+
+	if err != nil {
+		return err
+	}
+
+	mediationIDs := lo.Map(mediationRequests, func(r *core.UserConnectionMediationRequest, idx int) string { return r.ID })
+
+	if _, err := core.UserConnectionMediators(
+		qm.Expr(
+			core.UserConnectionMediatorWhere.UserID.EQ(user.ID),
+			qm.Or2(core.UserConnectionMediatorWhere.MediationID.IN(mediationIDs)),
+		),
+	).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+
+	if _, err := mediationRequests.DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+
+	if _, err := core.WhitelistedConnections(
+		qm.Expr(
+			core.WhitelistedConnectionWhere.WhoID.EQ(user.ID),
+			qm.Or2(core.WhitelistedConnectionWhere.AllowsWhoID.EQ(user.ID)),
+		),
+	).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+
+	// every connection is stored in both directions
+	connections, err := core.UserConnections(
+		qm.Expr(
+			core.UserConnectionWhere.User1ID.EQ(user.ID),
+			qm.Or2(core.UserConnectionWhere.User2ID.EQ(user.ID)),
+		),
+	).DeleteAll(ctx, exec)
+
+	if err != nil {
+		return err
+	}
+
+	stats.Connections = connections / 2
+
+	return nil
+}
+
+func deleteAccountFeeds(ctx context.Context, exec boil.ContextExecutor, user *core.User, stats *AccountDeletionStats) error {
+	if _, err := core.UserFeedRules(
+		core.UserFeedRuleWhere.UserID.EQ(user.ID),
+	).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+
+	if _, err := core.UserFeedItems(
+		core.UserFeedItemWhere.UserID.EQ(user.ID),
+	).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+
+	subscriptions, err := core.UserFeedSubscriptions(
+		core.UserFeedSubscriptionWhere.UserID.EQ(user.ID),
+	).DeleteAll(ctx, exec)
+
+	if err != nil {
+		return err
+	}
+
+	stats.FeedSubscriptions = subscriptions
+
+	_, err = core.UserFeedFolders(
+		core.UserFeedFolderWhere.UserID.EQ(user.ID),
+	).DeleteAll(ctx, exec)
+
+	return err
+}
+
+// DeleteScheduledAccounts deletes the accounts once their grace period is over
+func DeleteScheduledAccounts(ctx context.Context, db *sqlx.DB, store pgsession.Store, deleteFile postops.MediaDeleter, now time.Time) (err error) {
+	// a single broken account should never crash the scheduler
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = fmt.Errorf("DeleteScheduledAccounts panicked: %v - %s", panicErr, string(debug.Stack()))
+		}
+	}()
+
+	users, err := core.Users(
+		core.UserWhere.DeletionScheduledAt.LTE(null.TimeFrom(now)),
+	).All(ctx, db)
+
+	if err != nil {
+		return err
+	}
+
+	for _, user := range users {
+		if err := DeleteAccount(ctx, db, store, deleteFile, user.ID, now); err != nil {
+			slog.Warn("Failed to delete the account", "user_id", user.ID, "err", err.Error())
+			continue
+		}
+
+		slog.Info("Deleted the account", "user_id", user.ID)
+	}
+
+	return nil
+}
+
+func RunAccountDeleter(ctx context.Context, db *sqlx.DB, store pgsession.Store, deleteFile postops.MediaDeleter) {
+	ticker := time.NewTicker(deleteAccountsEvery)
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := DeleteScheduledAccounts(ctx, db, store, deleteFile, time.Now()); err != nil {
+				slog.Warn("Failed to DeleteScheduledAccounts", "err", err.Error())
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
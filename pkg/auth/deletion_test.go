@@ -0,0 +1,179 @@
+package auth
+
+import (
+	"context"
+	"net/mail"
+	"testing"
+	"time"
+
+	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/admin"
+	"github.com/can3p/pcom/pkg/feedops/testutil"
+	"github.com/can3p/pcom/pkg/mail/sender/dbsender"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/pkg/postops"
+	"github.com/can3p/pcom/testcontainers/postgres"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestDeleteAccount(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+	exec := testDB.DB
+
+	user, err := testutil.CreateUser(ctx, exec, "leaving@example.com")
+	require.NoError(t, err)
+	other, err := testutil.CreateUser(ctx, exec, "staying@example.com")
+	require.NoError(t, err)
+
+	createPost := func(authorID string) *core.Post {
+		p := &core.Post{
+			ID:               uuid.NewString(),
+			Subject:          null.StringFrom("test"),
+			Body:             "body",
+			UserID:           authorID,
+			VisibilityRadius: core.PostVisibilityDirectOnly,
+			PublishedAt:      null.TimeFrom(time.Now()),
+		}
+		require.NoError(t, p.Insert(ctx, exec, boil.Infer()))
+
+		return p
+	}
+
+	createComment := func(authorID string, postID string, parent *core.PostComment, body string) *core.PostComment {
+		id := uuid.NewString()
+		c := &core.PostComment{
+			ID:           id,
+			UserID:       null.StringFrom(authorID),
+			PostID:       postID,
+			Body:         body,
+			TopCommentID: id,
+		}
+
+		if parent != nil {
+			c.ParentCommentID = null.StringFrom(parent.ID)
+			c.TopCommentID = parent.TopCommentID
+		}
+
+		require.NoError(t, c.Insert(ctx, exec, boil.Infer()))
+		require.NoError(t, postops.SyncCommentMediaReferences(ctx, exec, c))
+
+		return c
+	}
+
+	ownPost := createPost(user.ID)
+	createComment(other.ID, ownPost.ID, nil, "a comment under the post that goes away")
+
+	otherPost := createPost(other.ID)
+	leaf := createComment(user.ID, otherPost.ID, nil, "nobody has replied")
+	replied := createComment(user.ID, otherPost.ID, nil, "![]("+uuid.NewString()+".jpg)")
+	reply := createComment(other.ID, otherPost.ID, replied, "the reply stays")
+
+	for _, pair := range [][2]string{{user.ID, other.ID}, {other.ID, user.ID}} {
+		conn := &core.UserConnection{ID: uuid.NewString(), User1ID: pair[0], User2ID: pair[1]}
+		require.NoError(t, conn.Insert(ctx, exec, boil.Infer()))
+	}
+
+	feed, err := testutil.CreateRSSFeed(ctx, exec, "https://example.com/feed", "Feed")
+	require.NoError(t, err)
+	_, err = testutil.CreateUserFeedSubscription(ctx, exec, user.ID, feed.ID)
+	require.NoError(t, err)
+	_, err = testutil.CreateUserFeedSubscription(ctx, exec, other.ID, feed.ID)
+	require.NoError(t, err)
+
+	upload := &core.MediaUpload{
+		ID:              uuid.NewString(),
+		UserID:          null.StringFrom(user.ID),
+		UploadedFname:   uuid.NewString() + ".mov",
+		ContentType:     "video/quicktime",
+		Kind:            core.MediaKindVideo,
+		RenditionStatus: core.NullMediaRenditionStatusFrom(core.MediaRenditionStatusReady),
+		RenditionFname:  null.StringFrom("web.mp4"),
+		PosterFname:     null.StringFrom("poster.jpg"),
+	}
+	require.NoError(t, upload.Insert(ctx, exec, boil.Infer()))
+
+	queue := dbsender.NewSender(exec, &recordingSender{})
+
+	for _, address := range []string{user.Email, other.Email} {
+		require.NoError(t, queue.Send(ctx, exec, uuid.NewString(), "test", &sender.Mail{
+			To:      []mail.Address{{Address: address}},
+			Subject: "queued",
+		}))
+	}
+
+	loggedInBrowser(t, exec, user)
+	loggedInBrowser(t, exec, other)
+
+	require.NoError(t, ScheduleAccountDeletion(ctx, exec, &recordingSender{}, user))
+
+	var deleted []string
+	deleteFile := func(ctx context.Context, fname string) error {
+		deleted = append(deleted, fname)
+		return nil
+	}
+
+	// the grace period is not over yet
+	require.NoError(t, DeleteAccount(ctx, exec, newTestStore(exec), deleteFile, user.ID, time.Now()))
+	require.NoError(t, user.Reload(ctx, exec))
+
+	require.NoError(t, DeleteAccount(ctx, exec, newTestStore(exec), deleteFile, user.ID, time.Now().Add(AccountDeletionGracePeriod+time.Minute)))
+
+	exists, err := core.UserExists(ctx, exec, user.ID)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	assert.ElementsMatch(t, []string{upload.UploadedFname, "web.mp4", "poster.jpg"}, deleted)
+
+	count := func(q interface {
+		Count(context.Context, boil.ContextExecutor) (int64, error)
+	}) int64 {
+		n, err := q.Count(ctx, exec)
+		require.NoError(t, err)
+
+		return n
+	}
+
+	assert.Equal(t, int64(0), count(core.Posts(core.PostWhere.UserID.EQ(user.ID))))
+	assert.Equal(t, int64(0), count(core.PostComments(core.PostCommentWhere.PostID.EQ(ownPost.ID))), "the comments go with the post")
+	assert.Equal(t, int64(0), count(core.PostComments(core.PostCommentWhere.ID.EQ(leaf.ID))))
+	assert.Equal(t, int64(0), count(core.PostComments(core.PostCommentWhere.UserID.EQ(null.StringFrom(user.ID)))))
+	assert.Equal(t, int64(0), count(core.MediaReferences(core.MediaReferenceWhere.CommentID.EQ(null.StringFrom(replied.ID)))))
+	assert.Equal(t, int64(0), count(core.UserConnections()))
+	assert.Equal(t, int64(0), count(core.UserFeedSubscriptions(core.UserFeedSubscriptionWhere.UserID.EQ(user.ID))))
+	assert.Equal(t, int64(0), count(core.MediaUploads(core.MediaUploadWhere.UserID.EQ(null.StringFrom(user.ID)))))
+	assert.Equal(t, int64(0), count(core.UserSessions(core.UserSessionWhere.UserID.EQ(user.ID))))
+
+	// whatever belongs to others stays
+	tombstone, err := core.FindPostComment(ctx, exec, replied.ID)
+	require.NoError(t, err)
+	assert.False(t, tombstone.UserID.Valid)
+	assert.Equal(t, "", tombstone.Body)
+	assert.True(t, tombstone.DeletedAt.Valid)
+
+	_, err = core.FindPostComment(ctx, exec, reply.ID)
+	assert.NoError(t, err)
+
+	_, err = core.FindPost(ctx, exec, otherPost.ID)
+	assert.NoError(t, err)
+
+	assert.Equal(t, int64(1), count(core.UserFeedSubscriptions(core.UserFeedSubscriptionWhere.UserID.EQ(other.ID))))
+	assert.Equal(t, int64(1), count(core.UserSessions(core.UserSessionWhere.UserID.EQ(other.ID))))
+
+	emails, err := core.OutgoingEmails(core.OutgoingEmailWhere.EmailType.EQ("test")).All(ctx, exec)
+	require.NoError(t, err)
+	require.Len(t, emails, 1)
+	assert.Contains(t, string(emails[0].Payload), other.Email)
+
+	audit, err := core.AdminAuditEntries(core.AdminAuditEntryWhere.SubjectID.EQ(user.ID)).One(ctx, exec)
+	require.NoError(t, err)
+	assert.Equal(t, admin.AuditAccountDeleted, audit.Action)
+	assert.JSONEq(t, `{"posts":1,"comments":1,"comment_tombstones":1,"media_uploads":1,"connections":1,"api_keys":0,"feed_subscriptions":1,"pending_emails":1}`, string(audit.Details))
+}
@@ -0,0 +1,177 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/mail"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/pkg/pgsession"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const emailChangeTTL = 24 * time.Hour
+
+var ErrEmailTaken = errors.New("email is already used in the system")
+
+// RequestEmailChange sends the confirmation links to both addresses,
+// the previous unfinished requests of the user are dropped
+func RequestEmailChange(ctx context.Context, exec boil.ContextExecutor, s sender.Sender, user *core.User, newEmail string) error {
+	if _, err := core.EmailChangeRequests(
+		core.EmailChangeRequestWhere.UserID.EQ(user.ID),
+		core.EmailChangeRequestWhere.CompletedAt.IsNull(),
+	).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+
+	oldToken, err := newRandomToken()
+
+	if err != nil {
+		return err
+	}
+
+	newToken, err := newRandomToken()
+
+	if err != nil {
+		return err
+	}
+
+	request := &core.EmailChangeRequest{
+		ID:           uuid.NewString(),
+		UserID:       user.ID,
+		OldEmail:     user.Email,
+		NewEmail:     newEmail,
+		OldTokenHash: hashToken(oldToken),
+		NewTokenHash: hashToken(newToken),
+		ExpiresAt:    time.Now().Add(emailChangeTTL),
+	}
+
+	if err := request.Insert(ctx, exec, boil.Infer()); err != nil {
+		return err
+	}
+
+	if err := mail.ConfirmEmailChange(ctx, exec, s, request, request.OldEmail, oldToken); err != nil {
+		return err
+	}
+
+	return mail.ConfirmEmailChange(ctx, exec, s, request, request.NewEmail, newToken)
+}
+
+// PendingEmailChange returns the unfinished request of the user, if any
+func PendingEmailChange(ctx context.Context, exec boil.ContextExecutor, user *core.User) (*core.EmailChangeRequest, error) {
+	request, err := core.EmailChangeRequests(
+		core.EmailChangeRequestWhere.UserID.EQ(user.ID),
+		core.EmailChangeRequestWhere.CompletedAt.IsNull(),
+		core.EmailChangeRequestWhere.ExpiresAt.GT(time.Now()),
+		qm.OrderBy(core.EmailChangeRequestColumns.CreatedAt+" desc"),
+	).One(ctx, exec)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	return request, err
+}
+
+// FindEmailChange returns the request with the user loaded, sql.ErrNoRows is
+// returned for the tokens that are unknown, expired or belong to a completed request
+func FindEmailChange(ctx context.Context, exec boil.ContextExecutor, token string, mods ...qm.QueryMod) (*core.EmailChangeRequest, error) {
+	hash := hashToken(token)
+
+	mods = append([]qm.QueryMod{
+		qm.Expr(
+			core.EmailChangeRequestWhere.OldTokenHash.EQ(hash),
+			qm.Or2(core.EmailChangeRequestWhere.NewTokenHash.EQ(hash)),
+		),
+		core.EmailChangeRequestWhere.CompletedAt.IsNull(),
+		core.EmailChangeRequestWhere.ExpiresAt.GT(time.Now()),
+		qm.Load(core.EmailChangeRequestRels.User),
+	}, mods...)
+
+	return core.EmailChangeRequests(mods...).One(ctx, exec)
+}
+
+// ConfirmEmailChange marks the address the token was sent to as confirmed and changes
+// the email once both of them are. sql.ErrNoRows means the token is unknown or expired
+func ConfirmEmailChange(ctx context.Context, exec boil.ContextExecutor, s sender.Sender, token string) (*core.EmailChangeRequest, error) {
+	hash := hashToken(token)
+
+	request, err := FindEmailChange(ctx, exec, token, qm.For("update"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if request.OldTokenHash == hash {
+		request.OldConfirmedAt = null.TimeFrom(time.Now())
+	} else {
+		request.NewConfirmedAt = null.TimeFrom(time.Now())
+	}
+
+	if request.OldConfirmedAt.Valid && request.NewConfirmedAt.Valid {
+		if err := completeEmailChange(ctx, exec, s, request); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := request.Update(ctx, exec, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func completeEmailChange(ctx context.Context, exec boil.ContextExecutor, s sender.Sender, request *core.EmailChangeRequest) error {
+	user := request.R.User
+
+	// somebody might have signed up with the address in the meantime
+	taken, err := core.Users(
+		core.UserWhere.Email.EQ(request.NewEmail),
+		core.UserWhere.ID.NEQ(user.ID),
+	).Exists(ctx, exec)
+
+	if err != nil {
+		return err
+	}
+
+	if taken {
+		return ErrEmailTaken
+	}
+
+	// legacy hashes are salted with the email and would stop matching,
+	// the only way out for such users is to reset the password
+	if pgsession.IsLegacyHash(user.Pwdhash.String) {
+		user.Pwdhash = null.String{}
+	}
+
+	user.Email = request.NewEmail
+
+	if _, err := user.Update(ctx, exec, boil.Whitelist(
+		core.UserColumns.Email,
+		core.UserColumns.Pwdhash,
+		core.UserColumns.UpdatedAt,
+	)); err != nil {
+		return err
+	}
+
+	// the links sent to the old address should not work anymore
+	if _, err := core.PasswordResetRequests(
+		core.PasswordResetRequestWhere.UserID.EQ(null.StringFrom(user.ID)),
+		core.PasswordResetRequestWhere.UsedAt.IsNull(),
+	).UpdateAll(ctx, exec, core.M{
+		core.PasswordResetRequestColumns.UsedAt:    time.Now(),
+		core.PasswordResetRequestColumns.UpdatedAt: time.Now(),
+	}); err != nil {
+		return err
+	}
+
+	request.CompletedAt = null.TimeFrom(time.Now())
+
+	return mail.EmailChanged(ctx, exec, s, request)
+}
@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/can3p/pcom/pkg/feedops/testutil"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/testcontainers/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var emailChangeLinkRe = regexp.MustCompile(`confirm_email_change/([A-Za-z0-9_-]+)`)
+
+func TestEmailChange(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+	exec := testDB.DB
+
+	user, err := testutil.CreateUser(ctx, exec, "old@example.com")
+	require.NoError(t, err)
+
+	// requestTokens returns the tokens sent to the old and the new address
+	requestTokens := func(newEmail string) (string, string) {
+		s := &recordingSender{}
+		require.NoError(t, RequestEmailChange(ctx, exec, s, user, newEmail))
+		require.Len(t, s.mails, 2)
+
+		tokens := map[string]string{}
+
+		for _, m := range s.mails {
+			match := emailChangeLinkRe.FindStringSubmatch(m.Text)
+			require.Len(t, match, 2)
+
+			tokens[m.To[0].Address] = match[1]
+		}
+
+		require.Contains(t, tokens, user.Email)
+		require.Contains(t, tokens, newEmail)
+
+		return tokens[user.Email], tokens[newEmail]
+	}
+
+	t.Run("both tokens", func(t *testing.T) {
+		oldToken, newToken := requestTokens("new@example.com")
+		s := &recordingSender{}
+
+		request, err := FindEmailChange(ctx, exec, newToken)
+		require.NoError(t, err)
+		assert.False(t, request.NewConfirmedAt.Valid, "opening the link confirms nothing")
+
+		request, err = ConfirmEmailChange(ctx, exec, s, newToken)
+		require.NoError(t, err)
+		assert.True(t, request.NewConfirmedAt.Valid)
+		assert.False(t, request.CompletedAt.Valid)
+
+		require.NoError(t, user.Reload(ctx, exec))
+		assert.Equal(t, "old@example.com", user.Email, "the old address has not confirmed the change yet")
+
+		request, err = ConfirmEmailChange(ctx, exec, s, oldToken)
+		require.NoError(t, err)
+		assert.True(t, request.CompletedAt.Valid)
+
+		require.NoError(t, user.Reload(ctx, exec))
+		assert.Equal(t, "new@example.com", user.Email)
+
+		require.Len(t, s.mails, 1)
+		assert.Equal(t, "old@example.com", s.mails[0].To[0].Address, "the old address learns about the change")
+
+		_, err = ConfirmEmailChange(ctx, exec, s, newToken)
+		assert.ErrorIs(t, err, sql.ErrNoRows, "the request is complete")
+	})
+
+	t.Run("taken in the meantime", func(t *testing.T) {
+		oldToken, newToken := requestTokens("taken@example.com")
+		s := &recordingSender{}
+
+		_, err := ConfirmEmailChange(ctx, exec, s, oldToken)
+		require.NoError(t, err)
+
+		_, err = testutil.CreateUser(ctx, exec, "taken@example.com")
+		require.NoError(t, err)
+
+		_, err = ConfirmEmailChange(ctx, exec, s, newToken)
+		assert.ErrorIs(t, err, ErrEmailTaken)
+
+		require.NoError(t, user.Reload(ctx, exec))
+		assert.Equal(t, "new@example.com", user.Email)
+		assert.Empty(t, s.mails)
+
+		request, err := core.EmailChangeRequests(core.EmailChangeRequestWhere.NewEmail.EQ("taken@example.com")).One(ctx, exec)
+		require.NoError(t, err)
+		assert.False(t, request.CompletedAt.Valid)
+	})
+}
@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/mail"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const loginLinkTTL = 15 * time.Minute
+
+// RequestLoginLink sends the login link in case the email belongs to a confirmed user,
+// the caller gets no indication of whether the user exists. The return url
+// is expected to be checked against the sign already
+func RequestLoginLink(ctx context.Context, exec boil.ContextExecutor, s sender.Sender, email string, returnURL string, sign string) error {
+	user, err := core.Users(
+		core.UserWhere.Email.EQ(email),
+		core.UserWhere.EmailConfirmedAt.IsNotNull(),
+	).One(ctx, exec)
+
+	if err == sql.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	token, err := newRandomToken()
+
+	if err != nil {
+		return err
+	}
+
+	loginLink := &core.LoginLink{
+		ID:        uuid.NewString(),
+		UserID:    user.ID,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().Add(loginLinkTTL),
+	}
+
+	if err := loginLink.Insert(ctx, exec, boil.Infer()); err != nil {
+		return err
+	}
+
+	return mail.LoginLink(ctx, exec, s, user, loginLink, token, returnURL, sign)
+}
+
+// FindLoginLink returns the link with the user loaded, sql.ErrNoRows
+// is returned for the tokens that are unknown, used or expired
+func FindLoginLink(ctx context.Context, exec boil.ContextExecutor, token string, mods ...qm.QueryMod) (*core.LoginLink, error) {
+	mods = append([]qm.QueryMod{
+		core.LoginLinkWhere.TokenHash.EQ(hashToken(token)),
+		core.LoginLinkWhere.UsedAt.IsNull(),
+		core.LoginLinkWhere.ExpiresAt.GT(time.Now()),
+		qm.Load(core.LoginLinkRels.User),
+	}, mods...)
+
+	return core.LoginLinks(mods...).One(ctx, exec)
+}
+
+// UseLoginLink burns the link and returns the user it belongs to,
+// the caller is expected to log the user in right away
+func UseLoginLink(ctx context.Context, exec boil.ContextExecutor, token string) (*core.User, error) {
+	loginLink, err := FindLoginLink(ctx, exec, token, qm.For("UPDATE"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	loginLink.UsedAt = null.TimeFrom(time.Now())
+
+	if _, err := loginLink.Update(ctx, exec, boil.Whitelist(
+		core.LoginLinkColumns.UsedAt,
+		core.LoginLinkColumns.UpdatedAt,
+	)); err != nil {
+		return nil, err
+	}
+
+	return loginLink.R.User, nil
+}
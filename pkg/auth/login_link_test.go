@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/can3p/pcom/pkg/feedops/testutil"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/testcontainers/postgres"
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+var loginLinkRe = regexp.MustCompile(`login_link/([A-Za-z0-9_-]+)`)
+
+func TestLoginLink(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+	exec := testDB.DB
+
+	user, err := testutil.CreateUser(ctx, exec, "user@example.com")
+	require.NoError(t, err)
+
+	s := &recordingSender{}
+	require.NoError(t, RequestLoginLink(ctx, exec, s, user.Email, "", ""))
+	require.NoError(t, RequestLoginLink(ctx, exec, s, "nobody@example.com", "", ""))
+	assert.Empty(t, s.mails, "only the confirmed users get the link")
+
+	user.EmailConfirmedAt = null.TimeFrom(time.Now())
+	_, err = user.Update(ctx, exec, boil.Infer())
+	require.NoError(t, err)
+
+	requestToken := func() string {
+		s := &recordingSender{}
+		require.NoError(t, RequestLoginLink(ctx, exec, s, user.Email, "", ""))
+		require.Len(t, s.mails, 1)
+
+		match := loginLinkRe.FindStringSubmatch(s.mails[0].Text)
+		require.Len(t, match, 2)
+
+		return match[1]
+	}
+
+	t.Run("single use", func(t *testing.T) {
+		token := requestToken()
+
+		used, err := UseLoginLink(ctx, exec, token)
+		require.NoError(t, err)
+		assert.Equal(t, user.ID, used.ID)
+
+		_, err = UseLoginLink(ctx, exec, token)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+
+		_, err = FindLoginLink(ctx, exec, token)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		token := requestToken()
+
+		loginLink, err := FindLoginLink(ctx, exec, token)
+		require.NoError(t, err)
+		assert.Equal(t, hashToken(token), loginLink.TokenHash)
+		assert.WithinDuration(t, time.Now().Add(15*time.Minute), loginLink.ExpiresAt, time.Minute)
+
+		loginLink.ExpiresAt = time.Now().Add(-time.Second)
+		_, err = loginLink.Update(ctx, exec, boil.Infer())
+		require.NoError(t, err)
+
+		_, err = UseLoginLink(ctx, exec, token)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("second factor", func(t *testing.T) {
+		user.TotpSecret = null.StringFrom("JBSWY3DPEHPK3PXP")
+		user.TotpEnabledAt = null.TimeFrom(time.Now())
+		_, err := user.Update(ctx, exec, boil.Infer())
+		require.NoError(t, err)
+
+		token := requestToken()
+		browser := newTestBrowser(t, exec)
+
+		// the link replaces the password, not the second factor
+		browser.do(func(c *gin.Context) {
+			linked, err := UseLoginLink(c, exec, token)
+			require.NoError(t, err)
+			assert.ErrorIs(t, LoginUser(c, exec, linked), ErrSecondFactorRequired)
+		})
+
+		browser.do(func(c *gin.Context) {
+			assert.Nil(t, sessions.Default(c).Get(userkey))
+
+			pending, err := PendingSecondFactorUser(c, exec)
+			require.NoError(t, err)
+			require.NotNil(t, pending)
+			assert.Equal(t, user.ID, pending.ID)
+		})
+
+		tracked, err := core.UserSessions(core.UserSessionWhere.UserID.EQ(user.ID)).Count(ctx, exec)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), tracked, "no session has started yet")
+	})
+}
@@ -0,0 +1,512 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/json"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/admin"
+	"github.com/can3p/pcom/pkg/links"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"golang.org/x/oauth2"
+)
+
+const (
+	oidcFlowKey = "oidc_flow"
+	// the user has this much time to come back from the provider
+	// and then again to link the account or to sign up
+	oidcFlowTTL = 10 * time.Minute
+)
+
+// OIDCRequiredEnv is checked once OIDC_ISSUER is set
+var OIDCRequiredEnv = []string{"OIDC_CLIENT_ID", "OIDC_CLIENT_SECRET"}
+
+// ErrOIDCFailed hides the details of a failed login, the user
+// can do nothing about them other than to try again
+var ErrOIDCFailed = errors.New("external login failed")
+
+// OIDCConfig describes the single identity provider the instance trusts
+type OIDCConfig struct {
+	// Name is shown to the users on the login buttons
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+}
+
+// OIDCConfigFromEnv returns nil when no provider is configured,
+// the login with the provider is not offered then
+func OIDCConfigFromEnv() *OIDCConfig {
+	issuer := os.Getenv("OIDC_ISSUER")
+
+	if issuer == "" {
+		return nil
+	}
+
+	name := os.Getenv("OIDC_NAME")
+
+	if name == "" {
+		name = "SSO"
+	}
+
+	return &OIDCConfig{
+		Name:         name,
+		Issuer:       issuer,
+		ClientID:     os.Getenv("OIDC_CLIENT_ID"),
+		ClientSecret: os.Getenv("OIDC_CLIENT_SECRET"),
+		RedirectURL:  links.AbsLink("oidc_callback"),
+	}
+}
+
+type OIDC struct {
+	config OIDCConfig
+
+	mu       sync.Mutex
+	provider *oidc.Provider
+}
+
+func NewOIDC(config OIDCConfig) *OIDC {
+	return &OIDC{config: config}
+}
+
+func (o *OIDC) Name() string {
+	return o.config.Name
+}
+
+// discover fetches the configuration of the provider on the first use,
+// failures are not remembered since the provider might just be down
+func (o *OIDC) discover(ctx context.Context) (*oidc.Provider, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.provider != nil {
+		return o.provider, nil
+	}
+
+	provider, err := oidc.NewProvider(ctx, o.config.Issuer)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to discover the identity provider")
+	}
+
+	o.provider = provider
+
+	return provider, nil
+}
+
+func (o *OIDC) oauth2Config(provider *oidc.Provider) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     o.config.ClientID,
+		ClientSecret: o.config.ClientSecret,
+		RedirectURL:  o.config.RedirectURL,
+		Endpoint:     provider.Endpoint(),
+		Scopes:       []string{oidc.ScopeOpenID, "email", "profile"},
+	}
+}
+
+// OIDCFlow is kept in the session between the steps of the login
+type OIDCFlow struct {
+	State     string `json:"state,omitempty"`
+	Nonce     string `json:"nonce,omitempty"`
+	Verifier  string `json:"verifier,omitempty"`
+	ReturnURL string `json:"return_url,omitempty"`
+	Sign      string `json:"sign,omitempty"`
+	InviteID  string `json:"invite_id,omitempty"`
+	StartedAt int64  `json:"started_at"`
+	// Identity is set once the provider has vouched for the user
+	// and the user still needs to be linked or to sign up
+	Identity *OIDCIdentity `json:"identity,omitempty"`
+}
+
+func (f *OIDCFlow) expired(now time.Time) bool {
+	return now.Sub(time.Unix(f.StartedAt, 0)) > oidcFlowTTL
+}
+
+type OIDCIdentity struct {
+	Issuer        string `json:"issuer"`
+	Subject       string `json:"subject"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Username      string `json:"username"`
+}
+
+func newOIDCFlow(returnURL string, sign string, inviteID string, now time.Time) (*OIDCFlow, error) {
+	state, err := newRandomToken()
+
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := newRandomToken()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &OIDCFlow{
+		State:     state,
+		Nonce:     nonce,
+		Verifier:  oauth2.GenerateVerifier(),
+		ReturnURL: returnURL,
+		Sign:      sign,
+		InviteID:  inviteID,
+		StartedAt: now.Unix(),
+	}, nil
+}
+
+func (o *OIDC) authURL(ctx context.Context, flow *OIDCFlow) (string, error) {
+	provider, err := o.discover(ctx)
+
+	if err != nil {
+		return "", err
+	}
+
+	return o.oauth2Config(provider).AuthCodeURL(
+		flow.State,
+		oidc.Nonce(flow.Nonce),
+		oauth2.S256ChallengeOption(flow.Verifier),
+	), nil
+}
+
+// exchange trades the code for the tokens and checks that the id token
+// has been issued for this very flow
+func (o *OIDC) exchange(ctx context.Context, flow *OIDCFlow, state string, code string, now time.Time) (*OIDCIdentity, error) {
+	if flow.expired(now) {
+		return nil, errors.Wrap(ErrOIDCFailed, "the login has expired")
+	}
+
+	if state == "" || subtle.ConstantTimeCompare([]byte(state), []byte(flow.State)) != 1 {
+		return nil, errors.Wrap(ErrOIDCFailed, "state mismatch")
+	}
+
+	provider, err := o.discover(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := o.oauth2Config(provider).Exchange(ctx, code, oauth2.VerifierOption(flow.Verifier))
+
+	if err != nil {
+		return nil, errors.Wrapf(ErrOIDCFailed, "failed to exchange the code: %s", err.Error())
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+
+	if !ok {
+		return nil, errors.Wrap(ErrOIDCFailed, "no id token in the response")
+	}
+
+	idToken, err := provider.Verifier(&oidc.Config{ClientID: o.config.ClientID}).Verify(ctx, rawIDToken)
+
+	if err != nil {
+		return nil, errors.Wrapf(ErrOIDCFailed, "failed to verify the id token: %s", err.Error())
+	}
+
+	if subtle.ConstantTimeCompare([]byte(idToken.Nonce), []byte(flow.Nonce)) != 1 {
+		return nil, errors.Wrap(ErrOIDCFailed, "nonce mismatch")
+	}
+
+	var claims struct {
+		Email             string `json:"email"`
+		EmailVerified     bool   `json:"email_verified"`
+		PreferredUsername string `json:"preferred_username"`
+	}
+
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, errors.Wrapf(ErrOIDCFailed, "failed to read the claims: %s", err.Error())
+	}
+
+	return &OIDCIdentity{
+		Issuer:        idToken.Issuer,
+		Subject:       idToken.Subject,
+		Email:         strings.ToLower(strings.TrimSpace(claims.Email)),
+		EmailVerified: claims.EmailVerified,
+		Username:      claims.PreferredUsername,
+	}, nil
+}
+
+// BeginLogin returns the url of the provider to send the user to. The return url
+// is expected to be checked against the sign already
+func (o *OIDC) BeginLogin(c *gin.Context, returnURL string, sign string, inviteID string) (string, error) {
+	flow, err := newOIDCFlow(returnURL, sign, inviteID, time.Now())
+
+	if err != nil {
+		return "", err
+	}
+
+	authURL, err := o.authURL(c.Request.Context(), flow)
+
+	if err != nil {
+		return "", err
+	}
+
+	if err := SaveOIDCFlow(c, flow); err != nil {
+		return "", err
+	}
+
+	return authURL, nil
+}
+
+// FinishLogin checks the response of the provider. The flow is taken out of
+// the session, the caller puts it back with SaveOIDCFlow in case the
+// user is new to us
+func (o *OIDC) FinishLogin(c *gin.Context) (*OIDCFlow, error) {
+	flow, err := takeOIDCFlow(c)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if flow == nil || flow.Identity != nil {
+		return nil, errors.Wrap(ErrOIDCFailed, "no login in progress")
+	}
+
+	if providerErr := c.Query("error"); providerErr != "" {
+		return nil, errors.Wrapf(ErrOIDCFailed, "the provider responded with %s", providerErr)
+	}
+
+	now := time.Now()
+
+	identity, err := o.exchange(c.Request.Context(), flow, c.Query("state"), c.Query("code"), now)
+
+	if err != nil {
+		return nil, err
+	}
+
+	flow.State = ""
+	flow.Nonce = ""
+	flow.Verifier = ""
+	flow.StartedAt = now.Unix()
+	flow.Identity = identity
+
+	return flow, nil
+}
+
+func SaveOIDCFlow(c *gin.Context, flow *OIDCFlow) error {
+	serialized, err := json.Marshal(flow)
+
+	if err != nil {
+		return err
+	}
+
+	session := sessions.Default(c)
+	session.Set(oidcFlowKey, string(serialized))
+
+	return session.Save()
+}
+
+// PendingOIDCFlow returns the flow waiting for the user to link the
+// account or to sign up, nil is returned if there is none
+func PendingOIDCFlow(c *gin.Context) (*OIDCFlow, error) {
+	flow, err := readOIDCFlow(c)
+
+	if err != nil || flow == nil {
+		return nil, err
+	}
+
+	if flow.Identity == nil || flow.expired(time.Now()) {
+		return nil, nil
+	}
+
+	return flow, nil
+}
+
+func ClearOIDCFlow(c *gin.Context) error {
+	session := sessions.Default(c)
+	session.Delete(oidcFlowKey)
+
+	return session.Save()
+}
+
+// takeOIDCFlow makes sure every state is used only once
+func takeOIDCFlow(c *gin.Context) (*OIDCFlow, error) {
+	flow, err := readOIDCFlow(c)
+
+	if err != nil || flow == nil {
+		return nil, err
+	}
+
+	if err := ClearOIDCFlow(c); err != nil {
+		return nil, err
+	}
+
+	return flow, nil
+}
+
+func readOIDCFlow(c *gin.Context) (*OIDCFlow, error) {
+	serialized, ok := sessions.Default(c).Get(oidcFlowKey).(string)
+
+	if !ok {
+		return nil, nil
+	}
+
+	var flow OIDCFlow
+
+	if err := json.Unmarshal([]byte(serialized), &flow); err != nil {
+		return nil, err
+	}
+
+	return &flow, nil
+}
+
+// FindIdentityUser returns the user the identity is linked to,
+// sql.ErrNoRows is returned for the identities we don't know yet
+func FindIdentityUser(ctx context.Context, exec boil.ContextExecutor, identity *OIDCIdentity) (*core.User, error) {
+	userIdentity, err := core.UserIdentities(
+		core.UserIdentityWhere.Issuer.EQ(identity.Issuer),
+		core.UserIdentityWhere.Subject.EQ(identity.Subject),
+		qm.Load(core.UserIdentityRels.User),
+	).One(ctx, exec)
+
+	if err != nil {
+		return nil, err
+	}
+
+	userIdentity.Email = identity.Email
+	userIdentity.LastLoginAt = null.TimeFrom(time.Now())
+
+	if _, err := userIdentity.Update(ctx, exec, boil.Whitelist(
+		core.UserIdentityColumns.Email,
+		core.UserIdentityColumns.LastLoginAt,
+		core.UserIdentityColumns.UpdatedAt,
+	)); err != nil {
+		return nil, err
+	}
+
+	return userIdentity.R.User, nil
+}
+
+// LinkIdentity lets the user log in with the identity from now on,
+// the caller is expected to have checked the password of the user
+func LinkIdentity(ctx context.Context, exec boil.ContextExecutor, user *core.User, identity *OIDCIdentity) error {
+	exists, err := core.UserIdentities(
+		core.UserIdentityWhere.Issuer.EQ(identity.Issuer),
+		core.UserIdentityWhere.Subject.EQ(identity.Subject),
+	).Exists(ctx, exec)
+
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return errors.Errorf("The account is linked already")
+	}
+
+	userIdentity := &core.UserIdentity{
+		ID:          uuid.NewString(),
+		UserID:      user.ID,
+		Issuer:      identity.Issuer,
+		Subject:     identity.Subject,
+		Email:       identity.Email,
+		LastLoginAt: null.TimeFrom(time.Now()),
+	}
+
+	return userIdentity.Insert(ctx, exec, boil.Infer())
+}
+
+// OIDCSignupInvite finds the unused invite the identity can sign up with, either
+// the one the flow has been started from or the one sent to the verified email.
+// An invite sent to somebody else does not count, the same as with the password
+func OIDCSignupInvite(ctx context.Context, exec boil.ContextExecutor, flow *OIDCFlow) (*core.UserInvitation, error) {
+	if flow.InviteID != "" {
+		invite, err := core.UserInvitations(
+			core.UserInvitationWhere.ID.EQ(flow.InviteID),
+			core.UserInvitationWhere.CreatedUserID.IsNull(),
+		).One(ctx, exec)
+
+		if err != nil && err != sql.ErrNoRows {
+			return nil, err
+		}
+
+		if err == nil && inviteMatchesIdentity(invite, flow.Identity) {
+			return invite, nil
+		}
+	}
+
+	if !flow.Identity.EmailVerified || flow.Identity.Email == "" {
+		return nil, nil
+	}
+
+	invite, err := core.UserInvitations(
+		qm.Where("lower("+core.UserInvitationColumns.InvitationEmail+") = ?", strings.ToLower(flow.Identity.Email)),
+		core.UserInvitationWhere.CreatedUserID.IsNull(),
+		qm.OrderBy(core.UserInvitationColumns.CreatedAt),
+	).One(ctx, exec)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	return invite, err
+}
+
+// inviteMatchesIdentity lets the invites without the email be used by anybody
+// holding the link, the rest are bound to the invited address
+func inviteMatchesIdentity(invite *core.UserInvitation, identity *OIDCIdentity) bool {
+	if !invite.InvitationEmail.Valid {
+		return true
+	}
+
+	return identity.EmailVerified && strings.EqualFold(invite.InvitationEmail.String, identity.Email)
+}
+
+// SignupWithIdentity creates the user without a password, the email is trusted
+// since the provider has verified it. The invite is optional, the caller is
+// expected to check whether the registration is open otherwise
+func SignupWithIdentity(ctx context.Context, exec boil.ContextExecutor, s sender.Sender, identity *OIDCIdentity, username string, invite *core.UserInvitation) (*core.User, error) {
+	if username == "" || identity.Email == "" {
+		return nil, errors.Errorf("Not enough data")
+	}
+
+	if !identity.EmailVerified {
+		return nil, errors.Errorf("The email has not been verified by the provider")
+	}
+
+	attribution := "oidc"
+
+	if invite != nil {
+		attribution = "accepted_invite"
+	}
+
+	u := &core.User{
+		ID:                uuid.NewString(),
+		Email:             identity.Email,
+		Username:          username,
+		EmailConfirmedAt:  null.TimeFrom(time.Now()),
+		SignupAttribution: null.StringFrom(attribution),
+	}
+
+	if err := u.Insert(ctx, exec, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	if err := LinkIdentity(ctx, exec, u, identity); err != nil {
+		return nil, err
+	}
+
+	if invite != nil {
+		if err := useInvite(ctx, exec, invite, u); err != nil {
+			return nil, err
+		}
+	}
+
+	admin.NotifyNewUser(ctx, exec, s, u)
+
+	return u, nil
+}
@@ -0,0 +1,216 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+// mockProvider is just enough of an identity provider to go through
+// the discovery and the code exchange with PKCE
+type mockProvider struct {
+	*httptest.Server
+	t      *testing.T
+	key    *rsa.PrivateKey
+	mu     sync.Mutex
+	grants map[string]url.Values
+}
+
+func newMockProvider(t *testing.T) *mockProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	p := &mockProvider{t: t, key: key, grants: map[string]url.Values{}}
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		p.writeJSON(w, map[string]any{
+			"issuer":                                p.URL,
+			"authorization_endpoint":                p.URL + "/authorize",
+			"token_endpoint":                        p.URL + "/token",
+			"jwks_uri":                              p.URL + "/keys",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		p.writeJSON(w, jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &key.PublicKey, KeyID: "test", Algorithm: "RS256", Use: "sig"},
+		}})
+	})
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+
+		p.mu.Lock()
+		grant, ok := p.grants[r.PostForm.Get("code")]
+		delete(p.grants, r.PostForm.Get("code"))
+		p.mu.Unlock()
+
+		clientID, _, _ := r.BasicAuth()
+
+		if clientID == "" {
+			clientID = r.PostForm.Get("client_id")
+		}
+
+		challenge := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+
+		if !ok || clientID != "pcom" ||
+			grant.Get("code_challenge_method") != "S256" ||
+			grant.Get("code_challenge") != base64.RawURLEncoding.EncodeToString(challenge[:]) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+
+		p.writeJSON(w, map[string]any{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     p.idToken(grant.Get("nonce")),
+		})
+	})
+
+	p.Server = httptest.NewServer(mux)
+	t.Cleanup(p.Close)
+
+	return p
+}
+
+func (p *mockProvider) writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(p.t, json.NewEncoder(w).Encode(v))
+}
+
+func (p *mockProvider) idToken(nonce string) string {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: p.key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", "test"),
+	)
+	require.NoError(p.t, err)
+
+	now := time.Now()
+
+	payload, err := json.Marshal(map[string]any{
+		"iss":                p.URL,
+		"sub":                "external-user",
+		"aud":                "pcom",
+		"iat":                now.Unix(),
+		"exp":                now.Add(time.Hour).Unix(),
+		"nonce":              nonce,
+		"email":              " User@Example.com",
+		"email_verified":     true,
+		"preferred_username": "user",
+	})
+	require.NoError(p.t, err)
+
+	signed, err := signer.Sign(payload)
+	require.NoError(p.t, err)
+
+	token, err := signed.CompactSerialize()
+	require.NoError(p.t, err)
+
+	return token
+}
+
+// authorize plays the part of the user logging in at the provider
+func (p *mockProvider) authorize(authURL string) (string, string) {
+	parsed, err := url.Parse(authURL)
+	require.NoError(p.t, err)
+
+	query := parsed.Query()
+	code := "code-" + query.Get("state")
+
+	p.mu.Lock()
+	p.grants[code] = query
+	p.mu.Unlock()
+
+	return query.Get("state"), code
+}
+
+func TestOIDCExchange(t *testing.T) {
+	provider := newMockProvider(t)
+	ctx := context.Background()
+
+	o := NewOIDC(OIDCConfig{
+		Name:         "Test",
+		Issuer:       provider.URL,
+		ClientID:     "pcom",
+		ClientSecret: "secret",
+		RedirectURL:  "https://example.com/login/oidc/callback",
+	})
+
+	start := func() (*OIDCFlow, string, string) {
+		flow, err := newOIDCFlow("/posts", "sign", "", time.Now())
+		require.NoError(t, err)
+
+		authURL, err := o.authURL(ctx, flow)
+		require.NoError(t, err)
+
+		state, code := provider.authorize(authURL)
+
+		return flow, state, code
+	}
+
+	flow, state, code := start()
+
+	identity, err := o.exchange(ctx, flow, state, code, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, &OIDCIdentity{
+		Issuer:        provider.URL,
+		Subject:       "external-user",
+		Email:         "user@example.com",
+		EmailVerified: true,
+		Username:      "user",
+	}, identity)
+
+	// the code cannot be used twice
+	_, err = o.exchange(ctx, flow, state, code, time.Now())
+	assert.ErrorIs(t, err, ErrOIDCFailed)
+
+	flow, _, code = start()
+	_, err = o.exchange(ctx, flow, "forged", code, time.Now())
+	assert.ErrorIs(t, err, ErrOIDCFailed, "state mismatch")
+
+	flow, state, code = start()
+	_, err = o.exchange(ctx, flow, state, code, time.Now().Add(oidcFlowTTL+time.Minute))
+	assert.ErrorIs(t, err, ErrOIDCFailed, "expired flow")
+
+	flow, state, code = start()
+	flow.Verifier = "a-verifier-the-provider-has-not-seen-before-at-all"
+	_, err = o.exchange(ctx, flow, state, code, time.Now())
+	assert.ErrorIs(t, err, ErrOIDCFailed, "wrong verifier")
+
+	flow, state, code = start()
+	flow.Nonce = "replayed"
+	_, err = o.exchange(ctx, flow, state, code, time.Now())
+	assert.ErrorIs(t, err, ErrOIDCFailed, "nonce mismatch")
+}
+
+func TestInviteMatchesIdentity(t *testing.T) {
+	identity := &OIDCIdentity{Email: "alice@example.com", EmailVerified: true}
+
+	assert.True(t, inviteMatchesIdentity(&core.UserInvitation{}, identity), "invite without the email")
+	assert.True(t, inviteMatchesIdentity(&core.UserInvitation{InvitationEmail: null.StringFrom("Alice@Example.com")}, identity))
+	assert.False(t, inviteMatchesIdentity(&core.UserInvitation{InvitationEmail: null.StringFrom("bob@example.com")}, identity))
+
+	unverified := &OIDCIdentity{Email: "alice@example.com"}
+	assert.False(t, inviteMatchesIdentity(&core.UserInvitation{InvitationEmail: null.StringFrom("alice@example.com")}, unverified))
+}
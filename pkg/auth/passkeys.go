@@ -0,0 +1,572 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/pkg/util"
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+const (
+	passkeyRegistrationKey = "passkey_registration"
+	passkeyLoginKey        = "passkey_login"
+
+	MaxPasskeyNameLength = 100
+)
+
+var ErrPasskeyFailed = errors.New("passkey verification failed")
+
+// passkeys are bound to the domain of the site
+var webAuthn = sync.OnceValues(func() (*webauthn.WebAuthn, error) {
+	return newWebAuthn(util.SiteRoot())
+})
+
+func newWebAuthn(siteRoot string) (*webauthn.WebAuthn, error) {
+	u, err := url.Parse(siteRoot)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid site root")
+	}
+
+	return webauthn.New(&webauthn.Config{
+		RPID:          u.Hostname(),
+		RPDisplayName: "pcom",
+		RPOrigins:     []string{strings.TrimSuffix(siteRoot, "/")},
+	})
+}
+
+// passkeyUser is the user as the webauthn library sees it
+type passkeyUser struct {
+	user     *core.User
+	passkeys core.UserPasskeySlice
+}
+
+func (u *passkeyUser) WebAuthnID() []byte {
+	return []byte(u.user.ID)
+}
+
+func (u *passkeyUser) WebAuthnName() string {
+	return u.user.Email
+}
+
+func (u *passkeyUser) WebAuthnDisplayName() string {
+	return u.user.Username
+}
+
+func (u *passkeyUser) WebAuthnCredentials() []webauthn.Credential {
+	out := make([]webauthn.Credential, 0, len(u.passkeys))
+
+	for _, pk := range u.passkeys {
+		var credential webauthn.Credential
+
+		// a broken row should not lock the user out of the other passkeys
+		if err := pk.Credential.Unmarshal(&credential); err != nil {
+			continue
+		}
+
+		out = append(out, credential)
+	}
+
+	return out
+}
+
+func (u *passkeyUser) passkey(credentialID []byte) *core.UserPasskey {
+	for _, pk := range u.passkeys {
+		if bytes.Equal(pk.CredentialID, credentialID) {
+			return pk
+		}
+	}
+
+	return nil
+}
+
+func loadPasskeyUser(ctx context.Context, exec boil.ContextExecutor, user *core.User) (*passkeyUser, error) {
+	passkeys, err := UserPasskeys(ctx, exec, user)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &passkeyUser{user: user, passkeys: passkeys}, nil
+}
+
+func UserPasskeys(ctx context.Context, exec boil.ContextExecutor, user *core.User) (core.UserPasskeySlice, error) {
+	return core.UserPasskeys(
+		core.UserPasskeyWhere.UserID.EQ(user.ID),
+	).All(ctx, exec)
+}
+
+func HasPasskeys(ctx context.Context, exec boil.ContextExecutor, user *core.User) (bool, error) {
+	return core.UserPasskeys(
+		core.UserPasskeyWhere.UserID.EQ(user.ID),
+	).Exists(ctx, exec)
+}
+
+// HasSecondFactor tells whether the password alone is not enough to log in,
+// both the authenticator app and the passkeys count
+func HasSecondFactor(ctx context.Context, exec boil.ContextExecutor, user *core.User) (bool, error) {
+	if HasTOTP(user) {
+		return true, nil
+	}
+
+	return HasPasskeys(ctx, exec, user)
+}
+
+// BeginPasskeyRegistration returns the options for navigator.credentials.create,
+// the challenge is kept in the session until the browser comes back
+func BeginPasskeyRegistration(c *gin.Context, exec boil.ContextExecutor, user *core.User) (*protocol.CredentialCreation, error) {
+	wa, err := webAuthn()
+
+	if err != nil {
+		return nil, err
+	}
+
+	pu, err := loadPasskeyUser(c.Request.Context(), exec, user)
+
+	if err != nil {
+		return nil, err
+	}
+
+	creation, sessionData, err := wa.BeginRegistration(pu,
+		webauthn.WithExclusions(webauthn.Credentials(pu.WebAuthnCredentials()).CredentialDescriptors()),
+		webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementRequired),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := saveWebAuthnSession(c, passkeyRegistrationKey, sessionData); err != nil {
+		return nil, err
+	}
+
+	return creation, nil
+}
+
+// FinishPasskeyRegistration verifies the response of the browser and stores the passkey
+func FinishPasskeyRegistration(c *gin.Context, exec boil.ContextExecutor, user *core.User, name string) (*core.UserPasskey, error) {
+	wa, err := webAuthn()
+
+	if err != nil {
+		return nil, err
+	}
+
+	sessionData, err := takeWebAuthnSession(c, passkeyRegistrationKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pu, err := loadPasskeyUser(c.Request.Context(), exec, user)
+
+	if err != nil {
+		return nil, err
+	}
+
+	passkey, err := registerPasskey(wa, pu, sessionData, c.Request.Body, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := passkey.Insert(c.Request.Context(), exec, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	return passkey, nil
+}
+
+func registerPasskey(wa *webauthn.WebAuthn, pu *passkeyUser, sessionData *webauthn.SessionData, body io.Reader, name string) (*core.UserPasskey, error) {
+	parsed, err := protocol.ParseCredentialCreationResponseBody(body)
+
+	if err != nil {
+		return nil, errors.Wrap(ErrPasskeyFailed, err.Error())
+	}
+
+	credential, err := wa.CreateCredential(pu, *sessionData, parsed)
+
+	if err != nil {
+		return nil, errors.Wrap(ErrPasskeyFailed, err.Error())
+	}
+
+	serialized, err := json.Marshal(credential)
+
+	if err != nil {
+		return nil, err
+	}
+
+	name = strings.TrimSpace(name)
+
+	if name == "" {
+		name = "Passkey"
+	}
+
+	return &core.UserPasskey{
+		ID:           uuid.NewString(),
+		UserID:       pu.user.ID,
+		Name:         truncateRunes(name, MaxPasskeyNameLength),
+		CredentialID: credential.ID,
+		Credential:   types.JSON(serialized),
+	}, nil
+}
+
+// BeginPasskeyLogin returns the options for navigator.credentials.get without
+// any credentials listed, the browser offers all the passkeys it has for the site
+func BeginPasskeyLogin(c *gin.Context) (*protocol.CredentialAssertion, error) {
+	wa, err := webAuthn()
+
+	if err != nil {
+		return nil, err
+	}
+
+	assertion, sessionData, err := wa.BeginDiscoverableLogin(
+		webauthn.WithUserVerification(protocol.VerificationRequired),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := saveWebAuthnSession(c, passkeyLoginKey, sessionData); err != nil {
+		return nil, err
+	}
+
+	return assertion, nil
+}
+
+// FinishPasskeyLogin logs in the user the passkey belongs to, the passkey
+// verifies the user on its own and no other factor is needed
+func FinishPasskeyLogin(c *gin.Context, exec boil.ContextExecutor) (*core.User, error) {
+	wa, err := webAuthn()
+
+	if err != nil {
+		return nil, err
+	}
+
+	sessionData, err := takeWebAuthnSession(c, passkeyLoginKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := c.Request.Context()
+
+	lookup := func(userID string) (*passkeyUser, error) {
+		user, err := core.Users(
+			core.UserWhere.ID.EQ(userID),
+			core.UserWhere.EmailConfirmedAt.IsNotNull(),
+		).One(ctx, exec)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return loadPasskeyUser(ctx, exec, user)
+	}
+
+	pu, passkey, err := validatePasskeyLogin(wa, sessionData, c.Request.Body, lookup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := passkey.Update(ctx, exec, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	session := sessions.Default(c)
+	setSessionUser(session, pu.user.ID)
+
+	if err := session.Save(); err != nil {
+		return nil, errors.Wrapf(err, "Failed to save session")
+	}
+
+	if err := trackSession(c, exec, pu.user.ID); err != nil {
+		return nil, err
+	}
+
+	if err := RecordLoginSuccess(ctx, exec, c.ClientIP(), pu.user.Email); err != nil {
+		return nil, err
+	}
+
+	return pu.user, nil
+}
+
+// validatePasskeyLogin returns the passkey with the updated sign counter, the passkeys
+// with the counter going backwards might be cloned and are rejected
+func validatePasskeyLogin(wa *webauthn.WebAuthn, sessionData *webauthn.SessionData, body io.Reader, lookup func(userID string) (*passkeyUser, error)) (*passkeyUser, *core.UserPasskey, error) {
+	parsed, err := protocol.ParseCredentialRequestResponseBody(body)
+
+	if err != nil {
+		return nil, nil, errors.Wrap(ErrPasskeyFailed, err.Error())
+	}
+
+	var pu *passkeyUser
+
+	handler := func(rawID, userHandle []byte) (webauthn.User, error) {
+		var err error
+		pu, err = lookup(string(userHandle))
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPasskeyFailed
+		}
+
+		return pu, err
+	}
+
+	var credential *webauthn.Credential
+
+	if len(sessionData.UserID) > 0 {
+		pu, err = lookup(string(sessionData.UserID))
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		credential, err = wa.ValidateLogin(pu, *sessionData, parsed)
+	} else {
+		credential, err = wa.ValidateDiscoverableLogin(handler, *sessionData, parsed)
+	}
+
+	if err != nil {
+		return nil, nil, errors.Wrap(ErrPasskeyFailed, err.Error())
+	}
+
+	if credential.Authenticator.CloneWarning {
+		return nil, nil, errors.Wrap(ErrPasskeyFailed, "sign counter went backwards")
+	}
+
+	passkey := pu.passkey(credential.ID)
+
+	if passkey == nil {
+		return nil, nil, ErrPasskeyFailed
+	}
+
+	serialized, err := json.Marshal(credential)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	passkey.Credential = types.JSON(serialized)
+	passkey.LastUsedAt = null.TimeFrom(time.Now())
+
+	return pu, passkey, nil
+}
+
+// BeginPasskeySecondFactor lists the passkeys of the user who has
+// entered the password already
+func BeginPasskeySecondFactor(c *gin.Context, exec boil.ContextExecutor) (*protocol.CredentialAssertion, error) {
+	wa, err := webAuthn()
+
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := PendingSecondFactorUser(c, exec)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, ErrPasskeyFailed
+	}
+
+	pu, err := loadPasskeyUser(c.Request.Context(), exec, user)
+
+	if err != nil {
+		return nil, err
+	}
+
+	assertion, sessionData, err := wa.BeginLogin(pu)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := saveWebAuthnSession(c, passkeyLoginKey, sessionData); err != nil {
+		return nil, err
+	}
+
+	return assertion, nil
+}
+
+// FinishPasskeySecondFactor completes the login started with the password,
+// failed attempts count towards the lockout of the account the same as the wrong codes
+func FinishPasskeySecondFactor(c *gin.Context, exec boil.ContextExecutor, s sender.Sender, remember bool) error {
+	wa, err := webAuthn()
+
+	if err != nil {
+		return err
+	}
+
+	sessionData, err := takeWebAuthnSession(c, passkeyLoginKey)
+
+	if err != nil {
+		return err
+	}
+
+	user, err := PendingSecondFactorUser(c, exec)
+
+	if err != nil {
+		return err
+	}
+
+	// the passkey has to belong to the user who has entered the password
+	if user == nil || string(sessionData.UserID) != user.ID {
+		return ErrPasskeyFailed
+	}
+
+	if err := CheckAttempts(c.Request.Context(), exec, AttemptLogin, c.ClientIP(), user.Email); err != nil {
+		return err
+	}
+
+	lookup := func(userID string) (*passkeyUser, error) {
+		return loadPasskeyUser(c.Request.Context(), exec, user)
+	}
+
+	_, passkey, err := validatePasskeyLogin(wa, sessionData, c.Request.Body, lookup)
+
+	if err != nil {
+		if failErr := FailSecondFactor(c, exec, s, user); failErr != nil {
+			return failErr
+		}
+
+		return err
+	}
+
+	if _, err := passkey.Update(c.Request.Context(), exec, boil.Infer()); err != nil {
+		return err
+	}
+
+	return CompleteSecondFactor(c, exec, user, remember)
+}
+
+func RenamePasskey(ctx context.Context, exec boil.ContextExecutor, user *core.User, passkeyID string, name string) error {
+	name = strings.TrimSpace(name)
+
+	if name == "" {
+		return errors.Errorf("Name cannot be empty")
+	}
+
+	passkey, err := core.UserPasskeys(
+		core.UserPasskeyWhere.ID.EQ(passkeyID),
+		core.UserPasskeyWhere.UserID.EQ(user.ID),
+	).One(ctx, exec)
+
+	if err != nil {
+		return err
+	}
+
+	passkey.Name = truncateRunes(name, MaxPasskeyNameLength)
+
+	_, err = passkey.Update(ctx, exec, boil.Whitelist(
+		core.UserPasskeyColumns.Name,
+		core.UserPasskeyColumns.UpdatedAt,
+	))
+
+	return err
+}
+
+// DeletePasskey refuses to delete the last second factor when everyone is required to have one
+func DeletePasskey(ctx context.Context, exec boil.ContextExecutor, user *core.User, passkeyID string) error {
+	passkey, err := core.UserPasskeys(
+		core.UserPasskeyWhere.ID.EQ(passkeyID),
+		core.UserPasskeyWhere.UserID.EQ(user.ID),
+	).One(ctx, exec)
+
+	if err != nil {
+		return err
+	}
+
+	if !HasTOTP(user) {
+		required, err := TwoFactorRequired(ctx, exec)
+
+		if err != nil {
+			return err
+		}
+
+		count, err := core.UserPasskeys(
+			core.UserPasskeyWhere.UserID.EQ(user.ID),
+		).Count(ctx, exec)
+
+		if err != nil {
+			return err
+		}
+
+		if required && count <= 1 {
+			return errors.Errorf("Two-factor authentication is required, the last passkey cannot be removed")
+		}
+	}
+
+	_, err = passkey.Delete(ctx, exec)
+
+	return err
+}
+
+func saveWebAuthnSession(c *gin.Context, key string, sessionData *webauthn.SessionData) error {
+	serialized, err := json.Marshal(sessionData)
+
+	if err != nil {
+		return err
+	}
+
+	session := sessions.Default(c)
+	session.Set(key, string(serialized))
+
+	return session.Save()
+}
+
+// takeWebAuthnSession makes sure every challenge is used only once
+func takeWebAuthnSession(c *gin.Context, key string) (*webauthn.SessionData, error) {
+	session := sessions.Default(c)
+
+	serialized, ok := session.Get(key).(string)
+
+	if !ok {
+		return nil, errors.Wrap(ErrPasskeyFailed, "no challenge in the session")
+	}
+
+	session.Delete(key)
+
+	if err := session.Save(); err != nil {
+		return nil, err
+	}
+
+	var sessionData webauthn.SessionData
+
+	if err := json.Unmarshal([]byte(serialized), &sessionData); err != nil {
+		return nil, err
+	}
+
+	return &sessionData, nil
+}
+
+func truncateRunes(s string, maxLength int) string {
+	runes := []rune(s)
+
+	if len(runes) <= maxLength {
+		return s
+	}
+
+	return string(runes[:maxLength])
+}
@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/descope/virtualwebauthn"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func toJSON(t *testing.T, v any) string {
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	return string(b)
+}
+
+func TestPasskeys(t *testing.T) {
+	wa, err := newWebAuthn("https://example.com/")
+	require.NoError(t, err)
+
+	rp := virtualwebauthn.RelyingParty{Name: "pcom", ID: "example.com", Origin: "https://example.com"}
+
+	user := &core.User{ID: "8d1f5f44-5a64-4f7e-9a3c-6a3f1f0c9e21", Email: "user@example.com", Username: "user"}
+	pu := &passkeyUser{user: user}
+
+	authenticator := virtualwebauthn.NewAuthenticatorWithOptions(virtualwebauthn.AuthenticatorOptions{
+		UserHandle: []byte(user.ID),
+	})
+	credential := virtualwebauthn.NewCredential(virtualwebauthn.KeyTypeEC2)
+
+	// registration
+	creation, sessionData, err := wa.BeginRegistration(pu,
+		webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementRequired),
+	)
+	require.NoError(t, err)
+
+	attestationOptions, err := virtualwebauthn.ParseAttestationOptions(toJSON(t, creation))
+	require.NoError(t, err)
+
+	attestation := virtualwebauthn.CreateAttestationResponse(rp, authenticator, credential, *attestationOptions)
+
+	passkey, err := registerPasskey(wa, pu, sessionData, strings.NewReader(attestation), "  ")
+	require.NoError(t, err)
+	assert.Equal(t, "Passkey", passkey.Name)
+	assert.Equal(t, user.ID, passkey.UserID)
+
+	_, err = registerPasskey(wa, pu, sessionData, strings.NewReader("{}"), "Laptop")
+	assert.ErrorIs(t, err, ErrPasskeyFailed)
+
+	authenticator.AddCredential(credential)
+	pu.passkeys = core.UserPasskeySlice{passkey}
+
+	lookup := func(userID string) (*passkeyUser, error) {
+		if userID != user.ID {
+			return nil, sql.ErrNoRows
+		}
+
+		return pu, nil
+	}
+
+	login := func(assertion *protocol.CredentialAssertion) string {
+		assertionOptions, err := virtualwebauthn.ParseAssertionOptions(toJSON(t, assertion))
+		require.NoError(t, err)
+
+		return virtualwebauthn.CreateAssertionResponse(rp, authenticator, credential, *assertionOptions)
+	}
+
+	// discoverable login, the user is only known from the response
+	assertion, sessionData, err := wa.BeginDiscoverableLogin(
+		webauthn.WithUserVerification(protocol.VerificationRequired),
+	)
+	require.NoError(t, err)
+
+	response := login(assertion)
+
+	loggedIn, updated, err := validatePasskeyLogin(wa, sessionData, strings.NewReader(response), lookup)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, loggedIn.user.ID)
+	assert.True(t, updated.LastUsedAt.Valid)
+
+	// challenge can be used only once
+	_, _, err = validatePasskeyLogin(wa, &webauthn.SessionData{Challenge: "other"}, strings.NewReader(response), lookup)
+	assert.ErrorIs(t, err, ErrPasskeyFailed)
+
+	// second factor, the user is already known
+	assertion, sessionData, err = wa.BeginLogin(pu)
+	require.NoError(t, err)
+
+	loggedIn, _, err = validatePasskeyLogin(wa, sessionData, strings.NewReader(login(assertion)), lookup)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, loggedIn.user.ID)
+
+	// passkey of the deleted user
+	assertion, sessionData, err = wa.BeginDiscoverableLogin()
+	require.NoError(t, err)
+
+	response = login(assertion)
+
+	_, _, err = validatePasskeyLogin(wa, sessionData, strings.NewReader(response), func(userID string) (*passkeyUser, error) {
+		return nil, sql.ErrNoRows
+	})
+	assert.ErrorIs(t, err, ErrPasskeyFailed)
+}
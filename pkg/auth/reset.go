@@ -0,0 +1,140 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/mail"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/pkg/pgsession"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	passwordResetTTL = time.Hour
+	// the limits apply to the requests made within the window
+	passwordResetWindow    = time.Hour
+	passwordResetsPerEmail = 3
+	passwordResetsPerIP    = 10
+)
+
+var ErrTooManyResets = errors.New("too many password reset requests")
+
+// CheckPasswordResetLimits returns ErrTooManyResets once there were too
+// many requests for the email or from the ip, no matter if the user exists
+func CheckPasswordResetLimits(ctx context.Context, exec boil.ContextExecutor, email string, ip string) error {
+	since := time.Now().Add(-passwordResetWindow)
+
+	byEmail, err := core.PasswordResetRequests(
+		core.PasswordResetRequestWhere.Email.EQ(email),
+		core.PasswordResetRequestWhere.CreatedAt.GT(since),
+	).Count(ctx, exec)
+
+	if err != nil {
+		return err
+	}
+
+	byIP, err := core.PasswordResetRequests(
+		core.PasswordResetRequestWhere.IP.EQ(ip),
+		core.PasswordResetRequestWhere.CreatedAt.GT(since),
+	).Count(ctx, exec)
+
+	if err != nil {
+		return err
+	}
+
+	if byEmail >= passwordResetsPerEmail || byIP >= passwordResetsPerIP {
+		return ErrTooManyResets
+	}
+
+	return nil
+}
+
+// RequestPasswordReset sends the reset link in case the email belongs to a confirmed user.
+// Every request is recorded for the rate limits to work, the caller gets
+// no indication of whether the user exists
+func RequestPasswordReset(ctx context.Context, exec boil.ContextExecutor, s sender.Sender, email string, ip string) error {
+	request := &core.PasswordResetRequest{
+		ID:    uuid.NewString(),
+		Email: email,
+		IP:    ip,
+	}
+
+	user, err := core.Users(
+		core.UserWhere.Email.EQ(email),
+		core.UserWhere.EmailConfirmedAt.IsNotNull(),
+	).One(ctx, exec)
+
+	if err == sql.ErrNoRows {
+		return request.Insert(ctx, exec, boil.Infer())
+	}
+
+	if err != nil {
+		return err
+	}
+
+	token, err := newRandomToken()
+
+	if err != nil {
+		return err
+	}
+
+	request.UserID = null.StringFrom(user.ID)
+	request.TokenHash = null.StringFrom(hashToken(token))
+	request.ExpiresAt = null.TimeFrom(time.Now().Add(passwordResetTTL))
+
+	if err := request.Insert(ctx, exec, boil.Infer()); err != nil {
+		return err
+	}
+
+	return mail.PasswordReset(ctx, exec, s, user, token)
+}
+
+// FindPasswordReset returns the request with the user loaded, sql.ErrNoRows
+// is returned for the tokens that are unknown, used or expired
+func FindPasswordReset(ctx context.Context, exec boil.ContextExecutor, token string) (*core.PasswordResetRequest, error) {
+	return core.PasswordResetRequests(passwordResetMods(token)...).One(ctx, exec)
+}
+
+// LockPasswordReset works as FindPasswordReset within the transaction that uses the token,
+// the concurrent attempts to use it wait for the transaction and find the token used
+func LockPasswordReset(ctx context.Context, exec boil.ContextExecutor, token string) (*core.PasswordResetRequest, error) {
+	return core.PasswordResetRequests(append(passwordResetMods(token), qm.For("UPDATE"))...).One(ctx, exec)
+}
+
+func passwordResetMods(token string) []qm.QueryMod {
+	return []qm.QueryMod{
+		core.PasswordResetRequestWhere.TokenHash.EQ(null.StringFrom(hashToken(token))),
+		core.PasswordResetRequestWhere.UsedAt.IsNull(),
+		core.PasswordResetRequestWhere.ExpiresAt.GT(null.TimeFrom(time.Now())),
+		qm.Load(core.PasswordResetRequestRels.User),
+	}
+}
+
+// ResetPassword sets the new password, burns all the pending reset
+// links of the user and ends every session they have
+func ResetPassword(ctx context.Context, exec boil.ContextExecutor, store pgsession.Store, request *core.PasswordResetRequest, password string) error {
+	user := request.R.User
+
+	if err := SetPassword(ctx, exec, user, password); err != nil {
+		return err
+	}
+
+	if _, err := core.PasswordResetRequests(
+		core.PasswordResetRequestWhere.UserID.EQ(null.StringFrom(user.ID)),
+		core.PasswordResetRequestWhere.UsedAt.IsNull(),
+	).UpdateAll(ctx, exec, core.M{
+		core.PasswordResetRequestColumns.UsedAt:    time.Now(),
+		core.PasswordResetRequestColumns.UpdatedAt: time.Now(),
+	}); err != nil {
+		return err
+	}
+
+	return DeleteUserSessions(ctx, exec, store, user.ID)
+}
@@ -0,0 +1,167 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/can3p/pcom/pkg/feedops/testutil"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/testcontainers/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+var resetLinkRe = regexp.MustCompile(`reset_password/([A-Za-z0-9_-]+)`)
+
+func TestPasswordReset(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+	exec := testDB.DB
+
+	user, err := testutil.CreateUser(ctx, exec, "user@example.com")
+	require.NoError(t, err)
+	user.EmailConfirmedAt = null.TimeFrom(time.Now())
+	_, err = user.Update(ctx, exec, boil.Infer())
+	require.NoError(t, err)
+	require.NoError(t, SetPassword(ctx, exec, user, "old password"))
+
+	requestToken := func() string {
+		s := &recordingSender{}
+		require.NoError(t, RequestPasswordReset(ctx, exec, s, user.Email, "203.0.113.7"))
+		require.Len(t, s.mails, 1)
+
+		match := resetLinkRe.FindStringSubmatch(s.mails[0].Text)
+		require.Len(t, match, 2)
+
+		return match[1]
+	}
+
+	token := requestToken()
+
+	// only the hash of the token is stored
+	request, err := FindPasswordReset(ctx, exec, token)
+	require.NoError(t, err)
+	assert.Equal(t, hashToken(token), request.TokenHash.String)
+	assert.NotContains(t, request.TokenHash.String, token)
+	assert.Equal(t, user.ID, request.R.User.ID)
+
+	_, err = FindPasswordReset(ctx, exec, request.TokenHash.String)
+	assert.ErrorIs(t, err, sql.ErrNoRows, "the hash is not the token")
+
+	t.Run("expired", func(t *testing.T) {
+		expiring := requestToken()
+
+		expired, err := FindPasswordReset(ctx, exec, expiring)
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(passwordResetTTL), expired.ExpiresAt.Time, time.Minute)
+
+		expired.ExpiresAt = null.TimeFrom(time.Now().Add(-time.Second))
+		_, err = expired.Update(ctx, exec, boil.Infer())
+		require.NoError(t, err)
+
+		_, err = FindPasswordReset(ctx, exec, expiring)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+
+		_, err = LockPasswordReset(ctx, exec, expiring)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("single use", func(t *testing.T) {
+		other := requestToken()
+		loggedInBrowser(t, exec, user)
+		untracked := untrackedBrowser(t, exec, user)
+
+		_, err := TrackStoredSessions(ctx, exec, untracked.store)
+		require.NoError(t, err)
+
+		tx, err := exec.BeginTx(ctx, nil)
+		require.NoError(t, err)
+
+		locked, err := LockPasswordReset(ctx, tx, token)
+		require.NoError(t, err)
+
+		// the concurrent attempt waits for the first one and finds the token used
+		concurrent := make(chan error, 1)
+
+		go func() {
+			tx, err := exec.BeginTx(ctx, nil)
+
+			if err != nil {
+				concurrent <- err
+				return
+			}
+
+			defer func() { _ = tx.Rollback() }()
+
+			_, err = LockPasswordReset(ctx, tx, token)
+			concurrent <- err
+		}()
+
+		require.NoError(t, ResetPassword(ctx, tx, newTestStore(exec), locked, "new password"))
+
+		select {
+		case err := <-concurrent:
+			t.Fatalf("the concurrent attempt has not waited for the lock: %v", err)
+		case <-time.After(200 * time.Millisecond):
+		}
+
+		require.NoError(t, tx.Commit())
+		assert.ErrorIs(t, <-concurrent, sql.ErrNoRows)
+
+		_, err = FindPasswordReset(ctx, exec, token)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+
+		_, err = FindPasswordReset(ctx, exec, other)
+		assert.ErrorIs(t, err, sql.ErrNoRows, "the other links are burnt as well")
+
+		_, _, err = findUserByCredentials(ctx, exec, user.Email, "new password")
+		assert.NoError(t, err)
+
+		sessions, err := core.UserSessions(core.UserSessionWhere.UserID.EQ(user.ID)).Count(ctx, exec)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), sessions, "every session has ended")
+
+		var stored int
+		require.NoError(t, exec.GetContext(ctx, &stored, "select count(*) from http_sessions"))
+		assert.Equal(t, 0, stored, "the store has forgotten the sessions, the backfilled one included")
+	})
+}
+
+func TestPasswordResetLimits(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+	exec := testDB.DB
+	s := &recordingSender{}
+
+	for i := 0; i < passwordResetsPerEmail; i++ {
+		ip := fmt.Sprintf("198.51.100.%d", i)
+
+		require.NoError(t, CheckPasswordResetLimits(ctx, exec, "nobody@example.com", ip))
+		require.NoError(t, RequestPasswordReset(ctx, exec, s, "nobody@example.com", ip))
+	}
+
+	assert.Empty(t, s.mails, "unknown emails get nothing")
+	assert.ErrorIs(t, CheckPasswordResetLimits(ctx, exec, "nobody@example.com", "198.51.100.200"), ErrTooManyResets, "the limit follows the email")
+
+	for i := 0; i < passwordResetsPerIP; i++ {
+		email := fmt.Sprintf("user%d@example.com", i)
+
+		require.NoError(t, CheckPasswordResetLimits(ctx, exec, email, "203.0.113.7"))
+		require.NoError(t, RequestPasswordReset(ctx, exec, s, email, "203.0.113.7"))
+	}
+
+	assert.ErrorIs(t, CheckPasswordResetLimits(ctx, exec, "another@example.com", "203.0.113.7"), ErrTooManyResets, "the limit follows the ip")
+	assert.NoError(t, CheckPasswordResetLimits(ctx, exec, "another@example.com", "198.51.100.200"))
+}
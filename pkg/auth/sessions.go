@@ -0,0 +1,303 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/pkg/pgsession"
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/mileusna/useragent"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	// last seen time does not need to be precise, no reason to write on every request
+	sessionSeenEvery   = 5 * time.Minute
+	maxUserAgentLength = 500
+)
+
+// the sessions that have expired or have been destroyed by the store
+const sessionAliveClause = "exists (select 1 from http_sessions where http_sessions.key = convert_to(user_sessions.session_key, 'UTF8') and http_sessions.expires_on > now())"
+
+type SessionInfo struct {
+	ID         string
+	Device     string
+	IP         string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	Current    bool
+}
+
+// trackSession keeps the record of the session up to date, the record is
+// created once the user is logged in or on the first request of the older sessions
+func trackSession(c *gin.Context, exec boil.ContextExecutor, userID string) error {
+	key := sessions.Default(c).ID()
+
+	if key == "" {
+		return nil
+	}
+
+	ctx := c.Request.Context()
+
+	record, err := core.UserSessions(
+		core.UserSessionWhere.SessionKey.EQ(key),
+	).One(ctx, exec)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		record = &core.UserSession{
+			ID:         uuid.NewString(),
+			UserID:     userID,
+			SessionKey: key,
+			UserAgent:  truncateRunes(c.Request.UserAgent(), maxUserAgentLength),
+			IP:         CoarseIP(c.ClientIP()),
+			LastSeenAt: time.Now(),
+		}
+
+		return record.Insert(ctx, exec, boil.Infer())
+	} else if err != nil {
+		return err
+	}
+
+	// same session might be used to log in as somebody else after the logout
+	if record.UserID == userID && time.Since(record.LastSeenAt) < sessionSeenEvery {
+		return nil
+	}
+
+	record.UserID = userID
+	record.LastSeenAt = time.Now()
+	record.IP = CoarseIP(c.ClientIP())
+
+	_, err = record.Update(ctx, exec, boil.Whitelist(
+		core.UserSessionColumns.UserID,
+		core.UserSessionColumns.LastSeenAt,
+		core.UserSessionColumns.IP,
+		core.UserSessionColumns.UpdatedAt,
+	))
+
+	return err
+}
+
+// forgetSession drops the record of the current session, the session itself stays
+func forgetSession(c *gin.Context, exec boil.ContextExecutor) error {
+	key := sessions.Default(c).ID()
+
+	if key == "" {
+		return nil
+	}
+
+	_, err := core.UserSessions(
+		core.UserSessionWhere.SessionKey.EQ(key),
+	).DeleteAll(c.Request.Context(), exec)
+
+	return err
+}
+
+// UserSessions lists the active sessions of the user, the most recent first
+func UserSessions(c *gin.Context, exec boil.ContextExecutor, userID string) ([]*SessionInfo, error) {
+	records, err := core.UserSessions(
+		core.UserSessionWhere.UserID.EQ(userID),
+		qm.Where(sessionAliveClause),
+		qm.OrderBy(core.UserSessionColumns.LastSeenAt+" desc"),
+	).All(c.Request.Context(), exec)
+
+	if err != nil {
+		return nil, err
+	}
+
+	currentKey := sessions.Default(c).ID()
+
+	out := make([]*SessionInfo, 0, len(records))
+
+	for _, record := range records {
+		out = append(out, &SessionInfo{
+			ID:         record.ID,
+			Device:     DescribeUserAgent(record.UserAgent),
+			IP:         record.IP,
+			CreatedAt:  record.CreatedAt,
+			LastSeenAt: record.LastSeenAt,
+			Current:    record.SessionKey == currentKey,
+		})
+	}
+
+	return out, nil
+}
+
+// RevokeSession logs the user out of the session, it's the store
+// that decides whether the user is logged in, hence the session goes first
+func RevokeSession(ctx context.Context, exec boil.ContextExecutor, store pgsession.Store, userID string, sessionID string) error {
+	record, err := core.UserSessions(
+		core.UserSessionWhere.ID.EQ(sessionID),
+		core.UserSessionWhere.UserID.EQ(userID),
+	).One(ctx, exec)
+
+	if err != nil {
+		return err
+	}
+
+	if err := store.DeleteSessions(ctx, exec, []string{record.SessionKey}); err != nil {
+		return err
+	}
+
+	_, err = record.Delete(ctx, exec)
+
+	return err
+}
+
+// RevokeOtherSessions logs the user out on every device except the current one
+func RevokeOtherSessions(c *gin.Context, exec boil.ContextExecutor, store pgsession.Store, userID string) error {
+	return deleteSessions(c.Request.Context(), exec, store, userID, sessions.Default(c).ID())
+}
+
+// DeleteUserSessions logs the user out on every device
+func DeleteUserSessions(ctx context.Context, exec boil.ContextExecutor, store pgsession.Store, userID string) error {
+	return deleteSessions(ctx, exec, store, userID, "")
+}
+
+// deleteSessions ends every session of the user except the one with the given key.
+// Only the tracked sessions are looked at, the ones started before the tracking
+// have been recorded by TrackStoredSessions
+func deleteSessions(ctx context.Context, exec boil.ContextExecutor, store pgsession.Store, userID string, exceptKey string) error {
+	records, err := core.UserSessions(
+		core.UserSessionWhere.UserID.EQ(userID),
+		core.UserSessionWhere.SessionKey.NEQ(exceptKey),
+	).All(ctx, exec)
+
+	if err != nil {
+		return err
+	}
+
+	keys := lo.Map(records, func(r *core.UserSession, idx int) string { return r.SessionKey })
+
+	if err := store.DeleteSessions(ctx, exec, keys); err != nil {
+		return err
+	}
+
+	_, err = records.DeleteAll(ctx, exec)
+
+	return err
+}
+
+// TrackStoredSessions records the sessions the users have logged in with before the
+// tracking and have not used since. It goes through every session in the store,
+// hence it's only run once by cmd/scripts/track_sessions. Returns the count of new records
+func TrackStoredSessions(ctx context.Context, exec boil.ContextExecutor, store pgsession.Store) (int, error) {
+	stored, err := store.Sessions(ctx, exec)
+
+	if err != nil {
+		return 0, err
+	}
+
+	records, err := core.UserSessions(
+		qm.Select(core.UserSessionColumns.SessionKey),
+	).All(ctx, exec)
+
+	if err != nil {
+		return 0, err
+	}
+
+	tracked := lo.SliceToMap(records, func(r *core.UserSession) (string, bool) { return r.SessionKey, true })
+
+	untracked := lo.Filter(stored, func(s *pgsession.StoredSession, idx int) bool {
+		_, loggedIn := s.Values[userkey].(string)
+		return loggedIn && !tracked[s.Key]
+	})
+
+	// the sessions of the deleted accounts are of no use
+	users, err := core.Users(
+		qm.Select(core.UserColumns.ID),
+		core.UserWhere.ID.IN(lo.Uniq(lo.Map(untracked, func(s *pgsession.StoredSession, idx int) string {
+			return s.Values[userkey].(string)
+		}))),
+	).All(ctx, exec)
+
+	if err != nil {
+		return 0, err
+	}
+
+	existing := lo.SliceToMap(users, func(u *core.User) (string, bool) { return u.ID, true })
+
+	count := 0
+
+	for _, s := range untracked {
+		userID := s.Values[userkey].(string)
+
+		if !existing[userID] {
+			continue
+		}
+
+		record := &core.UserSession{
+			ID:         uuid.NewString(),
+			UserID:     userID,
+			SessionKey: s.Key,
+			LastSeenAt: s.ModifiedOn,
+		}
+
+		if err := record.Insert(ctx, exec, boil.Infer()); err != nil {
+			return count, err
+		}
+
+		count++
+	}
+
+	return count, nil
+}
+
+// DeleteEndedSessions forgets the sessions that have expired or have been destroyed by the store
+func DeleteEndedSessions(ctx context.Context, exec boil.ContextExecutor) error {
+	_, err := core.UserSessions(
+		qm.Where("not "+sessionAliveClause),
+	).DeleteAll(ctx, exec)
+
+	return err
+}
+
+// CoarseIP keeps only the network part of the address, enough
+// to tell the location without storing the address of the user
+func CoarseIP(ip string) string {
+	parsed := net.ParseIP(ip)
+
+	if parsed == nil {
+		return ""
+	}
+
+	if v4 := parsed.To4(); v4 != nil {
+		return v4.Mask(net.CIDRMask(24, 32)).String() + "/24"
+	}
+
+	return parsed.Mask(net.CIDRMask(48, 128)).String() + "/48"
+}
+
+// DescribeUserAgent turns the user agent into something like "Firefox 128 on Linux"
+func DescribeUserAgent(s string) string {
+	ua := useragent.Parse(s)
+
+	if ua.Name == "" {
+		return "Unknown device"
+	}
+
+	out := ua.Name
+
+	if ua.VersionNo.Major > 0 {
+		out = fmt.Sprintf("%s %d", out, ua.VersionNo.Major)
+	}
+
+	if ua.OS != "" {
+		out += " on " + ua.OS
+	}
+
+	if ua.Device != "" {
+		out += " (" + ua.Device + ")"
+	}
+
+	return strings.TrimSpace(out)
+}
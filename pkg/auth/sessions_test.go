@@ -0,0 +1,205 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/can3p/pcom/pkg/feedops/testutil"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/testcontainers/postgres"
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoarseIP(t *testing.T) {
+	assert.Equal(t, "203.0.113.0/24", CoarseIP("203.0.113.57"))
+	assert.Equal(t, "2001:db8:85a3::/48", CoarseIP("2001:db8:85a3:8d3:1319:8a2e:370:7348"))
+	assert.Equal(t, "", CoarseIP("not an ip"))
+}
+
+func TestDescribeUserAgent(t *testing.T) {
+	assert.Equal(t, "Firefox 128 on Linux", DescribeUserAgent("Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Firefox/128.0"))
+	assert.Equal(t, "Safari 17 on iOS (iPhone)", DescribeUserAgent("Mozilla/5.0 (iPhone; CPU iPhone OS 17_5 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.5 Mobile/15E148 Safari/604.1"))
+	assert.Equal(t, "Unknown device", DescribeUserAgent(""))
+}
+
+func TestRevokeSessions(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+	exec := testDB.DB
+
+	user, err := testutil.CreateUser(ctx, exec, "user@example.com")
+	require.NoError(t, err)
+	other, err := testutil.CreateUser(ctx, exec, "other@example.com")
+	require.NoError(t, err)
+
+	current := loggedInBrowser(t, exec, user)
+	revoked := loggedInBrowser(t, exec, user)
+	remaining := loggedInBrowser(t, exec, user)
+	unrelated := loggedInBrowser(t, exec, other)
+	// logged in before the sessions were tracked
+	untracked := untrackedBrowser(t, exec, user)
+
+	loggedInAs := func(b *testBrowser) (userID any) {
+		b.do(func(c *gin.Context) {
+			userID = sessions.Default(c).Get(userkey)
+		})
+
+		return userID
+	}
+
+	sessionID := func(b *testBrowser) (id string) {
+		b.do(func(c *gin.Context) {
+			list, err := UserSessions(c, exec, user.ID)
+			require.NoError(t, err)
+
+			for _, s := range list {
+				if s.Current {
+					id = s.ID
+				}
+			}
+		})
+
+		require.NotEmpty(t, id)
+
+		return id
+	}
+
+	current.do(func(c *gin.Context) {
+		list, err := UserSessions(c, exec, user.ID)
+		require.NoError(t, err)
+		require.Len(t, list, 3)
+
+		currentCount := 0
+
+		for _, s := range list {
+			assert.Equal(t, "Firefox 128 on Linux", s.Device)
+			assert.Equal(t, "203.0.113.0/24", s.IP)
+
+			if s.Current {
+				currentCount++
+			}
+		}
+
+		assert.Equal(t, 1, currentCount)
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		otherSessions, err := core.UserSessions(core.UserSessionWhere.UserID.EQ(other.ID)).All(ctx, exec)
+		require.NoError(t, err)
+		require.Len(t, otherSessions, 1)
+
+		// the sessions of somebody else cannot be revoked
+		assert.ErrorIs(t, RevokeSession(ctx, exec, current.store, user.ID, otherSessions[0].ID), sql.ErrNoRows)
+		assert.Equal(t, other.ID, loggedInAs(unrelated))
+
+		require.NoError(t, RevokeSession(ctx, exec, current.store, user.ID, sessionID(revoked)))
+
+		assert.Nil(t, loggedInAs(revoked))
+		assert.Equal(t, user.ID, loggedInAs(current))
+		assert.Equal(t, user.ID, loggedInAs(remaining))
+	})
+
+	t.Run("log out other sessions", func(t *testing.T) {
+		count, err := TrackStoredSessions(ctx, exec, current.store)
+		require.NoError(t, err)
+		require.Equal(t, 1, count, "only the untracked session gets the record")
+
+		current.do(func(c *gin.Context) {
+			require.NoError(t, RevokeOtherSessions(c, exec, current.store, user.ID))
+		})
+
+		assert.Nil(t, loggedInAs(remaining))
+		assert.Nil(t, loggedInAs(untracked))
+		assert.Equal(t, user.ID, loggedInAs(current))
+		assert.Equal(t, other.ID, loggedInAs(unrelated))
+
+		current.do(func(c *gin.Context) {
+			list, err := UserSessions(c, exec, user.ID)
+			require.NoError(t, err)
+			require.Len(t, list, 1)
+			assert.True(t, list[0].Current)
+		})
+	})
+}
+
+func TestDeleteEndedSessions(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+	exec := testDB.DB
+
+	user, err := testutil.CreateUser(ctx, exec, "user@example.com")
+	require.NoError(t, err)
+
+	active := loggedInBrowser(t, exec, user)
+	expired := loggedInBrowser(t, exec, user)
+	destroyed := loggedInBrowser(t, exec, user)
+
+	sessionKey := func(b *testBrowser) (key string) {
+		b.do(func(c *gin.Context) {
+			key = sessions.Default(c).ID()
+		})
+
+		return key
+	}
+
+	_, err = exec.ExecContext(ctx, "update http_sessions set expires_on = now() - interval '1 minute' where key = convert_to($1, 'UTF8')", sessionKey(expired))
+	require.NoError(t, err)
+	require.NoError(t, destroyed.store.DeleteSessions(ctx, exec, []string{sessionKey(destroyed)}))
+
+	require.NoError(t, DeleteEndedSessions(ctx, exec))
+
+	records, err := core.UserSessions(core.UserSessionWhere.UserID.EQ(user.ID)).All(ctx, exec)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, sessionKey(active), records[0].SessionKey)
+}
+
+func TestTrackStoredSessions(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+	exec := testDB.DB
+
+	user, err := testutil.CreateUser(ctx, exec, "user@example.com")
+	require.NoError(t, err)
+	deleted, err := testutil.CreateUser(ctx, exec, "deleted@example.com")
+	require.NoError(t, err)
+
+	tracked := loggedInBrowser(t, exec, user)
+	untrackedBrowser(t, exec, user)
+	untrackedBrowser(t, exec, deleted)
+
+	// anonymous sessions have nobody to end them for
+	newTestBrowser(t, exec).do(func(c *gin.Context) {
+		session := sessions.Default(c)
+		session.Set("csrf_token", "token")
+		require.NoError(t, session.Save())
+	})
+
+	_, err = deleted.Delete(ctx, exec)
+	require.NoError(t, err)
+
+	count, err := TrackStoredSessions(ctx, exec, tracked.store)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	count, err = TrackStoredSessions(ctx, exec, tracked.store)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count, "the sessions are only recorded once")
+
+	records, err := core.UserSessions(core.UserSessionWhere.UserID.EQ(user.ID)).All(ctx, exec)
+	require.NoError(t, err)
+	assert.Len(t, records, 2)
+}
@@ -0,0 +1,274 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/admin"
+	"github.com/can3p/pcom/pkg/mail"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	AttemptLogin        = "login"
+	AttemptSignup       = "signup"
+	AttemptWaitingList  = "waiting_list"
+	AttemptAcceptInvite = "accept_invite"
+	AttemptLoginLink    = "login_link"
+)
+
+type attemptLimit struct {
+	window     time.Duration
+	perIP      int
+	perAccount int
+	// successful logins are not limited, only the failed ones
+	failedOnly bool
+}
+
+// accounts of the login are protected by the delays and lockouts instead of the limit
+var attemptLimits = map[string]attemptLimit{
+	AttemptLogin:        {window: 15 * time.Minute, perIP: 50, failedOnly: true},
+	AttemptSignup:       {window: time.Hour, perIP: 5, perAccount: 3},
+	AttemptWaitingList:  {window: time.Hour, perIP: 5, perAccount: 3},
+	AttemptAcceptInvite: {window: time.Hour, perIP: 10, perAccount: 10},
+	AttemptLoginLink:    {window: time.Hour, perIP: 10, perAccount: 3},
+}
+
+const (
+	// failed logins before the delays kick in, every next one doubles the delay
+	loginFreeAttempts = 3
+	loginMaxDelay     = time.Minute
+	loginLockoutAfter = 10
+	loginLockoutTTL   = 15 * time.Minute
+)
+
+// ThrottledError is returned when the limits have been hit,
+// the attempt can be repeated after RetryAfter
+type ThrottledError struct {
+	RetryAfter time.Duration
+}
+
+func (e *ThrottledError) Error() string {
+	seconds := max(int(math.Ceil(e.RetryAfter.Seconds())), 1)
+
+	if seconds < 60 {
+		return fmt.Sprintf("Too many attempts, please try again in %s", plural(seconds, "second"))
+	}
+
+	return fmt.Sprintf("Too many attempts, please try again in %s", plural(int(math.Ceil(float64(seconds)/60)), "minute"))
+}
+
+func plural(n int, word string) string {
+	if n == 1 {
+		return "1 " + word
+	}
+
+	return fmt.Sprintf("%d %ss", n, word)
+}
+
+var ErrBadCredentials = errors.New("Bad credentials")
+
+// CheckAttempts returns ThrottledError in case the ip or the account
+// have made too many attempts, the account is optional
+func CheckAttempts(ctx context.Context, exec boil.ContextExecutor, action string, ip string, account string) error {
+	limit, ok := attemptLimits[action]
+
+	if !ok {
+		return errors.Errorf("unknown attempt action %s", action)
+	}
+
+	account = attemptAccount(account)
+
+	if limit.perIP > 0 {
+		if err := checkAttemptLimit(ctx, exec, action, limit, limit.perIP, core.AuthAttemptWhere.IP.EQ(ip)); err != nil {
+			return err
+		}
+	}
+
+	if limit.perAccount > 0 && account != "" {
+		if err := checkAttemptLimit(ctx, exec, action, limit, limit.perAccount, core.AuthAttemptWhere.Account.EQ(account)); err != nil {
+			return err
+		}
+	}
+
+	if action != AttemptLogin || account == "" {
+		return nil
+	}
+
+	lockout, err := core.LoginLockouts(
+		core.LoginLockoutWhere.Email.EQ(account),
+		core.LoginLockoutWhere.LockedUntil.GT(time.Now()),
+		qm.OrderBy(core.LoginLockoutColumns.LockedUntil+" desc"),
+	).One(ctx, exec)
+
+	if err == nil {
+		return &ThrottledError{RetryAfter: time.Until(lockout.LockedUntil)}
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	failures, err := recentLoginFailures(ctx, exec, account)
+
+	if err != nil {
+		return err
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	if wait := time.Until(failures[0].CreatedAt.Add(LoginDelay(len(failures)))); wait > 0 {
+		return &ThrottledError{RetryAfter: wait}
+	}
+
+	return nil
+}
+
+func checkAttemptLimit(ctx context.Context, exec boil.ContextExecutor, action string, limit attemptLimit, maxAttempts int, where qm.QueryMod) error {
+	mods := []qm.QueryMod{
+		where,
+		core.AuthAttemptWhere.Action.EQ(action),
+		core.AuthAttemptWhere.CreatedAt.GT(time.Now().Add(-limit.window)),
+	}
+
+	if limit.failedOnly {
+		mods = append(mods, core.AuthAttemptWhere.Failed.EQ(true))
+	}
+
+	count, err := core.AuthAttempts(mods...).Count(ctx, exec)
+
+	if err != nil {
+		return err
+	}
+
+	if count < int64(maxAttempts) {
+		return nil
+	}
+
+	// the limit is lifted once the oldest attempt leaves the window
+	oldest, err := core.AuthAttempts(append(mods, qm.OrderBy(core.AuthAttemptColumns.CreatedAt))...).One(ctx, exec)
+
+	if err != nil {
+		return err
+	}
+
+	return &ThrottledError{RetryAfter: time.Until(oldest.CreatedAt.Add(limit.window))}
+}
+
+// RecordAttempt counts the attempt towards the limits
+func RecordAttempt(ctx context.Context, exec boil.ContextExecutor, action string, ip string, account string, failed bool) error {
+	attempt := &core.AuthAttempt{
+		ID:      uuid.NewString(),
+		Action:  action,
+		IP:      ip,
+		Account: attemptAccount(account),
+		Failed:  failed,
+	}
+
+	return attempt.Insert(ctx, exec, boil.Infer())
+}
+
+// RecordLoginFailure locks the account once there were too many failed attempts
+// in a row, both the owner of the account and the admins get notified about it
+func RecordLoginFailure(ctx context.Context, exec boil.ContextExecutor, s sender.Sender, ip string, email string) error {
+	if err := RecordAttempt(ctx, exec, AttemptLogin, ip, email, true); err != nil {
+		return err
+	}
+
+	account := attemptAccount(email)
+
+	failures, err := recentLoginFailures(ctx, exec, account)
+
+	if err != nil {
+		return err
+	}
+
+	if len(failures) < loginLockoutAfter {
+		return nil
+	}
+
+	lockout := &core.LoginLockout{
+		ID:          uuid.NewString(),
+		Email:       account,
+		IP:          ip,
+		LockedUntil: time.Now().Add(loginLockoutTTL),
+	}
+
+	if err := lockout.Insert(ctx, exec, boil.Infer()); err != nil {
+		return err
+	}
+
+	admin.NotifyLoginLockout(ctx, exec, s, lockout)
+
+	user, err := core.Users(
+		core.UserWhere.Email.EQ(email),
+		core.UserWhere.EmailConfirmedAt.IsNotNull(),
+	).One(ctx, exec)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	return mail.LoginLockout(ctx, exec, s, user, lockout)
+}
+
+// RecordLoginSuccess resets the delays for the account
+func RecordLoginSuccess(ctx context.Context, exec boil.ContextExecutor, ip string, email string) error {
+	return RecordAttempt(ctx, exec, AttemptLogin, ip, email, false)
+}
+
+// recentLoginFailures returns the failed logins since the last
+// successful one within the window, the most recent first
+func recentLoginFailures(ctx context.Context, exec boil.ContextExecutor, account string) (core.AuthAttemptSlice, error) {
+	since := time.Now().Add(-attemptLimits[AttemptLogin].window)
+
+	lastSuccess, err := core.AuthAttempts(
+		core.AuthAttemptWhere.Action.EQ(AttemptLogin),
+		core.AuthAttemptWhere.Account.EQ(account),
+		core.AuthAttemptWhere.Failed.EQ(false),
+		core.AuthAttemptWhere.CreatedAt.GT(since),
+		qm.OrderBy(core.AuthAttemptColumns.CreatedAt+" desc"),
+	).One(ctx, exec)
+
+	if err == nil {
+		since = lastSuccess.CreatedAt
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	// the lockout happens before there is any need for more
+	return core.AuthAttempts(
+		core.AuthAttemptWhere.Action.EQ(AttemptLogin),
+		core.AuthAttemptWhere.Account.EQ(account),
+		core.AuthAttemptWhere.Failed.EQ(true),
+		core.AuthAttemptWhere.CreatedAt.GT(since),
+		qm.OrderBy(core.AuthAttemptColumns.CreatedAt+" desc"),
+		qm.Limit(loginLockoutAfter),
+	).All(ctx, exec)
+}
+
+// LoginDelay is the time to wait after the last failed login before the next attempt
+func LoginDelay(failures int) time.Duration {
+	if failures < loginFreeAttempts {
+		return 0
+	}
+
+	delay := time.Second << min(failures-loginFreeAttempts, 16)
+
+	return min(delay, loginMaxDelay)
+}
+
+func attemptAccount(account string) string {
+	return strings.ToLower(strings.TrimSpace(account))
+}
@@ -0,0 +1,158 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/can3p/pcom/pkg/feedops/testutil"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/testcontainers/postgres"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestLoginDelay(t *testing.T) {
+	assert.Equal(t, time.Duration(0), LoginDelay(0))
+	assert.Equal(t, time.Duration(0), LoginDelay(loginFreeAttempts-1))
+	assert.Equal(t, time.Second, LoginDelay(loginFreeAttempts))
+	assert.Equal(t, 4*time.Second, LoginDelay(loginFreeAttempts+2))
+	assert.Equal(t, loginMaxDelay, LoginDelay(100))
+}
+
+func TestThrottledError(t *testing.T) {
+	assert.Equal(t, "Too many attempts, please try again in 1 second", (&ThrottledError{RetryAfter: 10 * time.Millisecond}).Error())
+	assert.Equal(t, "Too many attempts, please try again in 30 seconds", (&ThrottledError{RetryAfter: 30 * time.Second}).Error())
+	assert.Equal(t, "Too many attempts, please try again in 15 minutes", (&ThrottledError{RetryAfter: 14*time.Minute + time.Second}).Error())
+}
+
+func TestLoginLockout(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+	s := &recordingSender{}
+
+	user, err := testutil.CreateUser(ctx, testDB.DB, "user@example.com")
+	require.NoError(t, err)
+	user.EmailConfirmedAt = null.TimeFrom(time.Now())
+	_, err = user.Update(ctx, testDB.DB, boil.Infer())
+	require.NoError(t, err)
+
+	for i := 0; i < loginFreeAttempts-1; i++ {
+		require.NoError(t, RecordLoginFailure(ctx, testDB.DB, s, "203.0.113.7", "User@Example.com"))
+		assert.NoError(t, CheckAttempts(ctx, testDB.DB, AttemptLogin, "203.0.113.7", "user@example.com"), "free attempt %d", i)
+	}
+
+	require.NoError(t, RecordLoginFailure(ctx, testDB.DB, s, "203.0.113.7", "user@example.com"))
+
+	var throttled *ThrottledError
+	require.ErrorAs(t, CheckAttempts(ctx, testDB.DB, AttemptLogin, "198.51.100.1", "user@example.com"), &throttled, "the delay follows the account")
+	assert.LessOrEqual(t, throttled.RetryAfter, LoginDelay(loginFreeAttempts))
+
+	for i := loginFreeAttempts; i < loginLockoutAfter; i++ {
+		require.NoError(t, RecordLoginFailure(ctx, testDB.DB, s, "203.0.113.7", "user@example.com"))
+	}
+
+	lockouts, err := core.LoginLockouts(core.LoginLockoutWhere.Email.EQ("user@example.com")).All(ctx, testDB.DB)
+	require.NoError(t, err)
+	require.Len(t, lockouts, 1)
+
+	require.ErrorAs(t, CheckAttempts(ctx, testDB.DB, AttemptLogin, "203.0.113.7", "user@example.com"), &throttled)
+	assert.Greater(t, throttled.RetryAfter, loginMaxDelay, "the lockout outlasts any delay")
+
+	// the admins and the owner of the account are told about it
+	require.Len(t, s.mails, 2)
+	assert.Equal(t, "user@example.com", s.mails[1].To[0].Address)
+
+	// a successful login does not lift the lockout
+	require.NoError(t, RecordLoginSuccess(ctx, testDB.DB, "203.0.113.7", "user@example.com"))
+	assert.ErrorAs(t, CheckAttempts(ctx, testDB.DB, AttemptLogin, "203.0.113.7", "user@example.com"), &throttled)
+
+	// other accounts are not affected
+	assert.NoError(t, CheckAttempts(ctx, testDB.DB, AttemptLogin, "203.0.113.7", "other@example.com"))
+}
+
+func TestLoginSuccessResetsDelays(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+	s := &recordingSender{}
+
+	for i := 0; i < loginFreeAttempts+2; i++ {
+		require.NoError(t, RecordLoginFailure(ctx, testDB.DB, s, "203.0.113.7", "user@example.com"))
+	}
+
+	var throttled *ThrottledError
+	require.ErrorAs(t, CheckAttempts(ctx, testDB.DB, AttemptLogin, "203.0.113.7", "user@example.com"), &throttled)
+
+	require.NoError(t, RecordLoginSuccess(ctx, testDB.DB, "203.0.113.7", "user@example.com"))
+	assert.NoError(t, CheckAttempts(ctx, testDB.DB, AttemptLogin, "203.0.113.7", "user@example.com"))
+
+	// the count starts from scratch, the lockout needs the full series again
+	for i := 0; i < loginLockoutAfter-1; i++ {
+		require.NoError(t, RecordLoginFailure(ctx, testDB.DB, s, "203.0.113.7", "user@example.com"))
+	}
+
+	count, err := core.LoginLockouts().Count(ctx, testDB.DB)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+	assert.Empty(t, s.mails)
+}
+
+func TestSecondFactorLoginThrottle(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+	s := &recordingSender{}
+
+	user, err := testutil.CreateUser(ctx, testDB.DB, "user@example.com")
+	require.NoError(t, err)
+	user.TotpSecret = null.StringFrom("JBSWY3DPEHPK3PXP")
+	user.TotpEnabledAt = null.TimeFrom(time.Now())
+	_, err = user.Update(ctx, testDB.DB, boil.Infer())
+	require.NoError(t, err)
+
+	for i := 0; i < loginFreeAttempts; i++ {
+		require.NoError(t, RecordLoginFailure(ctx, testDB.DB, s, "203.0.113.7", user.Email))
+	}
+
+	browser := newTestBrowser(t, testDB.DB)
+
+	// the password alone does not forget the failed attempts
+	browser.do(func(c *gin.Context) {
+		assert.ErrorIs(t, LoginUser(c, testDB.DB, user), ErrSecondFactorRequired)
+	})
+
+	var throttled *ThrottledError
+	require.ErrorAs(t, CheckAttempts(ctx, testDB.DB, AttemptLogin, "203.0.113.7", user.Email), &throttled)
+
+	// wrong codes count towards the lockout of the account
+	browser.do(func(c *gin.Context) {
+		pending, err := PendingSecondFactorUser(c, testDB.DB)
+		require.NoError(t, err)
+		require.NotNil(t, pending)
+		require.NoError(t, FailSecondFactor(c, testDB.DB, s, pending))
+	})
+
+	failures, err := recentLoginFailures(ctx, testDB.DB, user.Email)
+	require.NoError(t, err)
+	assert.Len(t, failures, loginFreeAttempts+1)
+
+	browser.do(func(c *gin.Context) {
+		pending, err := PendingSecondFactorUser(c, testDB.DB)
+		require.NoError(t, err)
+		require.NotNil(t, pending)
+		require.NoError(t, CompleteSecondFactor(c, testDB.DB, pending, false))
+	})
+
+	assert.NoError(t, CheckAttempts(ctx, testDB.DB, AttemptLogin, "203.0.113.7", user.Email))
+}
@@ -0,0 +1,26 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+func newRandomToken() (string, error) {
+	b := make([]byte, 32)
+
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "failed to generate token")
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// tokens are random enough to not need any salt, the hash
+// only makes sure a leaked table cannot be used for anything
+func hashToken(token string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(token)))
+}
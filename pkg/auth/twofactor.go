@@ -0,0 +1,485 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/base32"
+	"encoding/base64"
+	"html/template"
+	"image/png"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/pkg/util"
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	totpIssuer = "pcom"
+	totpPeriod = 30
+	// one step back and forth to cope with the clock drift
+	totpSkew = 1
+
+	recoveryCodesNumber = 10
+
+	// the time the user has to enter the code after the password
+	pendingLoginTTL         = 10 * time.Minute
+	twoFactorRequiredTTL    = time.Minute
+	maxSecondFactorAttempts = 5
+
+	pendingUserKey     = "pending_user"
+	pendingUserAtKey   = "pending_user_at"
+	pendingAttemptsKey = "pending_attempts"
+
+	deviceCookieName = "pcom_device"
+)
+
+var ErrSecondFactorRequired = errors.New("second factor is required")
+
+var totpOpts = totp.ValidateOpts{
+	Period:    totpPeriod,
+	Skew:      totpSkew,
+	Digits:    otp.DigitsSix,
+	Algorithm: otp.AlgorithmSHA1,
+}
+
+func HasTOTP(user *core.User) bool {
+	return user.TotpEnabledAt.Valid && user.TotpSecret.Valid
+}
+
+// NewTOTPKey generates the secret to enroll, nothing is stored
+// until the user proves the authenticator app has it
+func NewTOTPKey(user *core.User) (*otp.Key, error) {
+	return totp.Generate(totp.GenerateOpts{
+		Issuer:      totpIssuer,
+		AccountName: user.Email,
+		Period:      totpPeriod,
+		Digits:      otp.DigitsSix,
+		Algorithm:   otp.AlgorithmSHA1,
+	})
+}
+
+// TOTPQRCode renders the key as a data uri to scan with the authenticator app
+func TOTPQRCode(key *otp.Key) (template.URL, error) {
+	img, err := key.Image(200, 200)
+
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+
+	if err := png.Encode(&buf, img); err != nil {
+		return "", err
+	}
+
+	return template.URL("data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes())), nil
+}
+
+// ValidateTOTPCode checks the code against the secret that is not stored yet
+func ValidateTOTPCode(secret string, code string) bool {
+	ok, err := totp.ValidateCustom(normalizeCode(code), secret, time.Now(), totpOpts)
+
+	return err == nil && ok
+}
+
+// EnableTwoFactor stores the secret and returns fresh recovery codes
+func EnableTwoFactor(ctx context.Context, exec boil.ContextExecutor, user *core.User, secret string) ([]string, error) {
+	user.TotpSecret = null.StringFrom(secret)
+	user.TotpEnabledAt = null.TimeFrom(time.Now())
+	user.TotpLastStep = null.Int64{}
+
+	if _, err := user.Update(ctx, exec, boil.Whitelist(
+		core.UserColumns.TotpSecret,
+		core.UserColumns.TotpEnabledAt,
+		core.UserColumns.TotpLastStep,
+		core.UserColumns.UpdatedAt,
+	)); err != nil {
+		return nil, err
+	}
+
+	return RegenerateRecoveryCodes(ctx, exec, user)
+}
+
+// DisableTwoFactor drops the secret along with the recovery codes
+// and the devices that were remembered
+func DisableTwoFactor(ctx context.Context, exec boil.ContextExecutor, user *core.User) error {
+	user.TotpSecret = null.String{}
+	user.TotpEnabledAt = null.Time{}
+	user.TotpLastStep = null.Int64{}
+
+	if _, err := user.Update(ctx, exec, boil.Whitelist(
+		core.UserColumns.TotpSecret,
+		core.UserColumns.TotpEnabledAt,
+		core.UserColumns.TotpLastStep,
+		core.UserColumns.UpdatedAt,
+	)); err != nil {
+		return err
+	}
+
+	if _, err := core.UserRecoveryCodes(
+		core.UserRecoveryCodeWhere.UserID.EQ(user.ID),
+	).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+
+	_, err := core.UserRememberedDevices(
+		core.UserRememberedDeviceWhere.UserID.EQ(user.ID),
+	).DeleteAll(ctx, exec)
+
+	return err
+}
+
+// RegenerateRecoveryCodes replaces all the codes of the user, the codes
+// are only stored as hashes and cannot be shown again
+func RegenerateRecoveryCodes(ctx context.Context, exec boil.ContextExecutor, user *core.User) ([]string, error) {
+	if _, err := core.UserRecoveryCodes(
+		core.UserRecoveryCodeWhere.UserID.EQ(user.ID),
+	).DeleteAll(ctx, exec); err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, 0, recoveryCodesNumber)
+
+	for range recoveryCodesNumber {
+		code, err := newRecoveryCode()
+
+		if err != nil {
+			return nil, err
+		}
+
+		rc := &core.UserRecoveryCode{
+			ID:       uuid.NewString(),
+			UserID:   user.ID,
+			CodeHash: hashToken(normalizeCode(code)),
+		}
+
+		if err := rc.Insert(ctx, exec, boil.Infer()); err != nil {
+			return nil, err
+		}
+
+		codes = append(codes, code)
+	}
+
+	return codes, nil
+}
+
+func RecoveryCodesLeft(ctx context.Context, exec boil.ContextExecutor, user *core.User) (int64, error) {
+	return core.UserRecoveryCodes(
+		core.UserRecoveryCodeWhere.UserID.EQ(user.ID),
+		core.UserRecoveryCodeWhere.UsedAt.IsNull(),
+	).Count(ctx, exec)
+}
+
+// VerifySecondFactor accepts either the code from the authenticator app
+// or one of the recovery codes, both can be used only once
+func VerifySecondFactor(ctx context.Context, exec boil.ContextExecutor, user *core.User, code string) (bool, error) {
+	if !HasTOTP(user) {
+		return false, nil
+	}
+
+	code = normalizeCode(code)
+
+	if step, ok := matchTOTPStep(user, code); ok {
+		// the user could have been loaded before a parallel request has used the same code,
+		// it's the row that has the final word
+		updated, err := core.Users(
+			core.UserWhere.ID.EQ(user.ID),
+			qm.Expr(
+				core.UserWhere.TotpLastStep.IsNull(),
+				qm.Or2(core.UserWhere.TotpLastStep.LT(null.Int64From(step))),
+			),
+		).UpdateAll(ctx, exec, core.M{
+			core.UserColumns.TotpLastStep: step,
+			core.UserColumns.UpdatedAt:    time.Now(),
+		})
+
+		if err != nil {
+			return false, err
+		}
+
+		if updated == 0 {
+			return false, nil
+		}
+
+		user.TotpLastStep = null.Int64From(step)
+
+		return true, nil
+	}
+
+	// totp codes are short and numeric, recovery codes are not
+	if len(code) <= 6 {
+		return false, nil
+	}
+
+	updated, err := core.UserRecoveryCodes(
+		core.UserRecoveryCodeWhere.UserID.EQ(user.ID),
+		core.UserRecoveryCodeWhere.CodeHash.EQ(hashToken(code)),
+		core.UserRecoveryCodeWhere.UsedAt.IsNull(),
+	).UpdateAll(ctx, exec, core.M{
+		core.UserRecoveryCodeColumns.UsedAt:    time.Now(),
+		core.UserRecoveryCodeColumns.UpdatedAt: time.Now(),
+	})
+
+	if err != nil {
+		return false, err
+	}
+
+	return updated > 0, nil
+}
+
+// matchTOTPStep returns the time step the code belongs to,
+// the steps that were used already are never accepted again
+func matchTOTPStep(user *core.User, code string) (int64, bool) {
+	now := time.Now()
+
+	for offset := -totpSkew; offset <= totpSkew; offset++ {
+		t := now.Add(time.Duration(offset*totpPeriod) * time.Second)
+		step := t.Unix() / totpPeriod
+
+		if user.TotpLastStep.Valid && step <= user.TotpLastStep.Int64 {
+			continue
+		}
+
+		expected, err := totp.GenerateCodeCustom(user.TotpSecret.String, t, totpOpts)
+
+		if err != nil {
+			return 0, false
+		}
+
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// TwoFactorRequired tells whether the admins want everyone to have the second factor
+func TwoFactorRequired(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	settings, err := core.SystemSettings().One(ctx, exec)
+
+	if err != nil {
+		return false, err
+	}
+
+	return settings.TwoFactorRequired, nil
+}
+
+// twoFactorRequiredCache saves the settings lookup on every request of the logged in users,
+// the admins can wait a bit for the change to take effect
+type twoFactorRequiredCache struct {
+	mu        sync.Mutex
+	required  bool
+	checkedAt time.Time
+}
+
+var twoFactorRequired = &twoFactorRequiredCache{}
+
+func (rc *twoFactorRequiredCache) get(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if !rc.checkedAt.IsZero() && time.Since(rc.checkedAt) < twoFactorRequiredTTL {
+		return rc.required, nil
+	}
+
+	required, err := TwoFactorRequired(ctx, exec)
+
+	if err != nil {
+		return false, err
+	}
+
+	rc.required = required
+	rc.checkedAt = time.Now()
+
+	return required, nil
+}
+
+// startSecondFactor keeps the user half logged in until the code is entered
+func startSecondFactor(c *gin.Context, user *core.User) error {
+	session := sessions.Default(c)
+
+	session.Set(pendingUserKey, user.ID)
+	session.Set(pendingUserAtKey, time.Now().Unix())
+	session.Set(pendingAttemptsKey, 0)
+
+	if err := session.Save(); err != nil {
+		return errors.Wrapf(err, "Failed to save session")
+	}
+
+	return nil
+}
+
+// PendingSecondFactorUser returns the user who has entered the password
+// and is expected to enter the code now, nil if there is none
+func PendingSecondFactorUser(c *gin.Context, exec boil.ContextExecutor) (*core.User, error) {
+	session := sessions.Default(c)
+
+	userID, ok := session.Get(pendingUserKey).(string)
+
+	if !ok {
+		return nil, nil
+	}
+
+	startedAt, _ := session.Get(pendingUserAtKey).(int64)
+
+	if time.Since(time.Unix(startedAt, 0)) > pendingLoginTTL {
+		return nil, clearSecondFactor(session)
+	}
+
+	user, err := core.FindUser(c.Request.Context(), exec, userID)
+
+	if err == sql.ErrNoRows {
+		return nil, clearSecondFactor(session)
+	}
+
+	return user, err
+}
+
+// FailSecondFactor counts the wrong codes towards the lockout of the account,
+// the user also has to start over with the password once there were too many of them
+func FailSecondFactor(c *gin.Context, exec boil.ContextExecutor, s sender.Sender, user *core.User) error {
+	if err := RecordLoginFailure(c.Request.Context(), exec, s, c.ClientIP(), user.Email); err != nil {
+		return err
+	}
+
+	session := sessions.Default(c)
+
+	attempts, _ := session.Get(pendingAttemptsKey).(int)
+	attempts++
+
+	if attempts >= maxSecondFactorAttempts {
+		return clearSecondFactor(session)
+	}
+
+	session.Set(pendingAttemptsKey, attempts)
+
+	return session.Save()
+}
+
+// CompleteSecondFactor logs the user in once the code has been verified,
+// only now the failed attempts of the account can be forgotten
+func CompleteSecondFactor(c *gin.Context, exec boil.ContextExecutor, user *core.User, remember bool) error {
+	session := sessions.Default(c)
+
+	session.Delete(pendingUserKey)
+	session.Delete(pendingUserAtKey)
+	session.Delete(pendingAttemptsKey)
+	setSessionUser(session, user.ID)
+
+	if err := session.Save(); err != nil {
+		return errors.Wrapf(err, "Failed to save session")
+	}
+
+	if err := trackSession(c, exec, user.ID); err != nil {
+		return err
+	}
+
+	if err := RecordLoginSuccess(c.Request.Context(), exec, c.ClientIP(), user.Email); err != nil {
+		return err
+	}
+
+	if !remember {
+		return nil
+	}
+
+	return rememberDevice(c, exec, user)
+}
+
+func clearSecondFactor(session sessions.Session) error {
+	session.Delete(pendingUserKey)
+	session.Delete(pendingUserAtKey)
+	session.Delete(pendingAttemptsKey)
+
+	return session.Save()
+}
+
+func rememberDevice(c *gin.Context, exec boil.ContextExecutor, user *core.User) error {
+	settings, err := core.SystemSettings().One(c.Request.Context(), exec)
+
+	if err != nil {
+		return err
+	}
+
+	if settings.TwoFactorRememberDays <= 0 {
+		return nil
+	}
+
+	token, err := newRandomToken()
+
+	if err != nil {
+		return err
+	}
+
+	ttl := time.Duration(settings.TwoFactorRememberDays) * 24 * time.Hour
+
+	device := &core.UserRememberedDevice{
+		ID:        uuid.NewString(),
+		UserID:    user.ID,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().Add(ttl),
+	}
+
+	if err := device.Insert(c.Request.Context(), exec, boil.Infer()); err != nil {
+		return err
+	}
+
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     deviceCookieName,
+		Value:    token,
+		Path:     "/",
+		MaxAge:   int(ttl.Seconds()),
+		Secure:   util.InCluster(),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	return nil
+}
+
+func deviceRemembered(c *gin.Context, exec boil.ContextExecutor, user *core.User) (bool, error) {
+	token, err := c.Cookie(deviceCookieName)
+
+	if err != nil || token == "" {
+		return false, nil
+	}
+
+	return core.UserRememberedDevices(
+		core.UserRememberedDeviceWhere.UserID.EQ(user.ID),
+		core.UserRememberedDeviceWhere.TokenHash.EQ(hashToken(token)),
+		core.UserRememberedDeviceWhere.ExpiresAt.GT(time.Now()),
+	).Exists(c.Request.Context(), exec)
+}
+
+// recovery codes look like abcde-fghij to be easier to type
+func newRecoveryCode() (string, error) {
+	b := make([]byte, 7)
+
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "failed to generate recovery code")
+	}
+
+	code := strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b))[:10]
+
+	return code[:5] + "-" + code[5:], nil
+}
+
+func normalizeCode(code string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(code)))
+}
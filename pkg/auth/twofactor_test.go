@@ -0,0 +1,120 @@
+package auth
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/can3p/pcom/pkg/feedops/testutil"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/testcontainers/postgres"
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestMatchTOTPStep(t *testing.T) {
+	key, err := NewTOTPKey(&core.User{Email: "user@example.com"})
+	require.NoError(t, err)
+
+	user := &core.User{
+		TotpSecret:    null.StringFrom(key.Secret()),
+		TotpEnabledAt: null.TimeFrom(time.Now()),
+	}
+
+	code, err := totp.GenerateCodeCustom(key.Secret(), time.Now(), totpOpts)
+	require.NoError(t, err)
+
+	step, ok := matchTOTPStep(user, code)
+	assert.True(t, ok)
+	assert.InDelta(t, time.Now().Unix()/totpPeriod, step, 1)
+
+	user.TotpLastStep = null.Int64From(step)
+
+	_, ok = matchTOTPStep(user, code)
+	assert.False(t, ok, "codes should not be accepted twice")
+
+	_, ok = matchTOTPStep(&core.User{TotpSecret: user.TotpSecret}, "000000x")
+	assert.False(t, ok)
+}
+
+func TestVerifySecondFactorOnce(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+	exec := testDB.DB
+
+	user, err := testutil.CreateUser(ctx, exec, "user@example.com")
+	require.NoError(t, err)
+
+	key, err := NewTOTPKey(user)
+	require.NoError(t, err)
+
+	user.TotpSecret = null.StringFrom(key.Secret())
+	user.TotpEnabledAt = null.TimeFrom(time.Now())
+	_, err = user.Update(ctx, exec, boil.Infer())
+	require.NoError(t, err)
+
+	// both requests have loaded the user before any of them has used the code
+	first, err := core.FindUser(ctx, exec, user.ID)
+	require.NoError(t, err)
+	second, err := core.FindUser(ctx, exec, user.ID)
+	require.NoError(t, err)
+
+	code, err := totp.GenerateCodeCustom(key.Secret(), time.Now(), totpOpts)
+	require.NoError(t, err)
+
+	ok, err := VerifySecondFactor(ctx, exec, first, code)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = VerifySecondFactor(ctx, exec, second, code)
+	require.NoError(t, err)
+	assert.False(t, ok, "the code has been used by the parallel request")
+}
+
+func TestTwoFactorRequiredCache(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+	exec := testDB.DB
+
+	settings, err := core.SystemSettings().One(ctx, exec)
+	require.NoError(t, err)
+
+	cache := &twoFactorRequiredCache{}
+
+	required, err := cache.get(ctx, exec)
+	require.NoError(t, err)
+	assert.Equal(t, settings.TwoFactorRequired, required)
+
+	settings.TwoFactorRequired = !settings.TwoFactorRequired
+	_, err = settings.Update(ctx, exec, boil.Infer())
+	require.NoError(t, err)
+
+	required, err = cache.get(ctx, exec)
+	require.NoError(t, err)
+	assert.NotEqual(t, settings.TwoFactorRequired, required, "the value is cached for a while")
+
+	cache.checkedAt = time.Now().Add(-twoFactorRequiredTTL)
+
+	required, err = cache.get(ctx, exec)
+	require.NoError(t, err)
+	assert.Equal(t, settings.TwoFactorRequired, required)
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	code, err := newRecoveryCode()
+	require.NoError(t, err)
+
+	assert.Regexp(t, regexp.MustCompile(`^[a-z2-7]{5}-[a-z2-7]{5}$`), code)
+	assert.Equal(t, normalizeCode(code), normalizeCode(" "+code[:5]+" "+code[6:]+" "))
+	assert.Len(t, normalizeCode(code), 10)
+}
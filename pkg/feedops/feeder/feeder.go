@@ -3,6 +3,7 @@ package feeder
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/can3p/gogo/util/transact"
 	"github.com/can3p/pcom/pkg/feedops/reader"
+	"github.com/can3p/pcom/pkg/feedops/rules"
 	"github.com/can3p/pcom/pkg/markdown"
 	"github.com/can3p/pcom/pkg/media"
 	"github.com/can3p/pcom/pkg/media/server"
@@ -30,9 +32,32 @@ const (
 	maxInitialFetchItems = 5
 )
 
+// markdownError means that the html could not be converted at all
+type markdownError struct {
+	err error
+}
+
+func (e *markdownError) Error() string {
+	return e.err.Error()
+}
+
 type fetcher interface {
 	Fetch(ctx context.Context, url string) (*reader.Feed, error)
 	FetchMedia(ctx context.Context, mediaURL string) (io.ReadCloser, error)
+	FetchArticle(ctx context.Context, articleURL string) (string, error)
+}
+
+type parser interface {
+	Parse(body []byte) (*reader.Feed, error)
+}
+
+type pollingFetcher interface {
+	fetcher
+	parser
+}
+
+type hub interface {
+	Subscribe(ctx context.Context, hubURL, topicURL, callbackURL, secret string) error
 }
 
 type cleaner interface {
@@ -42,17 +67,21 @@ type cleaner interface {
 
 type Feeder struct {
 	db           *sqlx.DB
-	fetcher      fetcher
+	fetcher      pollingFetcher
 	cleaner      cleaner
 	mediaStorage server.MediaStorage
+	hub          hub
+	callbackLink func(feedID string) string
 }
 
-func NewFeeder(db *sqlx.DB, fetcher fetcher, cleaner cleaner, mediaStorage server.MediaStorage) *Feeder {
+func NewFeeder(db *sqlx.DB, fetcher pollingFetcher, cleaner cleaner, mediaStorage server.MediaStorage, hub hub, callbackLink func(feedID string) string) *Feeder {
 	return &Feeder{
 		db:           db,
 		fetcher:      fetcher,
 		cleaner:      cleaner,
 		mediaStorage: mediaStorage,
+		hub:          hub,
+		callbackLink: callbackLink,
 	}
 }
 
@@ -65,6 +94,14 @@ func (f *Feeder) RunPoller(ctx context.Context) {
 			if err := f.refreshFeeds(ctx); err != nil {
 				slog.Warn("Failed to refreshFeeds", "err", err.Error())
 			}
+
+			if err := f.renewPushSubscriptions(ctx); err != nil {
+				slog.Warn("Failed to renewPushSubscriptions", "err", err.Error())
+			}
+
+			if err := f.processPushes(ctx); err != nil {
+				slog.Warn("Failed to processPushes", "err", err.Error())
+			}
 		case <-ctx.Done():
 			return
 		}
@@ -117,7 +154,23 @@ func (f *Feeder) tryFetchFeed(ctx context.Context, exec boil.ContextExecutor, fe
 		return nil
 	}
 
-	return SaveFeed(ctx, exec, feed, rssFeed, f.cleaner, f.fetcher, f.mediaStorage)
+	hadPush := HasActivePushSubscription(feed)
+	UpdateHubDetails(feed, rssFeed)
+
+	if err := SaveFeed(ctx, exec, feed, rssFeed, f.cleaner, f.fetcher, f.mediaStorage); err != nil {
+		return err
+	}
+
+	// polling is only a safety net for the feeds with push subscriptions,
+	// if it still finds anything new, the hub is not doing its job and
+	// we'd better rely on polling until the next subscription attempt
+	if hadPush && feed.LastItemsCount > 0 {
+		slog.Info("hub missed new items, falling back to polling", "feed_id", feed.ID, "hub", feed.WebsubHubURL.String)
+
+		return DropPushSubscription(ctx, exec, feed)
+	}
+
+	return nil
 }
 
 func GetFeedsToRefresh(ctx context.Context, exec boil.ContextExecutor) ([]*core.RSSFeed, error) {
@@ -164,25 +217,41 @@ func SaveFetchFailure(ctx context.Context, exec boil.ContextExecutor, feed *core
 }
 
 func SaveFeed(ctx context.Context, exec boil.ContextExecutor, feed *core.RSSFeed, rssFeed *reader.Feed, cleaner cleaner, fetcher fetcher, mediaStorage server.MediaStorage) error {
-	var err error
+	items, err := prepareFeedItems(ctx, exec, feed.ID, rssFeed, cleaner, fetcher, mediaStorage)
 
-	if feed.Title.IsZero() {
-		cleaned := cleaner.CleanField(rssFeed.Title)
-		feed.Title = null.NewString(cleaned, cleaned != "")
+	if err != nil {
+		return err
 	}
 
-	if feed.Description.IsZero() {
-		cleaned := cleaner.CleanField(rssFeed.Description)
-		feed.Description = null.NewString(cleaned, cleaned != "")
+	newItems, err := saveFeedItems(ctx, exec, feed.ID, items, cleaner)
+
+	if err != nil {
+		return err
 	}
 
+	return saveFeedState(ctx, exec, feed, rssFeed, newItems, cleaner)
+}
+
+// preparedItem is the feed item along with everything fetched for it,
+// saving it takes nothing but the database
+type preparedItem struct {
+	*reader.Item
+	Content          string
+	FullContent      null.String
+	FullContentError null.String
+}
+
+// prepareFeedItems picks the new items of the feed and fetches their content.
+// This is where the images get rehosted and the full articles downloaded,
+// the feed itself and its items are not touched
+func prepareFeedItems(ctx context.Context, exec boil.ContextExecutor, feedID string, rssFeed *reader.Feed, cleaner cleaner, fetcher fetcher, mediaStorage server.MediaStorage) ([]*preparedItem, error) {
 	// Check if this is an initial fetch by seeing if any items exist for this feed
 	isInitialFetch := false
 	existingCount, err := core.RSSItems(
-		core.RSSItemWhere.FeedID.EQ(feed.ID),
+		core.RSSItemWhere.FeedID.EQ(feedID),
 	).Count(ctx, exec)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	isInitialFetch = existingCount == 0
 
@@ -192,12 +261,11 @@ func SaveFeed(ctx context.Context, exec boil.ContextExecutor, feed *core.RSSFeed
 		totalItemsToFetch = maxInitialFetchItems
 	}
 
-	// Get subscribers once for all items
 	subscribers, err := core.UserFeedSubscriptions(
-		core.UserFeedSubscriptionWhere.FeedID.EQ(feed.ID),
+		core.UserFeedSubscriptionWhere.FeedID.EQ(feedID),
 	).All(ctx, exec)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Prefetch to find the index of the first known item
@@ -213,15 +281,15 @@ func SaveFeed(ctx context.Context, exec boil.ContextExecutor, feed *core.RSSFeed
 
 			url, err := postops.StoreURL(ctx, exec, item.URL)
 			if err != nil {
-				return err
+				return nil, err
 			}
 
 			exists, err := core.RSSItems(
-				core.RSSItemWhere.FeedID.EQ(feed.ID),
+				core.RSSItemWhere.FeedID.EQ(feedID),
 				core.RSSItemWhere.URLID.EQ(url.ID),
 			).Exists(ctx, exec)
 			if err != nil {
-				return err
+				return nil, err
 			}
 
 			if exists {
@@ -232,15 +300,59 @@ func SaveFeed(ctx context.Context, exec boil.ContextExecutor, feed *core.RSSFeed
 		}
 	}
 
-	// Insert items in chronological order (from oldest new item to newest)
+	// the page is only downloaded if someone actually wants to read it
+	wantsFullArticle := lo.SomeBy(subscribers, func(s *core.UserFeedSubscription) bool {
+		return s.FetchFullArticle
+	})
+
+	// Items are saved in chronological order (from oldest new item to newest)
 	// This means iterating from firstKnownItemIdx-1 down to 0
-	newItems := 0
+	items := make([]*preparedItem, 0, firstKnownItemIdx)
 	for idx := firstKnownItemIdx - 1; idx >= 0; idx-- {
-		item := rssFeed.Items[idx]
-		isNew, err := SaveFeedItem(ctx, exec, feed.ID, item, subscribers, cleaner, fetcher, mediaStorage)
+		item := &preparedItem{Item: rssFeed.Items[idx]}
+
+		if item.URL == "" {
+			return nil, fmt.Errorf("refuse to save an rss item without URL")
+		}
+
+		item.Content, err = cleanContent(ctx, exec, feedID, item.Summary, cleaner, fetcher, mediaStorage)
+
+		var mdErr *markdownError
+
+		if errors.As(err, &mdErr) {
+			item.Content = fmt.Sprintf("Summary errors: %s", err.Error())
+		} else if err != nil {
+			return nil, err
+		}
+
+		if wantsFullArticle {
+			if err := fetchFullArticle(ctx, exec, feedID, item, cleaner, fetcher, mediaStorage); err != nil {
+				return nil, err
+			}
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// saveFeedItems stores the prepared items of the feed and returns the count of the new ones
+func saveFeedItems(ctx context.Context, exec boil.ContextExecutor, feedID string, items []*preparedItem, cleaner cleaner) (int, error) {
+	// Get subscribers once for all items
+	subscribers, err := core.UserFeedSubscriptions(
+		core.UserFeedSubscriptionWhere.FeedID.EQ(feedID),
+	).All(ctx, exec)
+	if err != nil {
+		return 0, err
+	}
+
+	newItems := 0
+	for _, item := range items {
+		isNew, err := saveFeedItem(ctx, exec, feedID, item, subscribers, cleaner)
 
 		if err != nil {
-			return err
+			return 0, err
 		}
 
 		if isNew {
@@ -248,6 +360,23 @@ func SaveFeed(ctx context.Context, exec boil.ContextExecutor, feed *core.RSSFeed
 		}
 	}
 
+	return newItems, nil
+}
+
+// saveFeedState updates the feed after a fetch or a push
+func saveFeedState(ctx context.Context, exec boil.ContextExecutor, feed *core.RSSFeed, rssFeed *reader.Feed, newItems int, cleaner cleaner) error {
+	var err error
+
+	if feed.Title.IsZero() {
+		cleaned := cleaner.CleanField(rssFeed.Title)
+		feed.Title = null.NewString(cleaned, cleaned != "")
+	}
+
+	if feed.Description.IsZero() {
+		cleaned := cleaner.CleanField(rssFeed.Description)
+		feed.Description = null.NewString(cleaned, cleaned != "")
+	}
+
 	// Update average items per day using exponential moving average
 	feed.AvgItemsPerDay, err = calculateNewAverage(ctx, exec, feed.ID, avgWindowDays)
 
@@ -268,7 +397,11 @@ func SaveFeed(ctx context.Context, exec boil.ContextExecutor, feed *core.RSSFeed
 	wasManual := false
 
 	// Calculate next fetch time
-	feed.NextFetchAt = null.TimeFrom(reader.CalculateNextFetchTime(feed.ConsecutiveEmptyFetches, feed.AvgItemsPerDay, wasManual))
+	if HasActivePushSubscription(feed) {
+		feed.NextFetchAt = null.TimeFrom(time.Now().Add(reader.PushFetchInterval))
+	} else {
+		feed.NextFetchAt = null.TimeFrom(reader.CalculateNextFetchTime(feed.ConsecutiveEmptyFetches, feed.AvgItemsPerDay, wasManual))
+	}
 
 	// Update last manual refresh time if this was a manual fetch
 	if wasManual {
@@ -283,11 +416,7 @@ func SaveFeed(ctx context.Context, exec boil.ContextExecutor, feed *core.RSSFeed
 	return err
 }
 
-func SaveFeedItem(ctx context.Context, exec boil.ContextExecutor, feedID string, rssFeedItem *reader.Item, subscribers core.UserFeedSubscriptionSlice, cleaner cleaner, fetcher fetcher, mediaStorage server.MediaStorage) (bool, error) {
-	if rssFeedItem.URL == "" {
-		return false, fmt.Errorf("refuse to save an rss item without URL")
-	}
-
+func saveFeedItem(ctx context.Context, exec boil.ContextExecutor, feedID string, rssFeedItem *preparedItem, subscribers core.UserFeedSubscriptionSlice, cleaner cleaner) (bool, error) {
 	url, err := postops.StoreURL(ctx, exec, rssFeedItem.URL)
 
 	if err != nil {
@@ -301,40 +430,6 @@ func SaveFeedItem(ctx context.Context, exec boil.ContextExecutor, feedID string,
 	}
 	feedItemID := itemID.String()
 
-	markdownContent, err := cleaner.HTMLToMarkdown(rssFeedItem.Summary)
-
-	if err != nil {
-		markdownContent = fmt.Sprintf("Summary errors: %s", err.Error())
-	} else {
-		// Create a context with global timeout for all image downloads
-		downloadCtx, cancel := context.WithTimeout(ctx, reader.GlobalImageDownloadTimeout)
-		defer cancel()
-
-		// XXX: the code is kind of backwards because we're passing the control to cleaner
-		// only for it to extract urls and call us back to upload them and the return a replacer
-		// that we will call there. We could just
-		// On the other hand I don't want to have another abstraction and also do not want to
-		// inflate the function logic there.
-
-		uploadFunc := func(imageURL string) (string, error) {
-			readerIO, err := fetcher.FetchMedia(downloadCtx, imageURL)
-			if err != nil {
-				return "", err
-			}
-			defer func() { _ = readerIO.Close() }()
-
-			// XXX: using download context for upload to maintain timeout consistency
-			return media.HandleUpload(downloadCtx, exec, mediaStorage, nil, &feedID, readerIO)
-		}
-
-		replacer := reader.CreateImageReplacer(markdownContent, uploadFunc)
-
-		markdownContent, err = markdown.ReplaceImageUrlsOrLinkify(markdownContent, replacer)
-		if err != nil {
-			return false, fmt.Errorf("failed to process images in feed item: %w", err)
-		}
-	}
-
 	publishedAt := time.Now()
 
 	if rssFeedItem.PublishedAt != nil {
@@ -349,7 +444,9 @@ func SaveFeedItem(ctx context.Context, exec boil.ContextExecutor, feedID string,
 		Title:                cleaner.CleanField(rssFeedItem.Title),
 		Description:          rssFeedItem.Summary,
 		PublishedAt:          publishedAt,
-		SanitizedDescription: markdownContent,
+		SanitizedDescription: rssFeedItem.Content,
+		FullContent:          rssFeedItem.FullContent,
+		FullContentError:     rssFeedItem.FullContentError,
 	}
 
 	// Try to insert, if URL exists, get existing ID
@@ -369,6 +466,13 @@ func SaveFeedItem(ctx context.Context, exec boil.ContextExecutor, feedID string,
 		return false, nil
 	}
 
+	userRules, err := rules.LoadRules(ctx, exec, lo.Map(subscribers, func(s *core.UserFeedSubscription, idx int) string {
+		return s.UserID
+	}))
+	if err != nil {
+		return false, err
+	}
+
 	// Create user feed items with generated UUIDs (chronological ordering)
 	for _, s := range subscribers {
 		userItemID, err := uuid.NewV7()
@@ -383,6 +487,12 @@ func SaveFeedItem(ctx context.Context, exec boil.ContextExecutor, feedID string,
 			URLID:     url.ID,
 		}
 
+		outcome := rules.Evaluate(userRules[s.UserID], s.ID, feedItem, url.URL)
+
+		if err := rules.Apply(ctx, exec, outcome, &userItem, feedItem); err != nil {
+			return false, err
+		}
+
 		if err := userItem.Insert(ctx, exec, boil.Infer()); err != nil {
 			return false, err
 		}
@@ -392,6 +502,75 @@ func SaveFeedItem(ctx context.Context, exec boil.ContextExecutor, feedID string,
 	return true, nil
 }
 
+// fetchFullArticle downloads the page of the item, a broken page
+// is not a reason to fail the whole feed, subscribers will still see the summary
+func fetchFullArticle(ctx context.Context, exec boil.ContextExecutor, feedID string, item *preparedItem, cleaner cleaner, fetcher fetcher, mediaStorage server.MediaStorage) error {
+	articleCtx, cancel := context.WithTimeout(ctx, reader.ArticleDownloadTimeout)
+	defer cancel()
+
+	articleHTML, err := fetcher.FetchArticle(articleCtx, item.URL)
+
+	if err != nil {
+		item.FullContentError = null.StringFrom(err.Error())
+		return nil
+	}
+
+	content, err := cleanContent(ctx, exec, feedID, articleHTML, cleaner, fetcher, mediaStorage)
+
+	var mdErr *markdownError
+
+	if errors.As(err, &mdErr) {
+		item.FullContentError = null.StringFrom(err.Error())
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	item.FullContent = null.StringFrom(content)
+
+	return nil
+}
+
+// cleanContent converts feed html into markdown and rehosts all the images,
+// *markdownError is returned when the html could not be converted
+func cleanContent(ctx context.Context, exec boil.ContextExecutor, feedID string, html string, cleaner cleaner, fetcher fetcher, mediaStorage server.MediaStorage) (string, error) {
+	markdownContent, err := cleaner.HTMLToMarkdown(html)
+
+	if err != nil {
+		return "", &markdownError{err: err}
+	}
+
+	// Create a context with global timeout for all image downloads
+	downloadCtx, cancel := context.WithTimeout(ctx, reader.GlobalImageDownloadTimeout)
+	defer cancel()
+
+	// XXX: the code is kind of backwards because we're passing the control to cleaner
+	// only for it to extract urls and call us back to upload them and the return a replacer
+	// that we will call there. We could just
+	// On the other hand I don't want to have another abstraction and also do not want to
+	// inflate the function logic there.
+
+	uploadFunc := func(imageURL string) (string, error) {
+		readerIO, err := fetcher.FetchMedia(downloadCtx, imageURL)
+		if err != nil {
+			return "", err
+		}
+		defer func() { _ = readerIO.Close() }()
+
+		// XXX: using download context for upload to maintain timeout consistency
+		return media.HandleUpload(downloadCtx, exec, mediaStorage, nil, &feedID, nil, readerIO)
+	}
+
+	replacer := reader.CreateImageReplacer(markdownContent, uploadFunc)
+
+	markdownContent, err = markdown.ReplaceImageUrlsOrLinkify(markdownContent, replacer)
+	if err != nil {
+		return "", fmt.Errorf("failed to process images in feed item: %w", err)
+	}
+
+	return markdownContent, nil
+}
+
 func calculateNewAverage(ctx context.Context, exec boil.ContextExecutor, feedID string, avgWindowDays int) (float64, error) {
 	count, err := core.RSSItems(
 		core.RSSItemWhere.FeedID.EQ(feedID),
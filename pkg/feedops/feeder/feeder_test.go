@@ -2,6 +2,10 @@ package feeder_test
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"io"
 	"strconv"
 	"testing"
@@ -11,6 +15,8 @@ import (
 	"github.com/can3p/pcom/pkg/feedops/feeder"
 	"github.com/can3p/pcom/pkg/feedops/reader"
 	"github.com/can3p/pcom/pkg/feedops/testutil"
+	"github.com/can3p/pcom/pkg/feedops/websub"
+	"github.com/can3p/pcom/pkg/model/core"
 	"github.com/can3p/pcom/testcontainers/postgres"
 	. "github.com/ovechkin-dm/mockio/v2/mock"
 	"github.com/stretchr/testify/assert"
@@ -106,6 +112,11 @@ func TestLockFeed(t *testing.T) {
 type fetcher interface {
 	Fetch(ctx context.Context, url string) (*reader.Feed, error)
 	FetchMedia(ctx context.Context, mediaURL string) (io.ReadCloser, error)
+	FetchArticle(ctx context.Context, articleURL string) (string, error)
+}
+
+type parser interface {
+	Parse(body []byte) (*reader.Feed, error)
 }
 
 type cleaner interface {
@@ -266,3 +277,274 @@ func TestSaveFeedInitialAndFollowUp(t *testing.T) {
 	require.Equal(t, "https://example.com/post5", fetchedFeeds[5].URL)
 
 }
+
+func TestPushSubscription(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctrl := NewMockController(t)
+
+	ctx := context.Background()
+
+	user, err := testutil.CreateUser(ctx, testDB.DB, "test@example.com")
+	require.NoError(t, err)
+
+	feed, err := testutil.CreateRSSFeed(ctx, testDB.DB, "https://example.com/feed", "Feed")
+	require.NoError(t, err)
+
+	_, err = testutil.CreateUserFeedSubscription(ctx, testDB.DB, user.ID, feed.ID)
+	require.NoError(t, err)
+
+	feeder.UpdateHubDetails(feed, &reader.Feed{
+		HubURL:  "https://hub.example.com/",
+		SelfURL: "https://example.com/feed.xml",
+	})
+	_, err = feed.Update(ctx, testDB.DB, boil.Infer())
+	require.NoError(t, err)
+
+	feeds, err := feeder.GetFeedsToSubscribe(ctx, testDB.DB)
+	require.NoError(t, err)
+	require.Len(t, feeds, 1)
+
+	err = feeder.MarkSubscriptionRequested(ctx, testDB.DB, feeds[0])
+	require.NoError(t, err)
+
+	feeds, err = feeder.GetFeedsToSubscribe(ctx, testDB.DB)
+	require.NoError(t, err)
+	require.Len(t, feeds, 0, "should not request subscription twice in a row")
+
+	err = feed.Reload(ctx, testDB.DB)
+	require.NoError(t, err)
+	require.True(t, feed.WebsubSecret.Valid)
+
+	err = feeder.VerifyIntent(ctx, testDB.DB, feed, websub.ModeSubscribe, "https://example.com/another_feed.xml", 3600)
+	require.ErrorIs(t, err, websub.ErrUnknownSubscription)
+
+	err = feeder.VerifyIntent(ctx, testDB.DB, feed, websub.ModeSubscribe, "https://example.com/feed.xml", 3600)
+	require.NoError(t, err)
+	require.True(t, feeder.HasActivePushSubscription(feed))
+
+	parser := Mock[parser](ctrl)
+	fetcher := Mock[fetcher](ctrl)
+	cleaner := Mock[cleaner](ctrl)
+
+	WhenDouble(cleaner.HTMLToMarkdown(Any[string]())).ThenAnswer(func(args []any) (string, error) {
+		return args[0].(string), nil
+	})
+
+	body := []byte("pushed feed")
+
+	WhenDouble(parser.Parse(Equal(body))).ThenReturn(&reader.Feed{
+		Items: createFeedItems(2, time.Now()),
+	}, nil)
+
+	err = feeder.SavePush(ctx, testDB.DB, feed.ID, "sha256=deadbeef", body)
+	require.ErrorIs(t, err, websub.ErrInvalidSignature)
+
+	mac := hmac.New(sha256.New, []byte(feed.WebsubSecret.String))
+	mac.Write(body)
+
+	err = feeder.SavePush(ctx, testDB.DB, feed.ID, "sha256="+hex.EncodeToString(mac.Sum(nil)), body)
+	require.NoError(t, err)
+
+	pushes, err := core.WebsubPushes().All(ctx, testDB.DB)
+	require.NoError(t, err)
+	require.Len(t, pushes, 1, "the payload is stored for the poller")
+
+	fetchedFeeds, err := feedops.GetRssFeedItems(ctx, testDB.DB, user.ID)
+	require.NoError(t, err)
+	require.Len(t, fetchedFeeds, 0, "nothing is fetched while the hub waits")
+
+	err = feeder.ProcessPush(ctx, testDB.DB, pushes[0], parser, cleaner, fetcher, nil)
+	require.NoError(t, err)
+
+	count, err := core.WebsubPushes().Count(ctx, testDB.DB)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count, "processed pushes are deleted")
+
+	fetchedFeeds, err = feedops.GetRssFeedItems(ctx, testDB.DB, user.ID)
+	require.NoError(t, err)
+	require.Len(t, fetchedFeeds, 2)
+
+	err = feed.Reload(ctx, testDB.DB)
+	require.NoError(t, err)
+	assert.True(t, feed.WebsubLastPushAt.Valid)
+	assert.True(t, feed.NextFetchAt.Time.After(time.Now().Add(reader.PushFetchInterval-time.Minute)), "polling should be postponed while the hub pushes updates")
+}
+
+func TestSilentHubRenewal(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+
+	user, err := testutil.CreateUser(ctx, testDB.DB, "test@example.com")
+	require.NoError(t, err)
+
+	feed, err := testutil.CreateRSSFeed(ctx, testDB.DB, "https://example.com/feed", "Feed")
+	require.NoError(t, err)
+
+	_, err = testutil.CreateUserFeedSubscription(ctx, testDB.DB, user.ID, feed.ID)
+	require.NoError(t, err)
+
+	feeder.UpdateHubDetails(feed, &reader.Feed{
+		HubURL:  "https://hub.example.com/",
+		SelfURL: "https://example.com/feed.xml",
+	})
+
+	err = feeder.MarkSubscriptionRequested(ctx, testDB.DB, feed)
+	require.NoError(t, err)
+
+	err = feeder.VerifyIntent(ctx, testDB.DB, feed, websub.ModeSubscribe, "https://example.com/feed.xml", int(websub.DefaultLease.Seconds()))
+	require.NoError(t, err)
+
+	feeds, err := feeder.GetFeedsToSubscribe(ctx, testDB.DB)
+	require.NoError(t, err)
+	require.Len(t, feeds, 0, "the lease is fresh")
+
+	longAgo := null.TimeFrom(time.Now().Add(-4 * reader.PushFetchInterval))
+
+	feed.WebsubRequestedAt = longAgo
+	_, err = feed.Update(ctx, testDB.DB, boil.Infer())
+	require.NoError(t, err)
+
+	feeds, err = feeder.GetFeedsToSubscribe(ctx, testDB.DB)
+	require.NoError(t, err)
+	require.Len(t, feeds, 1, "nothing has ever been pushed")
+	assert.True(t, feeder.HasActivePushSubscription(feeds[0]))
+
+	feed.WebsubLastPushAt = null.TimeFrom(time.Now().Add(-time.Hour))
+	_, err = feed.Update(ctx, testDB.DB, boil.Infer())
+	require.NoError(t, err)
+
+	feeds, err = feeder.GetFeedsToSubscribe(ctx, testDB.DB)
+	require.NoError(t, err)
+	require.Len(t, feeds, 0, "the hub pushes updates")
+
+	feed.WebsubLastPushAt = longAgo
+	_, err = feed.Update(ctx, testDB.DB, boil.Infer())
+	require.NoError(t, err)
+
+	feeds, err = feeder.GetFeedsToSubscribe(ctx, testDB.DB)
+	require.NoError(t, err)
+	require.Len(t, feeds, 1, "the hub has gone silent")
+
+	// the renewal itself postpones the next one
+	err = feeder.MarkSubscriptionRequested(ctx, testDB.DB, feeds[0])
+	require.NoError(t, err)
+
+	feeds, err = feeder.GetFeedsToSubscribe(ctx, testDB.DB)
+	require.NoError(t, err)
+	require.Len(t, feeds, 0)
+}
+
+func TestSaveFeedAppliesRules(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctrl := NewMockController(t)
+
+	ctx := context.Background()
+
+	user, err := testutil.CreateUser(ctx, testDB.DB, "test@example.com")
+	require.NoError(t, err)
+
+	feed, err := testutil.CreateRSSFeed(ctx, testDB.DB, "https://example.com/feed", "Feed")
+	require.NoError(t, err)
+
+	subscription, err := testutil.CreateUserFeedSubscription(ctx, testDB.DB, user.ID, feed.ID)
+	require.NoError(t, err)
+
+	_, err = testutil.CreateUserFeedRule(ctx, testDB.DB, user.ID, subscription.ID, core.FeedRuleFieldTitle, "post 0", core.FeedRuleActionDismiss)
+	require.NoError(t, err)
+
+	_, err = testutil.CreateUserFeedRule(ctx, testDB.DB, user.ID, "", core.FeedRuleFieldTitle, "post 1", core.FeedRuleActionHighlight)
+	require.NoError(t, err)
+
+	feedContent := &reader.Feed{
+		Title: "test feed",
+		Items: createFeedItems(3, time.Now()),
+	}
+
+	fetcher := Mock[fetcher](ctrl)
+	cleaner := Mock[cleaner](ctrl)
+
+	WhenDouble(cleaner.HTMLToMarkdown(Any[string]())).ThenAnswer(func(args []any) (string, error) {
+		return args[0].(string), nil
+	})
+	WhenSingle(cleaner.CleanField(Any[string]())).ThenAnswer(func(args []any) string {
+		return args[0].(string)
+	})
+
+	err = feeder.SaveFeed(ctx, testDB.DB, feed, feedContent, cleaner, fetcher, nil)
+	require.NoError(t, err)
+
+	fetchedItems, err := feedops.GetRssFeedItems(ctx, testDB.DB, user.ID)
+	require.NoError(t, err)
+	require.Len(t, fetchedItems, 2, "dismissed item should not show up in the feed")
+
+	assert.Equal(t, "https://example.com/post2", fetchedItems[0].URL)
+	assert.False(t, fetchedItems[0].Highlighted)
+	assert.Equal(t, "https://example.com/post1", fetchedItems[1].URL)
+	assert.True(t, fetchedItems[1].Highlighted)
+}
+
+func TestSaveFeedFullArticle(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctrl := NewMockController(t)
+
+	ctx := context.Background()
+
+	user, err := testutil.CreateUser(ctx, testDB.DB, "test@example.com")
+	require.NoError(t, err)
+
+	feed, err := testutil.CreateRSSFeed(ctx, testDB.DB, "https://example.com/feed", "Feed")
+	require.NoError(t, err)
+
+	subscription, err := testutil.CreateUserFeedSubscription(ctx, testDB.DB, user.ID, feed.ID)
+	require.NoError(t, err)
+
+	subscription.FetchFullArticle = true
+	_, err = subscription.Update(ctx, testDB.DB, boil.Infer())
+	require.NoError(t, err)
+
+	feedContent := &reader.Feed{
+		Title: "test feed",
+		Items: createFeedItems(3, time.Now()),
+	}
+
+	fetcher := Mock[fetcher](ctrl)
+	cleaner := Mock[cleaner](ctrl)
+
+	WhenDouble(cleaner.HTMLToMarkdown(Any[string]())).ThenAnswer(func(args []any) (string, error) {
+		if args[0].(string) == "Broken page" {
+			return "", errors.New("unexpected EOF")
+		}
+
+		return args[0].(string), nil
+	})
+
+	WhenDouble(fetcher.FetchArticle(Any[context.Context](), Equal("https://example.com/post0"))).ThenReturn("", reader.ErrNotAnArticle)
+	WhenDouble(fetcher.FetchArticle(Any[context.Context](), Equal("https://example.com/post1"))).ThenReturn("Full text of post 1", nil)
+	WhenDouble(fetcher.FetchArticle(Any[context.Context](), Equal("https://example.com/post2"))).ThenReturn("Broken page", nil)
+
+	err = feeder.SaveFeed(ctx, testDB.DB, feed, feedContent, cleaner, fetcher, nil)
+	require.NoError(t, err)
+
+	fetchedItems, err := feedops.GetRssFeedItems(ctx, testDB.DB, user.ID)
+	require.NoError(t, err)
+	require.Len(t, fetchedItems, 3)
+
+	assert.Equal(t, "Summary of test post 2", fetchedItems[0].Summary, "summary should be used if the page could not be converted")
+	assert.False(t, fetchedItems[0].IsFullArticle)
+	assert.Equal(t, "Full text of post 1", fetchedItems[1].Summary)
+	assert.True(t, fetchedItems[1].IsFullArticle)
+	assert.Equal(t, "Summary of test post 0", fetchedItems[2].Summary, "summary should be used if extraction failed")
+	assert.False(t, fetchedItems[2].IsFullArticle)
+}
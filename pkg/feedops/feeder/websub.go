@@ -0,0 +1,398 @@
+package feeder
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	"github.com/can3p/gogo/util/transact"
+	"github.com/can3p/pcom/pkg/feedops/reader"
+	"github.com/can3p/pcom/pkg/feedops/websub"
+	"github.com/can3p/pcom/pkg/media/server"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/lo"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	// how long before lease expiration we start renewing the subscription
+	leaseRenewBefore = 2 * 24 * time.Hour
+	// how long to wait for the hub to verify the intent before trying again
+	subscribeRetryInterval = 12 * time.Hour
+	// the hub might lose the subscription without telling us, once it has
+	// been silent for that long the subscription is renewed ahead of time
+	pushSilenceRenewAfter = 3 * reader.PushFetchInterval
+	// the claim outlives the fetches of all the articles and images of the push,
+	// anything older belongs to an instance that has died halfway
+	pushClaimTTL = time.Hour
+)
+
+func HasActivePushSubscription(feed *core.RSSFeed) bool {
+	return feed.WebsubLeaseExpiresAt.Valid && feed.WebsubLeaseExpiresAt.Time.After(time.Now())
+}
+
+// UpdateHubDetails records the hub advertised by the freshly fetched feed.
+// Any change of the hub or the topic invalidates existing subscription
+func UpdateHubDetails(feed *core.RSSFeed, rssFeed *reader.Feed) {
+	topicURL := rssFeed.SelfURL
+
+	if topicURL == "" {
+		topicURL = feed.URL
+	}
+
+	if rssFeed.HubURL == "" {
+		feed.WebsubHubURL = null.String{}
+		feed.WebsubTopicURL = null.String{}
+		feed.WebsubSecret = null.String{}
+		feed.WebsubLeaseExpiresAt = null.Time{}
+
+		return
+	}
+
+	if feed.WebsubHubURL.String == rssFeed.HubURL && feed.WebsubTopicURL.String == topicURL {
+		return
+	}
+
+	feed.WebsubHubURL = null.StringFrom(rssFeed.HubURL)
+	feed.WebsubTopicURL = null.StringFrom(topicURL)
+	feed.WebsubSecret = null.String{}
+	feed.WebsubLeaseExpiresAt = null.Time{}
+	feed.WebsubRequestedAt = null.Time{}
+}
+
+func DropPushSubscription(ctx context.Context, exec boil.ContextExecutor, feed *core.RSSFeed) error {
+	feed.WebsubLeaseExpiresAt = null.Time{}
+	feed.NextFetchAt = null.TimeFrom(reader.CalculateNextFetchTime(feed.ConsecutiveEmptyFetches, feed.AvgItemsPerDay, false))
+
+	_, err := feed.Update(ctx, exec, boil.Infer())
+
+	return err
+}
+
+func (f *Feeder) renewPushSubscriptions(ctx context.Context) (err error) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = fmt.Errorf("renewPushSubscriptions panicked: %v - %s", panicErr, string(debug.Stack()))
+		}
+	}()
+
+	feeds, err := GetFeedsToSubscribe(ctx, f.db)
+
+	if err != nil {
+		return err
+	}
+
+	for _, ff := range feeds {
+		var feed *core.RSSFeed
+
+		// the subscription request is sent outside of the transaction, since the hub
+		// is allowed to verify the intent before replying and we need the secret to be
+		// visible by then
+		err := transact.Transact(f.db, func(tx *sql.Tx) error {
+			var err error
+
+			feed, err = LockFeed(ctx, tx, ff.ID)
+
+			if err != nil {
+				return err
+			}
+
+			return MarkSubscriptionRequested(ctx, tx, feed)
+		})
+
+		if err != nil {
+			slog.Warn("failed to prepare push subscription", "feed_id", ff.ID, "err", err)
+			continue
+		}
+
+		err = f.hub.Subscribe(ctx, feed.WebsubHubURL.String, feed.WebsubTopicURL.String, f.callbackLink(feed.ID), feed.WebsubSecret.String)
+
+		if err != nil {
+			slog.Warn("failed to subscribe to the hub", "feed_id", feed.ID, "hub", feed.WebsubHubURL.String, "err", err)
+			continue
+		}
+	}
+
+	return nil
+}
+
+// GetFeedsToSubscribe returns feeds that advertise a hub and either do not have
+// a subscription yet, the lease is about to expire or the hub has gone silent
+func GetFeedsToSubscribe(ctx context.Context, exec boil.ContextExecutor) ([]*core.RSSFeed, error) {
+	now := time.Now()
+
+	feeds, err := core.RSSFeeds(
+		core.RSSFeedWhere.WebsubHubURL.IsNotNull(),
+		core.RSSFeedWhere.DisableReason.IsNull(),
+		qm.Expr(
+			core.RSSFeedWhere.WebsubLeaseExpiresAt.IsNull(),
+			qm.Or2(core.RSSFeedWhere.WebsubLeaseExpiresAt.LT(null.TimeFrom(now.Add(leaseRenewBefore)))),
+			// nothing has been pushed since the subscription has been requested
+			qm.Or(fmt.Sprintf("greatest(%s, %s) < ?", core.RSSFeedColumns.WebsubLastPushAt, core.RSSFeedColumns.WebsubRequestedAt), now.Add(-pushSilenceRenewAfter)),
+		),
+		qm.Expr(
+			core.RSSFeedWhere.WebsubRequestedAt.IsNull(),
+			qm.Or2(core.RSSFeedWhere.WebsubRequestedAt.LT(null.TimeFrom(now.Add(-subscribeRetryInterval)))),
+		),
+		qm.Load(core.RSSFeedRels.FeedUserFeedSubscriptions, qm.Limit(1)),
+	).All(ctx, exec)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// nobody reads the feed, no need to bother the hub
+	feeds = lo.Filter(feeds, func(f *core.RSSFeed, index int) bool {
+		return len(f.R.FeedUserFeedSubscriptions) > 0
+	})
+
+	return feeds, nil
+}
+
+func MarkSubscriptionRequested(ctx context.Context, exec boil.ContextExecutor, feed *core.RSSFeed) error {
+	if !feed.WebsubSecret.Valid {
+		feed.WebsubSecret = null.StringFrom(uuid.NewString())
+	}
+
+	feed.WebsubRequestedAt = null.TimeFrom(time.Now())
+
+	_, err := feed.Update(ctx, exec, boil.Infer())
+
+	return err
+}
+
+// VerifyIntent handles the hub's verification request for the callback url
+// of the feed, see https://www.w3.org/TR/websub/#hub-verifies-intent
+func (f *Feeder) VerifyIntent(ctx context.Context, feedID string, mode string, topic string, leaseSeconds int) error {
+	return transact.Transact(f.db, func(tx *sql.Tx) error {
+		feed, err := core.RSSFeeds(
+			core.RSSFeedWhere.ID.EQ(feedID),
+			qm.For("UPDATE"),
+		).One(ctx, tx)
+
+		if err == sql.ErrNoRows {
+			return websub.ErrUnknownSubscription
+		} else if err != nil {
+			return err
+		}
+
+		return VerifyIntent(ctx, tx, feed, mode, topic, leaseSeconds)
+	})
+}
+
+func VerifyIntent(ctx context.Context, exec boil.ContextExecutor, feed *core.RSSFeed, mode string, topic string, leaseSeconds int) error {
+	switch mode {
+	case websub.ModeSubscribe:
+		if !feed.WebsubSecret.Valid || feed.WebsubTopicURL.String != topic {
+			return websub.ErrUnknownSubscription
+		}
+
+		lease := time.Duration(leaseSeconds) * time.Second
+
+		if lease <= 0 {
+			lease = websub.DefaultLease
+		}
+
+		feed.WebsubLeaseExpiresAt = null.TimeFrom(time.Now().Add(lease))
+
+		// the poller should not wait for the old schedule
+		// now that the updates will be pushed
+		feed.NextFetchAt = null.TimeFrom(time.Now().Add(reader.PushFetchInterval))
+	case websub.ModeUnsubscribe:
+		// we never unsubscribe from active subscriptions
+		if feed.WebsubTopicURL.String == topic && HasActivePushSubscription(feed) {
+			return websub.ErrUnknownSubscription
+		}
+
+		return nil
+	case websub.ModeDenied:
+		if feed.WebsubTopicURL.String != topic {
+			return websub.ErrUnknownSubscription
+		}
+
+		slog.Info("hub denied the subscription", "feed_id", feed.ID, "hub", feed.WebsubHubURL.String)
+
+		return DropPushSubscription(ctx, exec, feed)
+	default:
+		return websub.ErrUnknownSubscription
+	}
+
+	_, err := feed.Update(ctx, exec, boil.Infer())
+
+	return err
+}
+
+// HandlePush verifies the content distributed by the hub and stores it
+// for the poller, the hub should not wait until the articles and images
+// are fetched. The payload is the same feed document we would get by polling
+func (f *Feeder) HandlePush(ctx context.Context, feedID string, signature string, body []byte) error {
+	return SavePush(ctx, f.db, feedID, signature, body)
+}
+
+func SavePush(ctx context.Context, exec boil.ContextExecutor, feedID string, signature string, body []byte) error {
+	feed, err := core.FindRSSFeed(ctx, exec, feedID)
+
+	if err == sql.ErrNoRows {
+		return websub.ErrUnknownSubscription
+	} else if err != nil {
+		return err
+	}
+
+	if !feed.WebsubSecret.Valid {
+		return websub.ErrUnknownSubscription
+	}
+
+	if !websub.VerifySignature(feed.WebsubSecret.String, signature, body) {
+		return websub.ErrInvalidSignature
+	}
+
+	push := &core.WebsubPush{
+		ID:     uuid.NewString(),
+		FeedID: feed.ID,
+		Body:   body,
+	}
+
+	return push.Insert(ctx, exec, boil.Infer())
+}
+
+func (f *Feeder) processPushes(ctx context.Context) (err error) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = fmt.Errorf("processPushes panicked: %v - %s", panicErr, string(debug.Stack()))
+		}
+	}()
+
+	pushes, err := core.WebsubPushes(
+		qm.Select(core.WebsubPushColumns.ID),
+		claimablePushes(time.Now()),
+		qm.OrderBy(core.WebsubPushColumns.CreatedAt),
+	).All(ctx, f.db)
+
+	if err != nil {
+		return err
+	}
+
+	// the push is claimed in a short transaction, nothing
+	// is locked while the articles and images are fetched
+	for _, p := range pushes {
+		push, err := claimPush(ctx, f.db, p.ID, time.Now())
+
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		} else if err != nil {
+			slog.Warn("failed to claim the push", "push_id", p.ID, "err", err)
+			continue
+		}
+
+		if err := ProcessPush(ctx, f.db, push, f.fetcher, f.cleaner, f.fetcher, f.mediaStorage); err != nil {
+			// polling will pick up whatever has been lost with the push
+			slog.Warn("failed to process the push", "feed_id", push.FeedID, "err", err)
+
+			if _, err := push.Delete(ctx, f.db); err != nil {
+				slog.Warn("failed to delete the push", "push_id", push.ID, "err", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// claimablePushes matches the pushes nobody works on, along with the ones
+// claimed by the instances that have died halfway, the claim is stale by then
+func claimablePushes(now time.Time) qm.QueryMod {
+	return qm.Expr(
+		core.WebsubPushWhere.ClaimedAt.IsNull(),
+		qm.Or2(core.WebsubPushWhere.ClaimedAt.LT(null.TimeFrom(now.Add(-pushClaimTTL)))),
+	)
+}
+
+// claimPush marks the push as being processed, sql.ErrNoRows means
+// that somebody else has got it first
+func claimPush(ctx context.Context, db *sqlx.DB, id string, now time.Time) (*core.WebsubPush, error) {
+	var push *core.WebsubPush
+
+	err := transact.Transact(db, func(tx *sql.Tx) error {
+		var err error
+
+		push, err = core.WebsubPushes(
+			core.WebsubPushWhere.ID.EQ(id),
+			claimablePushes(now),
+			qm.For("UPDATE SKIP LOCKED"),
+		).One(ctx, tx)
+
+		if err != nil {
+			return err
+		}
+
+		push.ClaimedAt = null.TimeFrom(now)
+
+		_, err = push.Update(ctx, tx, boil.Whitelist(
+			core.WebsubPushColumns.ClaimedAt,
+			core.WebsubPushColumns.UpdatedAt,
+		))
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return push, nil
+}
+
+// ProcessPush saves the items of the pushed feed. The articles and images
+// are fetched before the transaction is opened, the feed row is only locked
+// to update its state once the items are there
+func ProcessPush(ctx context.Context, db *sqlx.DB, push *core.WebsubPush, parser parser, cleaner cleaner, fetcher fetcher, mediaStorage server.MediaStorage) error {
+	rssFeed, err := parser.Parse(push.Body)
+
+	if err != nil {
+		return err
+	}
+
+	items, err := prepareFeedItems(ctx, db, push.FeedID, rssFeed, cleaner, fetcher, mediaStorage)
+
+	if err != nil {
+		return err
+	}
+
+	return transact.Transact(db, func(tx *sql.Tx) error {
+		newItems, err := saveFeedItems(ctx, tx, push.FeedID, items, cleaner)
+
+		if err != nil {
+			return err
+		}
+
+		// the subscription could have been renewed in the meantime,
+		// the state is updated on top of the latest version
+		feed, err := core.RSSFeeds(
+			core.RSSFeedWhere.ID.EQ(push.FeedID),
+			qm.For("UPDATE"),
+		).One(ctx, tx)
+
+		if err != nil {
+			return err
+		}
+
+		if !feed.WebsubLastPushAt.Valid || push.CreatedAt.After(feed.WebsubLastPushAt.Time) {
+			feed.WebsubLastPushAt = null.TimeFrom(push.CreatedAt)
+		}
+
+		if err := saveFeedState(ctx, tx, feed, rssFeed, newItems, cleaner); err != nil {
+			return err
+		}
+
+		_, err = push.Delete(ctx, tx)
+
+		return err
+	})
+}
@@ -7,26 +7,30 @@ import (
 	"time"
 
 	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/pkg/postops"
 	"github.com/samber/lo"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 type RssFeed struct {
-	ID             string
-	URL            string
-	WebsiteURL     string
-	Title          string
-	NextFetchAt    *time.Time
-	LastFetchedAt  *time.Time
-	LastImportedAt *time.Time
-	LastError      string
+	ID               string
+	URL              string
+	WebsiteURL       string
+	Title            string
+	NextFetchAt      *time.Time
+	LastFetchedAt    *time.Time
+	LastImportedAt   *time.Time
+	LastError        string
+	FetchFullArticle bool
+	Folder           string
 }
 
 func GetRssFeeds(ctx context.Context, db boil.ContextExecutor, userID string) ([]*RssFeed, error) {
 	rssFeeds, err := core.UserFeedSubscriptions(
 		core.UserFeedSubscriptionWhere.UserID.EQ(userID),
 		qm.Load(core.UserFeedSubscriptionRels.Feed),
+		qm.Load(core.UserFeedSubscriptionRels.Folder),
 		qm.OrderBy(fmt.Sprintf("%s ASC", core.UserFeedSubscriptionColumns.ID)),
 	).All(ctx, db)
 
@@ -57,15 +61,23 @@ func GetRssFeeds(ctx context.Context, db boil.ContextExecutor, userID string) ([
 	}
 
 	feeds := lo.Map(rssFeeds, func(feed *core.UserFeedSubscription, idx int) *RssFeed {
+		folder := ""
+
+		if feed.R.Folder != nil {
+			folder = feed.R.Folder.Name
+		}
+
 		return &RssFeed{
-			ID:             feed.ID,
-			URL:            feed.R.Feed.URL,
-			WebsiteURL:     extractWebsiteURL(feed.R.Feed.URL),
-			Title:          feed.R.Feed.Title.String,
-			NextFetchAt:    feed.R.Feed.NextFetchAt.Ptr(),
-			LastFetchedAt:  feed.R.Feed.LastFetchedAt.Ptr(),
-			LastImportedAt: lastImportedMap[feed.FeedID],
-			LastError:      feed.R.Feed.LastFetchError.String,
+			ID:               feed.ID,
+			URL:              feed.R.Feed.URL,
+			WebsiteURL:       extractWebsiteURL(feed.R.Feed.URL),
+			Title:            feed.R.Feed.Title.String,
+			NextFetchAt:      feed.R.Feed.NextFetchAt.Ptr(),
+			LastFetchedAt:    feed.R.Feed.LastFetchedAt.Ptr(),
+			LastImportedAt:   lastImportedMap[feed.FeedID],
+			LastError:        feed.R.Feed.LastFetchError.String,
+			FetchFullArticle: feed.FetchFullArticle,
+			Folder:           folder,
 		}
 	})
 
@@ -82,6 +94,8 @@ func extractWebsiteURL(feedURL string) string {
 
 type RssFeedItem struct {
 	ID          string
+	RSSItemID   string
+	URLID       string
 	URL         string
 	FeedTitle   string
 	FeedURL     string
@@ -89,10 +103,26 @@ type RssFeedItem struct {
 	PublishedAt time.Time
 	AddedAt     time.Time
 	Summary     string
+	Highlighted bool
+	// true when Summary contains the extracted article
+	// instead of the feed description
+	IsFullArticle bool
+	// id of the post the user has written about the item, if any
+	PostID string
 }
 
 func GetRssFeedItems(ctx context.Context, db boil.ContextExecutor, userID string) ([]*RssFeedItem, error) {
-	dbItems, err := core.UserFeedItems(
+	return getRssFeedItems(ctx, db, userID)
+}
+
+// GetFolderRssFeedItems works as GetRssFeedItems, but only returns
+// items of the feeds from the given folder
+func GetFolderRssFeedItems(ctx context.Context, db boil.ContextExecutor, userID string, folderID string) ([]*RssFeedItem, error) {
+	return getRssFeedItems(ctx, db, userID, inFolder(userID, folderID))
+}
+
+func getRssFeedItems(ctx context.Context, db boil.ContextExecutor, userID string, mods ...qm.QueryMod) ([]*RssFeedItem, error) {
+	mods = append([]qm.QueryMod{
 		core.UserFeedItemWhere.UserID.EQ(userID),
 		core.UserFeedItemWhere.IsDismissed.EQ(false),
 		qm.Load(qm.Rels(
@@ -101,12 +131,35 @@ func GetRssFeedItems(ctx context.Context, db boil.ContextExecutor, userID string
 		)),
 		qm.Load(core.UserFeedItemRels.URL),
 		qm.OrderBy(fmt.Sprintf("%s DESC", core.UserFeedItemColumns.ID)),
+	}, mods...)
+
+	dbItems, err := core.UserFeedItems(mods...).All(ctx, db)
+
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := core.UserFeedSubscriptions(
+		core.UserFeedSubscriptionWhere.UserID.EQ(userID),
+		core.UserFeedSubscriptionWhere.FetchFullArticle.EQ(true),
 	).All(ctx, db)
 
 	if err != nil {
 		return nil, err
 	}
 
+	wantsFullArticle := lo.SliceToMap(subscriptions, func(s *core.UserFeedSubscription) (string, bool) {
+		return s.FeedID, true
+	})
+
+	postIDs, err := postops.GetRSSItemPosts(ctx, db, userID, lo.Map(dbItems, func(item *core.UserFeedItem, idx int) string {
+		return item.RSSItemID
+	}))
+
+	if err != nil {
+		return nil, err
+	}
+
 	items := lo.Map(dbItems, func(item *core.UserFeedItem, idx int) *RssFeedItem {
 		publishedAt := item.CreatedAt
 
@@ -114,17 +167,78 @@ func GetRssFeedItems(ctx context.Context, db boil.ContextExecutor, userID string
 			publishedAt = item.R.RSSItem.PublishedAt
 		}
 
+		summary := item.R.RSSItem.SanitizedDescription
+		isFullArticle := false
+
+		if wantsFullArticle[item.R.RSSItem.FeedID] && item.R.RSSItem.FullContent.Valid {
+			summary = item.R.RSSItem.FullContent.String
+			isFullArticle = true
+		}
+
 		return &RssFeedItem{
-			ID:          item.ID,
-			URL:         item.R.URL.URL,
-			Title:       item.R.RSSItem.Title,
-			Summary:     item.R.RSSItem.SanitizedDescription,
-			PublishedAt: publishedAt,
-			AddedAt:     item.CreatedAt,
-			FeedTitle:   item.R.RSSItem.R.Feed.Title.String,
-			FeedURL:     item.R.RSSItem.R.Feed.URL,
+			ID:            item.ID,
+			RSSItemID:     item.RSSItemID,
+			URLID:         item.URLID,
+			URL:           item.R.URL.URL,
+			Title:         item.R.RSSItem.Title,
+			Summary:       summary,
+			PublishedAt:   publishedAt,
+			AddedAt:       item.CreatedAt,
+			FeedTitle:     item.R.RSSItem.R.Feed.Title.String,
+			FeedURL:       item.R.RSSItem.R.Feed.URL,
+			Highlighted:   item.IsHighlighted,
+			IsFullArticle: isFullArticle,
+			PostID:        postIDs[item.RSSItemID],
 		}
 	})
 
 	return items, nil
 }
+
+type FeedRule struct {
+	ID        string
+	FeedTitle string
+	Field     core.FeedRuleField
+	MatchType core.FeedRuleMatchType
+	Pattern   string
+	Action    core.FeedRuleAction
+}
+
+func GetFeedRules(ctx context.Context, db boil.ContextExecutor, userID string) ([]*FeedRule, error) {
+	dbRules, err := core.UserFeedRules(
+		core.UserFeedRuleWhere.UserID.EQ(userID),
+		qm.Load(qm.Rels(
+			core.UserFeedRuleRels.Subscription,
+			core.UserFeedSubscriptionRels.Feed,
+		)),
+		qm.OrderBy(fmt.Sprintf("%s ASC", core.UserFeedRuleColumns.ID)),
+	).All(ctx, db)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rules := lo.Map(dbRules, func(r *core.UserFeedRule, idx int) *FeedRule {
+		feedTitle := ""
+
+		if r.R.Subscription != nil {
+			feed := r.R.Subscription.R.Feed
+			feedTitle = feed.Title.String
+
+			if feedTitle == "" {
+				feedTitle = extractWebsiteURL(feed.URL)
+			}
+		}
+
+		return &FeedRule{
+			ID:        r.ID,
+			FeedTitle: feedTitle,
+			Field:     r.Field,
+			MatchType: r.MatchType,
+			Pattern:   r.Pattern,
+			Action:    r.Action,
+		}
+	})
+
+	return rules, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/can3p/pcom/pkg/feedops"
 	"github.com/can3p/pcom/pkg/feedops/testutil"
+	"github.com/can3p/pcom/pkg/postops"
 	"github.com/can3p/pcom/testcontainers/postgres"
 	"github.com/stretchr/testify/require"
 )
@@ -65,3 +66,96 @@ func TestGetRssFeedItems_EmptyResult(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, items, 0, "Should return empty list for user with no items")
 }
+
+func TestGetRssFeedItems_NormalizedURL(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+
+	user, err := testutil.CreateUser(ctx, testDB.DB, "test@example.com")
+	require.NoError(t, err)
+
+	feed, err := testutil.CreateRSSFeed(ctx, testDB.DB, "https://example.com/feed", "Test Feed")
+	require.NoError(t, err)
+
+	_, err = testutil.CreateUserFeedSubscription(ctx, testDB.DB, user.ID, feed.ID)
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	// the feed and the post spell the same url differently
+	itemURL, err := postops.StoreURL(ctx, testDB.DB, "https://Example.com/item/?b=2&a=1")
+	require.NoError(t, err)
+	rssItem, err := testutil.CreateRSSItem(ctx, testDB.DB, feed.ID, itemURL.ID, "Item", now)
+	require.NoError(t, err)
+	_, err = testutil.CreateUserFeedItem(ctx, testDB.DB, user.ID, rssItem.ID, itemURL.ID, now)
+	require.NoError(t, err)
+
+	postURL, err := postops.StoreURL(ctx, testDB.DB, "https://example.com/item?a=1&b=2")
+	require.NoError(t, err)
+	require.Equal(t, itemURL.ID, postURL.ID)
+
+	items, err := feedops.GetRssFeedItems(ctx, testDB.DB, user.ID)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	require.Equal(t, postURL.ID, items[0].URLID, "Posts are matched with the items by the url id")
+}
+
+func TestFeedFolders(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+
+	user, err := testutil.CreateUser(ctx, testDB.DB, "test@example.com")
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	createFeedWithItem := func(name string) string {
+		feed, err := testutil.CreateRSSFeed(ctx, testDB.DB, "https://"+name+".com/feed", name)
+		require.NoError(t, err)
+
+		subscription, err := testutil.CreateUserFeedSubscription(ctx, testDB.DB, user.ID, feed.ID)
+		require.NoError(t, err)
+
+		url, err := testutil.CreateURL(ctx, testDB.DB, "https://"+name+".com/item")
+		require.NoError(t, err)
+		rssItem, err := testutil.CreateRSSItem(ctx, testDB.DB, feed.ID, url.ID, name+" item", now)
+		require.NoError(t, err)
+		_, err = testutil.CreateUserFeedItem(ctx, testDB.DB, user.ID, rssItem.ID, url.ID, now)
+		require.NoError(t, err)
+
+		return subscription.ID
+	}
+
+	newsSubscriptionID := createFeedWithItem("news")
+	_ = createFeedWithItem("blog")
+
+	require.NoError(t, feedops.SetSubscriptionFolder(ctx, testDB.DB, user.ID, newsSubscriptionID, "News"))
+
+	folders, err := feedops.GetFeedFolders(ctx, testDB.DB, user.ID)
+	require.NoError(t, err)
+	require.Len(t, folders, 1)
+	require.Equal(t, "News", folders[0].Name)
+	require.EqualValues(t, 1, folders[0].UnreadCount)
+
+	items, err := feedops.GetFolderRssFeedItems(ctx, testDB.DB, user.ID, folders[0].ID)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	require.Equal(t, "news item", items[0].Title)
+
+	require.NoError(t, feedops.MarkFolderRead(ctx, testDB.DB, user.ID, folders[0].ID))
+
+	folders, err = feedops.GetFeedFolders(ctx, testDB.DB, user.ID)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, folders[0].UnreadCount)
+
+	items, err = feedops.GetRssFeedItems(ctx, testDB.DB, user.ID)
+	require.NoError(t, err)
+	require.Len(t, items, 1, "Items outside of the folder should stay unread")
+	require.Equal(t, "blog item", items[0].Title)
+}
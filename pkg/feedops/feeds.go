@@ -2,14 +2,18 @@ package feedops
 
 import (
 	"context"
+	"strings"
 
 	"github.com/can3p/pcom/pkg/model/core"
 	"github.com/can3p/pcom/pkg/util"
 	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
-func SubscribeToFeed(ctx context.Context, exec boil.ContextExecutor, userID string, rawURL string) error {
+// SubscribeToFeed adds the feed to the subscriptions of the user. Non empty folder name
+// puts the subscription into the folder, creating it if necessary
+func SubscribeToFeed(ctx context.Context, exec boil.ContextExecutor, userID string, rawURL string, folderName string) error {
 	normalizedURL, err := util.NormalizeURL(rawURL)
 	if err != nil {
 		return err
@@ -44,12 +48,27 @@ func SubscribeToFeed(ctx context.Context, exec boil.ContextExecutor, userID stri
 		UserID: userID,
 	}
 
+	// subscribing again should not reset the settings of existing subscription,
+	// the only thing that can change is the folder
+	updateColumns := boil.Whitelist(core.UserFeedSubscriptionColumns.UserID)
+
+	if strings.TrimSpace(folderName) != "" {
+		folder, err := GetOrCreateFolder(ctx, exec, userID, folderName)
+
+		if err != nil {
+			return err
+		}
+
+		userSubscription.FolderID = null.StringFrom(folder.ID)
+		updateColumns = boil.Whitelist(core.UserFeedSubscriptionColumns.FolderID)
+	}
+
 	err = userSubscription.Upsert(
 		ctx,
 		exec,
 		true,
 		[]string{core.UserFeedSubscriptionColumns.UserID, core.UserFeedSubscriptionColumns.FeedID},
-		boil.Infer(), boil.Infer())
+		updateColumns, boil.Infer())
 
 	if err != nil {
 		return err
@@ -74,3 +93,14 @@ func UnsubscribeFromFeed(ctx context.Context, exec boil.ContextExecutor, userID
 
 	return err
 }
+
+func SetFetchFullArticle(ctx context.Context, exec boil.ContextExecutor, userID string, subscriptionID string, enabled bool) error {
+	_, err := core.UserFeedSubscriptions(
+		core.UserFeedSubscriptionWhere.ID.EQ(subscriptionID),
+		core.UserFeedSubscriptionWhere.UserID.EQ(userID),
+	).UpdateAll(ctx, exec, core.M{
+		core.UserFeedSubscriptionColumns.FetchFullArticle: enabled,
+	})
+
+	return err
+}
@@ -0,0 +1,148 @@
+package feedops
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type FeedFolder struct {
+	ID          string
+	Name        string
+	UnreadCount int64
+}
+
+func GetFeedFolders(ctx context.Context, db boil.ContextExecutor, userID string) ([]*FeedFolder, error) {
+	dbFolders, err := core.UserFeedFolders(
+		core.UserFeedFolderWhere.UserID.EQ(userID),
+		qm.OrderBy(fmt.Sprintf("%s ASC", core.UserFeedFolderColumns.Name)),
+	).All(ctx, db)
+
+	if err != nil {
+		return nil, err
+	}
+
+	type unreadResult struct {
+		FolderID    string `boil:"folder_id"`
+		UnreadCount int64  `boil:"unread_count"`
+	}
+
+	var unread []*unreadResult
+
+	err = core.NewQuery(
+		qm.Select("ufs.folder_id as folder_id, count(*) as unread_count"),
+		qm.From("user_feed_items as ufi"),
+		qm.InnerJoin("rss_items as ri on ri.id = ufi.rss_item_id"),
+		qm.InnerJoin("user_feed_subscriptions as ufs on ufs.feed_id = ri.feed_id and ufs.user_id = ufi.user_id"),
+		qm.Where("ufi.user_id = ? and ufi.is_dismissed = false and ufs.folder_id is not null", userID),
+		qm.GroupBy("ufs.folder_id"),
+	).Bind(ctx, db, &unread)
+
+	if err != nil {
+		return nil, err
+	}
+
+	unreadMap := lo.SliceToMap(unread, func(u *unreadResult) (string, int64) {
+		return u.FolderID, u.UnreadCount
+	})
+
+	folders := lo.Map(dbFolders, func(f *core.UserFeedFolder, idx int) *FeedFolder {
+		return &FeedFolder{
+			ID:          f.ID,
+			Name:        f.Name,
+			UnreadCount: unreadMap[f.ID],
+		}
+	})
+
+	return folders, nil
+}
+
+// GetOrCreateFolder returns the folder of the user with the given name,
+// folders are matched by name to let users type them in freely
+func GetOrCreateFolder(ctx context.Context, exec boil.ContextExecutor, userID string, name string) (*core.UserFeedFolder, error) {
+	folderID, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	folder := &core.UserFeedFolder{
+		ID:     folderID.String(),
+		UserID: userID,
+		Name:   strings.TrimSpace(name),
+	}
+
+	// same as with feeds, we need at least some update to get the id of the existing row
+	err = folder.Upsert(ctx, exec, true,
+		[]string{core.UserFeedFolderColumns.UserID, core.UserFeedFolderColumns.Name},
+		boil.Whitelist(core.UserFeedFolderColumns.Name), boil.Infer())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return folder, nil
+}
+
+// SetSubscriptionFolder moves the subscription to the folder with the given name,
+// empty name removes the subscription from any folder
+func SetSubscriptionFolder(ctx context.Context, exec boil.ContextExecutor, userID string, subscriptionID string, folderName string) error {
+	var folderID *string
+
+	if strings.TrimSpace(folderName) != "" {
+		folder, err := GetOrCreateFolder(ctx, exec, userID, folderName)
+
+		if err != nil {
+			return err
+		}
+
+		folderID = &folder.ID
+	}
+
+	_, err := core.UserFeedSubscriptions(
+		core.UserFeedSubscriptionWhere.ID.EQ(subscriptionID),
+		core.UserFeedSubscriptionWhere.UserID.EQ(userID),
+	).UpdateAll(ctx, exec, core.M{
+		core.UserFeedSubscriptionColumns.FolderID: folderID,
+	})
+
+	return err
+}
+
+// RemoveFeedFolder deletes the folder, subscriptions stay and just
+// become unsorted
+func RemoveFeedFolder(ctx context.Context, exec boil.ContextExecutor, userID string, folderID string) error {
+	_, err := core.UserFeedFolders(
+		core.UserFeedFolderWhere.ID.EQ(folderID),
+		core.UserFeedFolderWhere.UserID.EQ(userID),
+	).DeleteAll(ctx, exec)
+
+	return err
+}
+
+// MarkFolderRead dismisses all the items of the user coming from
+// the feeds in the folder
+func MarkFolderRead(ctx context.Context, exec boil.ContextExecutor, userID string, folderID string) error {
+	_, err := core.UserFeedItems(
+		core.UserFeedItemWhere.UserID.EQ(userID),
+		core.UserFeedItemWhere.IsDismissed.EQ(false),
+		inFolder(userID, folderID),
+	).UpdateAll(ctx, exec, core.M{
+		core.UserFeedItemColumns.IsDismissed: true,
+	})
+
+	return err
+}
+
+func inFolder(userID string, folderID string) qm.QueryMod {
+	return qm.Where(`user_feed_items.rss_item_id in (
+		select ri.id from rss_items ri
+		join user_feed_subscriptions ufs on ufs.feed_id = ri.feed_id
+		where ufs.user_id = ? and ufs.folder_id = ?
+	)`, userID, folderID)
+}
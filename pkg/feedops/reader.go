@@ -6,6 +6,8 @@ import (
 
 	"github.com/can3p/pcom/pkg/feedops/feeder"
 	"github.com/can3p/pcom/pkg/feedops/reader"
+	"github.com/can3p/pcom/pkg/feedops/websub"
+	"github.com/can3p/pcom/pkg/links"
 	"github.com/can3p/pcom/pkg/media/server"
 	"github.com/jmoiron/sqlx"
 )
@@ -17,6 +19,9 @@ func DefaultRssReader(db *sqlx.DB, mediaStorage server.MediaStorage) *feeder.Fee
 
 	fetcher := reader.NewFetcher(httpClient)
 	cleaner := reader.DefaultCleaner()
+	hub := websub.NewClient(httpClient)
 
-	return feeder.NewFeeder(db, fetcher, cleaner, mediaStorage)
+	return feeder.NewFeeder(db, fetcher, cleaner, mediaStorage, hub, func(feedID string) string {
+		return links.AbsLink("websub_callback", feedID)
+	})
 }
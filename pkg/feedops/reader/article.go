@@ -0,0 +1,64 @@
+package reader
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-shiori/go-readability"
+	"github.com/pkg/errors"
+)
+
+const (
+	MaxArticleSize = 5 * 1024 * 1024
+)
+
+var (
+	ErrNotAnArticle = errors.New("page does not look like an article")
+)
+
+// FetchArticle downloads the page and extracts the main content out of it.
+// The result is raw html and should go through the cleaner as any other feed content
+func (f *Fetcher) FetchArticle(ctx context.Context, articleURL string) (string, error) {
+	pageURL, err := url.Parse(articleURL)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid article url")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", articleURL, nil)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("User-Agent", f.parser.UserAgent)
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to fetch article")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("failed to fetch article: HTTP %d", resp.StatusCode)
+	}
+
+	if ct := resp.Header.Get("Content-Type"); ct != "" && !strings.Contains(ct, "html") {
+		return "", errors.Wrapf(ErrNotAnArticle, "content type %s", ct)
+	}
+
+	return ExtractArticle(io.LimitReader(resp.Body, MaxArticleSize), pageURL)
+}
+
+func ExtractArticle(page io.Reader, pageURL *url.URL) (string, error) {
+	article, err := readability.FromReader(page, pageURL)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to extract article")
+	}
+
+	if strings.TrimSpace(article.TextContent) == "" {
+		return "", ErrNotAnArticle
+	}
+
+	return article.Content, nil
+}
@@ -0,0 +1,46 @@
+package reader_test
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/can3p/pcom/pkg/feedops/reader"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractArticle(t *testing.T) {
+	page := `<html>
+<head><title>Test article</title></head>
+<body>
+  <nav><a href="/">Home</a> <a href="/about">About</a></nav>
+  <article>
+    <h1>Test article</h1>
+    <p>` + strings.Repeat("This is the main content of the article and it is long enough to be picked. ", 10) + `</p>
+    <img src="/images/picture.png">
+    <p>` + strings.Repeat("Another paragraph of the main content. ", 10) + `</p>
+  </article>
+  <footer>Copyright notice</footer>
+</body>
+</html>`
+
+	pageURL, err := url.Parse("https://example.com/posts/test")
+	require.NoError(t, err)
+
+	content, err := reader.ExtractArticle(strings.NewReader(page), pageURL)
+	require.NoError(t, err)
+
+	assert.Contains(t, content, "main content of the article")
+	assert.Contains(t, content, "https://example.com/images/picture.png", "relative urls should be resolved")
+	assert.NotContains(t, content, "Copyright notice")
+	assert.NotContains(t, content, "About")
+}
+
+func TestExtractArticleEmptyPage(t *testing.T) {
+	pageURL, err := url.Parse("https://example.com/")
+	require.NoError(t, err)
+
+	_, err = reader.ExtractArticle(strings.NewReader("<html><body></body></html>"), pageURL)
+	require.Error(t, err)
+}
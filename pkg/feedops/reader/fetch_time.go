@@ -10,6 +10,9 @@ const (
 	MinFetchInterval    = time.Hour
 	MaxFetchInterval    = 24 * time.Hour
 	ErrorFetchInterval  = time.Hour
+	// feeds with an active WebSub subscription are still polled
+	// from time to time in case the hub stops delivering updates
+	PushFetchInterval = MaxFetchInterval
 )
 
 func CalculateNextFetchTime(consecutiveEmptyFetches int, avgItemsPerDay float64, wasManual bool) time.Time {
@@ -17,6 +17,10 @@ type Feed struct {
 	Title       string
 	Description string
 	Items       []*Item
+	// HubURL and SelfURL are only set when the feed advertises
+	// a WebSub hub, either in the document or in the Link header
+	HubURL  string
+	SelfURL string
 }
 
 type Item struct {
@@ -28,8 +32,10 @@ type Item struct {
 
 const (
 	MaxMediaSize               = 10 * 1024 * 1024
+	MaxFeedSize                = 10 * 1024 * 1024
 	MediaDownloadTimeout       = 30 * time.Second
 	GlobalImageDownloadTimeout = 2 * time.Minute
+	ArticleDownloadTimeout     = 30 * time.Second
 )
 
 var (
@@ -53,7 +59,48 @@ func NewFetcher(httpClient *http.Client) *Fetcher {
 }
 
 func (f *Fetcher) Fetch(ctx context.Context, rssURL string) (*Feed, error) {
-	feed, err := f.parser.ParseURLWithContext(rssURL, ctx)
+	req, err := http.NewRequestWithContext(ctx, "GET", rssURL, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("User-Agent", f.parser.UserAgent)
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, gofeed.HTTPError{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+		}
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, MaxFeedSize))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read feed body")
+	}
+
+	feed, err := f.Parse(body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Link header takes precedence over the links in the document,
+	// the spec says the publisher should keep them in sync anyway
+	hubURL, selfURL := DetectWebSubLinks(resp.Header, body)
+	feed.HubURL = hubURL
+	feed.SelfURL = selfURL
+
+	return feed, nil
+}
+
+// Parse converts raw feed document into the internal representation.
+// It's used both for polled feeds and for the content pushed by WebSub hubs
+func (f *Fetcher) Parse(body []byte) (*Feed, error) {
+	feed, err := f.parser.Parse(bytes.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
@@ -124,7 +171,7 @@ func (f *Fetcher) FetchMedia(ctx context.Context, mediaURL string) (io.ReadClose
 		return nil, errors.Wrap(err, "failed to read media header")
 	}
 
-	contentType := http.DetectContentType(peekBuf[:n])
+	contentType := media.DetectContentType(peekBuf[:n])
 	if _, err := media.ValidateImageType(contentType); err != nil {
 		_ = resp.Body.Close()
 		cancel()
@@ -0,0 +1,262 @@
+package reader
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+const (
+	// everything we need is in the head, there is no need to read the whole page
+	MaxPreviewPageSize = 512 * 1024
+	MaxOEmbedSize      = 64 * 1024
+)
+
+var (
+	ErrNoPreview = errors.New("page has no preview data")
+)
+
+// Preview is the data the page provides to describe itself,
+// it's collected from OpenGraph, Twitter card and oEmbed data
+type Preview struct {
+	Title       string
+	Description string
+	SiteName    string
+	ImageURL    string
+	// PlayerURL is the embeddable player the page advertises, if any
+	PlayerURL string
+}
+
+func (p *Preview) merge(other *Preview) {
+	if p.Title == "" {
+		p.Title = other.Title
+	}
+
+	if p.Description == "" {
+		p.Description = other.Description
+	}
+
+	if p.SiteName == "" {
+		p.SiteName = other.SiteName
+	}
+
+	if p.ImageURL == "" {
+		p.ImageURL = other.ImageURL
+	}
+
+	if p.PlayerURL == "" {
+		p.PlayerURL = other.PlayerURL
+	}
+}
+
+// FetchPreview downloads the page and extracts the preview data out of it.
+// oEmbed endpoint is only called in case the page advertises it
+func (f *Fetcher) FetchPreview(ctx context.Context, pageURL string) (*Preview, error) {
+	parsedURL, err := url.Parse(pageURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid page url")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", pageURL, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("User-Agent", f.parser.UserAgent)
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch the page")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to fetch the page: HTTP %d", resp.StatusCode)
+	}
+
+	if ct := resp.Header.Get("Content-Type"); ct != "" && !strings.Contains(ct, "html") {
+		return nil, errors.Wrapf(ErrNoPreview, "content type %s", ct)
+	}
+
+	preview, oembedURL := ExtractPreview(io.LimitReader(resp.Body, MaxPreviewPageSize), parsedURL)
+
+	if oembedURL != "" {
+		oembed, err := f.fetchOEmbed(ctx, oembedURL)
+
+		// page data is good enough without oEmbed
+		if err == nil {
+			preview.merge(oembed)
+		}
+	}
+
+	if preview.Title == "" {
+		return nil, ErrNoPreview
+	}
+
+	return preview, nil
+}
+
+// ExtractPreview parses the head of the page and returns the preview data
+// along with the url of the oEmbed endpoint if the page has one.
+// OpenGraph takes precedence over Twitter cards and the title tag
+func ExtractPreview(page io.Reader, pageURL *url.URL) (*Preview, string) {
+	og := &Preview{}
+	twitter := &Preview{}
+	fallback := &Preview{}
+
+	var oembedURL string
+
+	resolve := func(ref string) string {
+		ref = strings.TrimSpace(ref)
+
+		if ref == "" {
+			return ""
+		}
+
+		u, err := pageURL.Parse(ref)
+
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return ""
+		}
+
+		return u.String()
+	}
+
+	tokenizer := html.NewTokenizer(page)
+	inTitle := false
+
+loop:
+	for {
+		tt := tokenizer.Next()
+
+		switch tt {
+		case html.ErrorToken:
+			break loop
+		case html.TextToken:
+			if inTitle && fallback.Title == "" {
+				fallback.Title = strings.TrimSpace(string(tokenizer.Text()))
+			}
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+
+			switch atom.Lookup(name) {
+			case atom.Title:
+				inTitle = false
+			case atom.Head:
+				break loop
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := tokenizer.TagName()
+
+			attrs := map[string]string{}
+
+			for hasAttr {
+				var key, val []byte
+				key, val, hasAttr = tokenizer.TagAttr()
+				attrs[string(key)] = string(val)
+			}
+
+			switch atom.Lookup(name) {
+			case atom.Title:
+				inTitle = true
+			case atom.Body:
+				break loop
+			case atom.Link:
+				if strings.EqualFold(attrs["rel"], "alternate") && attrs["type"] == "application/json+oembed" && oembedURL == "" {
+					oembedURL = resolve(attrs["href"])
+				}
+			case atom.Meta:
+				key := attrs["property"]
+
+				if key == "" {
+					key = attrs["name"]
+				}
+
+				content := strings.TrimSpace(attrs["content"])
+
+				switch strings.ToLower(key) {
+				case "og:title":
+					og.Title = content
+				case "og:description":
+					og.Description = content
+				case "og:site_name":
+					og.SiteName = content
+				case "og:image", "og:image:url", "og:image:secure_url":
+					if og.ImageURL == "" {
+						og.ImageURL = resolve(content)
+					}
+				case "og:video:url", "og:video:secure_url", "og:video":
+					if og.PlayerURL == "" {
+						og.PlayerURL = resolve(content)
+					}
+				case "twitter:title":
+					twitter.Title = content
+				case "twitter:description":
+					twitter.Description = content
+				case "twitter:image", "twitter:image:src":
+					twitter.ImageURL = resolve(content)
+				case "twitter:player":
+					twitter.PlayerURL = resolve(content)
+				case "description":
+					fallback.Description = content
+				}
+			}
+		}
+	}
+
+	og.merge(twitter)
+	og.merge(fallback)
+
+	return og, oembedURL
+}
+
+type oembedResponse struct {
+	Title        string `json:"title"`
+	AuthorName   string `json:"author_name"`
+	ProviderName string `json:"provider_name"`
+	ThumbnailURL string `json:"thumbnail_url"`
+}
+
+func (f *Fetcher) fetchOEmbed(ctx context.Context, oembedURL string) (*Preview, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", oembedURL, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("User-Agent", f.parser.UserAgent)
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch oembed")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to fetch oembed: HTTP %d", resp.StatusCode)
+	}
+
+	var out oembedResponse
+
+	if err := json.NewDecoder(io.LimitReader(resp.Body, MaxOEmbedSize)).Decode(&out); err != nil {
+		return nil, errors.Wrap(err, "failed to decode oembed")
+	}
+
+	// only absolute links are allowed by the spec
+	thumbnail := out.ThumbnailURL
+
+	if !strings.HasPrefix(thumbnail, "https://") && !strings.HasPrefix(thumbnail, "http://") {
+		thumbnail = ""
+	}
+
+	return &Preview{
+		Title:       strings.TrimSpace(out.Title),
+		Description: strings.TrimSpace(out.AuthorName),
+		SiteName:    strings.TrimSpace(out.ProviderName),
+		ImageURL:    thumbnail,
+	}, nil
+}
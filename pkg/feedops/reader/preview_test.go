@@ -0,0 +1,93 @@
+package reader_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/can3p/pcom/pkg/feedops/reader"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractPreview(t *testing.T) {
+	page := `<html>
+<head>
+  <title>Page title</title>
+  <meta name="description" content="Plain description">
+  <meta property="og:title" content="OpenGraph title">
+  <meta property="og:image" content="/images/cover.png">
+  <meta name="twitter:description" content="Twitter description">
+  <meta name="twitter:player" content="https://example.com/player/1">
+  <link rel="alternate" type="application/json+oembed" href="/oembed?url=page">
+</head>
+<body>
+  <meta property="og:site_name" content="should not be picked from the body">
+</body>
+</html>`
+
+	pageURL, err := url.Parse("https://example.com/posts/test")
+	require.NoError(t, err)
+
+	preview, oembedURL := reader.ExtractPreview(strings.NewReader(page), pageURL)
+
+	assert.Equal(t, &reader.Preview{
+		Title:       "OpenGraph title",
+		Description: "Twitter description",
+		ImageURL:    "https://example.com/images/cover.png",
+		PlayerURL:   "https://example.com/player/1",
+	}, preview)
+	assert.Equal(t, "https://example.com/oembed?url=page", oembedURL)
+}
+
+func TestExtractPreviewTitleOnly(t *testing.T) {
+	pageURL, err := url.Parse("https://example.com/")
+	require.NoError(t, err)
+
+	preview, oembedURL := reader.ExtractPreview(strings.NewReader(`<title> Just a title </title><meta property="og:image" content="javascript:alert(1)">`), pageURL)
+
+	assert.Equal(t, &reader.Preview{Title: "Just a title"}, preview)
+	assert.Empty(t, oembedURL)
+}
+
+func TestFetchPreview(t *testing.T) {
+	var server *httptest.Server
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/song":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = fmt.Fprintf(w, `<head><link rel="alternate" type="application/json+oembed" href="%s/oembed"></head>`, server.URL)
+		case "/oembed":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprint(w, `{"title": "A song", "author_name": "A band", "provider_name": "Music", "thumbnail_url": "https://example.com/cover.jpg"}`)
+		case "/image.png":
+			w.Header().Set("Content-Type", "image/png")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	fetcher := reader.NewFetcher(server.Client())
+
+	preview, err := fetcher.FetchPreview(context.Background(), server.URL+"/song")
+	require.NoError(t, err)
+
+	assert.Equal(t, &reader.Preview{
+		Title:       "A song",
+		Description: "A band",
+		SiteName:    "Music",
+		ImageURL:    "https://example.com/cover.jpg",
+	}, preview)
+
+	_, err = fetcher.FetchPreview(context.Background(), server.URL+"/image.png")
+	assert.ErrorIs(t, err, reader.ErrNoPreview)
+
+	_, err = fetcher.FetchPreview(context.Background(), server.URL+"/missing")
+	assert.Error(t, err)
+}
@@ -0,0 +1,133 @@
+package reader
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// DetectWebSubLinks looks for rel="hub" and rel="self" links
+// as described in https://www.w3.org/TR/websub/#discovery
+// Link headers are checked first, then the feed document itself.
+// Both atom feeds and rss feeds with atom:link elements are supported.
+func DetectWebSubLinks(header http.Header, body []byte) (string, string) {
+	hubURL, selfURL := parseLinkHeaders(header.Values("Link"))
+
+	if hubURL != "" && selfURL != "" {
+		return hubURL, selfURL
+	}
+
+	docHub, docSelf := parseDocumentLinks(body)
+
+	if hubURL == "" {
+		hubURL = docHub
+	}
+
+	if selfURL == "" {
+		selfURL = docSelf
+	}
+
+	return hubURL, selfURL
+}
+
+func parseLinkHeaders(values []string) (string, string) {
+	var hubURL, selfURL string
+
+	for _, value := range values {
+		for _, link := range strings.Split(value, ",") {
+			parts := strings.Split(link, ";")
+
+			target := strings.TrimSpace(parts[0])
+
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+
+			target = strings.Trim(target, "<>")
+
+			for _, param := range parts[1:] {
+				key, val, ok := strings.Cut(strings.TrimSpace(param), "=")
+
+				if !ok || strings.ToLower(strings.TrimSpace(key)) != "rel" {
+					continue
+				}
+
+				for _, rel := range strings.Fields(strings.Trim(val, `"`)) {
+					switch strings.ToLower(rel) {
+					case "hub":
+						if hubURL == "" {
+							hubURL = target
+						}
+					case "self":
+						if selfURL == "" {
+							selfURL = target
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return hubURL, selfURL
+}
+
+func parseDocumentLinks(body []byte) (string, string) {
+	var hubURL, selfURL string
+
+	decoder := xml.NewDecoder(bytes.NewReader(body))
+	// feeds in the wild come in all sorts of encodings, we only
+	// care about the attributes that are ascii anyway
+	decoder.CharsetReader = func(charset string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+	decoder.Strict = false
+
+	for {
+		token, err := decoder.Token()
+
+		if err != nil {
+			break
+		}
+
+		el, ok := token.(xml.StartElement)
+
+		if !ok {
+			continue
+		}
+
+		// the links we're interested in are always on the feed level,
+		// no need to scan all the items
+		if el.Name.Local == "item" || el.Name.Local == "entry" {
+			break
+		}
+
+		if el.Name.Local != "link" {
+			continue
+		}
+
+		var rel, href string
+
+		for _, attr := range el.Attr {
+			switch attr.Name.Local {
+			case "rel":
+				rel = strings.ToLower(attr.Value)
+			case "href":
+				href = strings.TrimSpace(attr.Value)
+			}
+		}
+
+		if href == "" {
+			continue
+		}
+
+		if rel == "hub" && hubURL == "" {
+			hubURL = href
+		} else if rel == "self" && selfURL == "" {
+			selfURL = href
+		}
+	}
+
+	return hubURL, selfURL
+}
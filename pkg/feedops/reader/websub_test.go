@@ -0,0 +1,73 @@
+package reader_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/can3p/pcom/pkg/feedops/reader"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectWebSubLinks(t *testing.T) {
+	examples := []struct {
+		name    string
+		header  http.Header
+		body    string
+		hubURL  string
+		selfURL string
+	}{
+		{
+			name: "atom feed",
+			body: `<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <link rel="hub" href="https://hub.example.com/"/>
+  <link rel="self" href="https://example.com/atom.xml"/>
+  <link rel="alternate" href="https://example.com/"/>
+  <entry><link rel="hub" href="https://wrong.example.com/"/></entry>
+</feed>`,
+			hubURL:  "https://hub.example.com/",
+			selfURL: "https://example.com/atom.xml",
+		},
+		{
+			name: "rss feed with atom links",
+			body: `<?xml version="1.0" encoding="windows-1251"?>
+<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom">
+  <channel>
+    <atom:link rel="self" href="https://example.com/rss.xml"/>
+    <atom:link rel="hub" href="https://hub.example.com/"/>
+    <link>https://example.com/</link>
+  </channel>
+</rss>`,
+			hubURL:  "https://hub.example.com/",
+			selfURL: "https://example.com/rss.xml",
+		},
+		{
+			name: "link header wins",
+			header: http.Header{
+				"Link": []string{`<https://header-hub.example.com/>; rel="hub", <https://example.com/feed>; rel="self"`},
+			},
+			body:    `<feed><link rel="hub" href="https://hub.example.com/"/></feed>`,
+			hubURL:  "https://header-hub.example.com/",
+			selfURL: "https://example.com/feed",
+		},
+		{
+			name: "no hub",
+			body: `<rss><channel><link>https://example.com/</link></channel></rss>`,
+		},
+	}
+
+	for _, ex := range examples {
+		t.Run(ex.name, func(t *testing.T) {
+			header := ex.header
+
+			if header == nil {
+				header = http.Header{}
+			}
+
+			hubURL, selfURL := reader.DetectWebSubLinks(header, []byte(ex.body))
+
+			assert.Equal(t, ex.hubURL, hubURL)
+			assert.Equal(t, ex.selfURL, selfURL)
+		})
+	}
+}
@@ -0,0 +1,284 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type Rule struct {
+	*core.UserFeedRule
+	re *regexp.Regexp
+}
+
+func NewRule(dbRule *core.UserFeedRule) (*Rule, error) {
+	r := &Rule{
+		UserFeedRule: dbRule,
+	}
+
+	if dbRule.MatchType == core.FeedRuleMatchTypeRegex {
+		re, err := regexp.Compile(dbRule.Pattern)
+
+		if err != nil {
+			return nil, fmt.Errorf("rule %s has invalid pattern: %w", dbRule.ID, err)
+		}
+
+		r.re = re
+	}
+
+	return r, nil
+}
+
+// AppliesTo checks whether the rule is global or
+// bound to the given subscription
+func (r *Rule) AppliesTo(subscriptionID string) bool {
+	return !r.SubscriptionID.Valid || r.SubscriptionID.String == subscriptionID
+}
+
+func (r *Rule) Match(item *core.RSSItem, itemURL string) bool {
+	var value string
+
+	switch r.Field {
+	case core.FeedRuleFieldTitle:
+		value = item.Title
+	case core.FeedRuleFieldDescription:
+		value = item.SanitizedDescription
+	case core.FeedRuleFieldHost:
+		parsed, err := url.Parse(itemURL)
+
+		if err != nil {
+			return false
+		}
+
+		value = strings.ToLower(parsed.Hostname())
+
+		// host keyword should match subdomains as well, but not
+		// random hosts that happen to contain the keyword
+		if r.MatchType == core.FeedRuleMatchTypeKeyword {
+			pattern := strings.ToLower(strings.TrimSpace(r.Pattern))
+
+			return value == pattern || strings.HasSuffix(value, "."+pattern)
+		}
+	}
+
+	if r.MatchType == core.FeedRuleMatchTypeRegex {
+		return r.re.MatchString(value)
+	}
+
+	return strings.Contains(strings.ToLower(value), strings.ToLower(r.Pattern))
+}
+
+// Outcome is the combined result of all the rules matched by an item
+type Outcome struct {
+	Dismiss   bool
+	Highlight bool
+	DraftPost bool
+}
+
+func Evaluate(rules []*Rule, subscriptionID string, item *core.RSSItem, itemURL string) Outcome {
+	var out Outcome
+
+	for _, r := range rules {
+		if !r.AppliesTo(subscriptionID) || !r.Match(item, itemURL) {
+			continue
+		}
+
+		switch r.Action {
+		case core.FeedRuleActionDismiss:
+			out.Dismiss = true
+		case core.FeedRuleActionHighlight:
+			out.Highlight = true
+		case core.FeedRuleActionDraftPost:
+			out.DraftPost = true
+		}
+	}
+
+	return out
+}
+
+// LoadRules returns the rules grouped by user id
+func LoadRules(ctx context.Context, exec boil.ContextExecutor, userIDs []string) (map[string][]*Rule, error) {
+	out := map[string][]*Rule{}
+
+	if len(userIDs) == 0 {
+		return out, nil
+	}
+
+	dbRules, err := core.UserFeedRules(
+		core.UserFeedRuleWhere.UserID.IN(userIDs),
+		qm.OrderBy(core.UserFeedRuleColumns.ID),
+	).All(ctx, exec)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dbRule := range dbRules {
+		r, err := NewRule(dbRule)
+
+		// pattern is validated on save, so it's safer to skip the rule
+		// than to fail feed processing for everyone
+		if err != nil {
+			continue
+		}
+
+		out[dbRule.UserID] = append(out[dbRule.UserID], r)
+	}
+
+	return out, nil
+}
+
+// Apply modifies the user feed item according to the outcome and creates
+// a draft post if requested. The caller is responsible for saving the item
+func Apply(ctx context.Context, exec boil.ContextExecutor, outcome Outcome, userItem *core.UserFeedItem, rssItem *core.RSSItem) error {
+	if outcome.Dismiss {
+		userItem.IsDismissed = true
+	}
+
+	userItem.IsHighlighted = outcome.Highlight
+
+	if outcome.DraftPost {
+		return createDraft(ctx, exec, userItem.UserID, rssItem)
+	}
+
+	return nil
+}
+
+func createDraft(ctx context.Context, exec boil.ContextExecutor, userID string, rssItem *core.RSSItem) error {
+	// rules can be rerun any number of times, one draft is enough
+	exists, err := core.Posts(
+		core.PostWhere.UserID.EQ(userID),
+		core.PostWhere.RSSItemID.EQ(null.StringFrom(rssItem.ID)),
+	).Exists(ctx, exec)
+
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return nil
+	}
+
+	postID, err := uuid.NewV7()
+
+	if err != nil {
+		return err
+	}
+
+	title := strings.TrimSpace(rssItem.Title)
+
+	post := &core.Post{
+		ID:               postID.String(),
+		Subject:          null.NewString(title, title != ""),
+		UserID:           userID,
+		URLID:            null.StringFrom(rssItem.URLID),
+		RSSItemID:        null.StringFrom(rssItem.ID),
+		VisibilityRadius: core.PostVisibilityDirectOnly,
+	}
+
+	return post.Insert(ctx, exec, boil.Infer())
+}
+
+// rerunItemsLimit keeps the rerun short enough to happen while the user
+// waits for the rule to be saved, older items are rarely looked at anyway
+const rerunItemsLimit = 500
+
+// Rerun applies current rules of the user to the most recent
+// items that have not been dismissed yet
+func Rerun(ctx context.Context, exec boil.ContextExecutor, userID string) error {
+	userRules, err := LoadRules(ctx, exec, []string{userID})
+
+	if err != nil {
+		return err
+	}
+
+	subscriptions, err := core.UserFeedSubscriptions(
+		core.UserFeedSubscriptionWhere.UserID.EQ(userID),
+	).All(ctx, exec)
+
+	if err != nil {
+		return err
+	}
+
+	subscriptionByFeed := lo.SliceToMap(subscriptions, func(s *core.UserFeedSubscription) (string, string) {
+		return s.FeedID, s.ID
+	})
+
+	items, err := core.UserFeedItems(
+		core.UserFeedItemWhere.UserID.EQ(userID),
+		core.UserFeedItemWhere.IsDismissed.EQ(false),
+		qm.Load(core.UserFeedItemRels.RSSItem),
+		qm.Load(core.UserFeedItemRels.URL),
+		qm.OrderBy(fmt.Sprintf("%s DESC", core.UserFeedItemColumns.CreatedAt)),
+		qm.Limit(rerunItemsLimit),
+	).All(ctx, exec)
+
+	if err != nil {
+		return err
+	}
+
+	drafted, err := draftedRSSItems(ctx, exec, userID, lo.Map(items, func(item *core.UserFeedItem, idx int) string {
+		return item.RSSItemID
+	}))
+
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		outcome := Evaluate(userRules[userID], subscriptionByFeed[item.R.RSSItem.FeedID], item.R.RSSItem, item.R.URL.URL)
+
+		// one draft is enough
+		if drafted[item.RSSItemID] {
+			outcome.DraftPost = false
+		}
+
+		if !outcome.Dismiss && !outcome.DraftPost && outcome.Highlight == item.IsHighlighted {
+			continue
+		}
+
+		if err := Apply(ctx, exec, outcome, item, item.R.RSSItem); err != nil {
+			return err
+		}
+
+		if _, err := item.Update(ctx, exec, boil.Infer()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// draftedRSSItems returns the ids of the rss items the user has posts about
+func draftedRSSItems(ctx context.Context, exec boil.ContextExecutor, userID string, rssItemIDs []string) (map[string]bool, error) {
+	out := map[string]bool{}
+
+	if len(rssItemIDs) == 0 {
+		return out, nil
+	}
+
+	posts, err := core.Posts(
+		qm.Select(core.PostColumns.RSSItemID),
+		core.PostWhere.UserID.EQ(userID),
+		core.PostWhere.RSSItemID.IN(rssItemIDs),
+	).All(ctx, exec)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range posts {
+		out[p.RSSItemID.String] = true
+	}
+
+	return out, nil
+}
@@ -0,0 +1,158 @@
+package rules_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/can3p/pcom/pkg/feedops/rules"
+	"github.com/can3p/pcom/pkg/feedops/testutil"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/testcontainers/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func newRule(t *testing.T, subscriptionID string, field core.FeedRuleField, matchType core.FeedRuleMatchType, pattern string, action core.FeedRuleAction) *rules.Rule {
+	r, err := rules.NewRule(&core.UserFeedRule{
+		ID:             "rule",
+		SubscriptionID: null.NewString(subscriptionID, subscriptionID != ""),
+		Field:          field,
+		MatchType:      matchType,
+		Pattern:        pattern,
+		Action:         action,
+	})
+	require.NoError(t, err)
+
+	return r
+}
+
+func TestMatch(t *testing.T) {
+	item := &core.RSSItem{
+		Title:                "Sponsored: The Best Keyboard",
+		SanitizedDescription: "Buy it now at the **store**",
+	}
+
+	examples := []struct {
+		name    string
+		rule    *rules.Rule
+		itemURL string
+		matches bool
+	}{
+		{
+			name:    "title keyword is case insensitive",
+			rule:    newRule(t, "", core.FeedRuleFieldTitle, core.FeedRuleMatchTypeKeyword, "sponsored", core.FeedRuleActionDismiss),
+			matches: true,
+		},
+		{
+			name:    "title regex",
+			rule:    newRule(t, "", core.FeedRuleFieldTitle, core.FeedRuleMatchTypeRegex, `^Sponsored:`, core.FeedRuleActionDismiss),
+			matches: true,
+		},
+		{
+			name:    "regex is case sensitive by default",
+			rule:    newRule(t, "", core.FeedRuleFieldTitle, core.FeedRuleMatchTypeRegex, `^sponsored:`, core.FeedRuleActionDismiss),
+			matches: false,
+		},
+		{
+			name:    "description keyword",
+			rule:    newRule(t, "", core.FeedRuleFieldDescription, core.FeedRuleMatchTypeKeyword, "store", core.FeedRuleActionHighlight),
+			matches: true,
+		},
+		{
+			name:    "host keyword matches subdomains",
+			rule:    newRule(t, "", core.FeedRuleFieldHost, core.FeedRuleMatchTypeKeyword, "example.com", core.FeedRuleActionDismiss),
+			itemURL: "https://blog.example.com/post",
+			matches: true,
+		},
+		{
+			name:    "host keyword does not match other hosts",
+			rule:    newRule(t, "", core.FeedRuleFieldHost, core.FeedRuleMatchTypeKeyword, "example.com", core.FeedRuleActionDismiss),
+			itemURL: "https://notexample.com/post",
+			matches: false,
+		},
+		{
+			name:    "host regex",
+			rule:    newRule(t, "", core.FeedRuleFieldHost, core.FeedRuleMatchTypeRegex, `\.substack\.com$`, core.FeedRuleActionDismiss),
+			itemURL: "https://someone.substack.com/p/post",
+			matches: true,
+		},
+	}
+
+	for _, ex := range examples {
+		t.Run(ex.name, func(t *testing.T) {
+			assert.Equal(t, ex.matches, ex.rule.Match(item, ex.itemURL))
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	item := &core.RSSItem{
+		Title: "Weekly links",
+	}
+
+	ruleList := []*rules.Rule{
+		newRule(t, "", core.FeedRuleFieldTitle, core.FeedRuleMatchTypeKeyword, "links", core.FeedRuleActionHighlight),
+		newRule(t, "sub2", core.FeedRuleFieldTitle, core.FeedRuleMatchTypeKeyword, "weekly", core.FeedRuleActionDismiss),
+		newRule(t, "sub1", core.FeedRuleFieldTitle, core.FeedRuleMatchTypeKeyword, "weekly", core.FeedRuleActionDraftPost),
+	}
+
+	assert.Equal(t, rules.Outcome{Highlight: true, DraftPost: true}, rules.Evaluate(ruleList, "sub1", item, "https://example.com"))
+	assert.Equal(t, rules.Outcome{Highlight: true, Dismiss: true}, rules.Evaluate(ruleList, "sub2", item, "https://example.com"))
+	assert.Equal(t, rules.Outcome{}, rules.Evaluate(ruleList, "sub1", &core.RSSItem{Title: "Other"}, "https://example.com"))
+}
+
+func TestInvalidRegex(t *testing.T) {
+	_, err := rules.NewRule(&core.UserFeedRule{
+		ID:        "rule",
+		MatchType: core.FeedRuleMatchTypeRegex,
+		Pattern:   "(unclosed",
+	})
+
+	require.Error(t, err)
+}
+
+func TestRerun(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+
+	user, err := testutil.CreateUser(ctx, testDB.DB, "test@example.com")
+	require.NoError(t, err)
+
+	feed, err := testutil.CreateRSSFeed(ctx, testDB.DB, "https://example.com/feed", "Feed")
+	require.NoError(t, err)
+
+	_, err = testutil.CreateUserFeedSubscription(ctx, testDB.DB, user.ID, feed.ID)
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	for _, name := range []string{"go release", "go conference", "rust release"} {
+		url, err := testutil.CreateURL(ctx, testDB.DB, "https://example.com/"+name)
+		require.NoError(t, err)
+		rssItem, err := testutil.CreateRSSItem(ctx, testDB.DB, feed.ID, url.ID, name, now)
+		require.NoError(t, err)
+		_, err = testutil.CreateUserFeedItem(ctx, testDB.DB, user.ID, rssItem.ID, url.ID, now)
+		require.NoError(t, err)
+	}
+
+	_, err = testutil.CreateUserFeedRule(ctx, testDB.DB, user.ID, "", core.FeedRuleFieldTitle, "go", core.FeedRuleActionDraftPost)
+	require.NoError(t, err)
+
+	countDrafts := func() int64 {
+		count, err := core.Posts(core.PostWhere.UserID.EQ(user.ID)).Count(ctx, testDB.DB)
+		require.NoError(t, err)
+
+		return count
+	}
+
+	require.NoError(t, rules.Rerun(ctx, testDB.DB, user.ID))
+	assert.Equal(t, int64(2), countDrafts())
+
+	require.NoError(t, rules.Rerun(ctx, testDB.DB, user.ID))
+	assert.Equal(t, int64(2), countDrafts(), "drafts are not created twice")
+}
@@ -114,3 +114,21 @@ func GetRSSItemsByFeed(ctx context.Context, exec boil.ContextExecutor, feedID st
 func GetUserFeedItemsByUser(ctx context.Context, exec boil.ContextExecutor, userID string) (core.UserFeedItemSlice, error) {
 	return core.UserFeedItems(core.UserFeedItemWhere.UserID.EQ(userID)).All(ctx, exec)
 }
+
+func CreateUserFeedRule(ctx context.Context, exec boil.ContextExecutor, userID string, subscriptionID string, field core.FeedRuleField, keyword string, action core.FeedRuleAction) (*core.UserFeedRule, error) {
+	rule := &core.UserFeedRule{
+		ID:             uuid.New().String(),
+		UserID:         userID,
+		SubscriptionID: null.NewString(subscriptionID, subscriptionID != ""),
+		Field:          field,
+		MatchType:      core.FeedRuleMatchTypeKeyword,
+		Pattern:        keyword,
+		Action:         action,
+	}
+
+	if err := rule.Insert(ctx, exec, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
@@ -0,0 +1,130 @@
+package websub
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	ModeSubscribe   = "subscribe"
+	ModeUnsubscribe = "unsubscribe"
+	ModeDenied      = "denied"
+
+	// the hub is free to pick any lease, this is only our preference
+	DefaultLease = 10 * 24 * time.Hour
+)
+
+var (
+	ErrUnknownSubscription = errors.New("unknown websub subscription")
+	ErrInvalidSignature    = errors.New("websub payload signature mismatch")
+)
+
+// Client talks to WebSub hubs on behalf of the subscriber,
+// see https://www.w3.org/TR/websub/#subscriber-sends-subscription-request
+type Client struct {
+	httpClient *http.Client
+}
+
+func NewClient(httpClient *http.Client) *Client {
+	return &Client{
+		httpClient: httpClient,
+	}
+}
+
+func (c *Client) Subscribe(ctx context.Context, hubURL, topicURL, callbackURL, secret string) error {
+	form := url.Values{}
+	form.Set("hub.mode", ModeSubscribe)
+	form.Set("hub.topic", topicURL)
+	form.Set("hub.callback", callbackURL)
+	form.Set("hub.secret", secret)
+	form.Set("hub.lease_seconds", strconv.Itoa(int(DefaultLease.Seconds())))
+
+	return c.send(ctx, hubURL, form)
+}
+
+func (c *Client) Unsubscribe(ctx context.Context, hubURL, topicURL, callbackURL string) error {
+	form := url.Values{}
+	form.Set("hub.mode", ModeUnsubscribe)
+	form.Set("hub.topic", topicURL)
+	form.Set("hub.callback", callbackURL)
+
+	return c.send(ctx, hubURL, form)
+}
+
+func (c *Client) send(ctx context.Context, hubURL string, form url.Values) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", hubURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return errors.Wrap(err, "failed to create hub request")
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "hub request failed")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	// the spec mandates 202, but some hubs reply with 204 and
+	// verify the intent synchronously
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+
+		return errors.Errorf("hub rejected the request: HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	return nil
+}
+
+// VerifySignature checks X-Hub-Signature header value against the payload,
+// see https://www.w3.org/TR/websub/#signature-validation
+func VerifySignature(secret string, signature string, body []byte) bool {
+	if secret == "" {
+		return false
+	}
+
+	method, sig, ok := strings.Cut(signature, "=")
+
+	if !ok {
+		return false
+	}
+
+	var h func() hash.Hash
+
+	switch strings.ToLower(method) {
+	case "sha1":
+		h = sha1.New
+	case "sha256":
+		h = sha256.New
+	case "sha384":
+		h = sha512.New384
+	case "sha512":
+		h = sha512.New
+	default:
+		return false
+	}
+
+	expected, err := hex.DecodeString(sig)
+
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(h, []byte(secret))
+	mac.Write(body)
+
+	return hmac.Equal(mac.Sum(nil), expected)
+}
@@ -0,0 +1,166 @@
+package websub_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/can3p/pcom/pkg/feedops/websub"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// fakeHub verifies the intent synchronously and then
+// immediately distributes the given content to the subscriber
+func fakeHub(t *testing.T, content []byte) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+
+		callback := r.PostForm.Get("hub.callback")
+
+		verifyURL, err := url.Parse(callback)
+		require.NoError(t, err)
+
+		q := verifyURL.Query()
+		q.Set("hub.mode", r.PostForm.Get("hub.mode"))
+		q.Set("hub.topic", r.PostForm.Get("hub.topic"))
+		q.Set("hub.challenge", "challenge-string")
+		q.Set("hub.lease_seconds", r.PostForm.Get("hub.lease_seconds"))
+		verifyURL.RawQuery = q.Encode()
+
+		resp, err := http.Get(verifyURL.String())
+		require.NoError(t, err)
+
+		challenge, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK || string(challenge) != "challenge-string" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		req, err := http.NewRequest("POST", callback, strings.NewReader(string(content)))
+		require.NoError(t, err)
+		req.Header.Set("X-Hub-Signature", sign(r.PostForm.Get("hub.secret"), content))
+
+		resp, err = http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+
+		w.WriteHeader(http.StatusAccepted)
+	}))
+}
+
+func TestSubscribe(t *testing.T) {
+	content := []byte("<feed></feed>")
+	secret := "secret"
+	topic := "https://example.com/feed"
+
+	var received []byte
+	var verifiedTopic string
+
+	subscriber := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			verifiedTopic = r.URL.Query().Get("hub.topic")
+			_, _ = w.Write([]byte(r.URL.Query().Get("hub.challenge")))
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		if websub.VerifySignature(secret, r.Header.Get("X-Hub-Signature"), body) {
+			received = body
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer subscriber.Close()
+
+	hub := fakeHub(t, content)
+	defer hub.Close()
+
+	client := websub.NewClient(http.DefaultClient)
+
+	err := client.Subscribe(context.Background(), hub.URL, topic, subscriber.URL+"/websub/feed", secret)
+	require.NoError(t, err)
+
+	assert.Equal(t, topic, verifiedTopic)
+	assert.Equal(t, content, received)
+}
+
+func TestSubscribeRejected(t *testing.T) {
+	hub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unknown topic", http.StatusBadRequest)
+	}))
+	defer hub.Close()
+
+	client := websub.NewClient(http.DefaultClient)
+
+	err := client.Subscribe(context.Background(), hub.URL, "https://example.com/feed", "https://pcom.example/websub/feed", "secret")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown topic")
+}
+
+func TestVerifySignature(t *testing.T) {
+	body := []byte("payload")
+
+	examples := []struct {
+		name      string
+		secret    string
+		signature string
+		valid     bool
+	}{
+		{
+			name:      "valid sha256",
+			secret:    "secret",
+			signature: sign("secret", body),
+			valid:     true,
+		},
+		{
+			name:      "wrong secret",
+			secret:    "another secret",
+			signature: sign("secret", body),
+			valid:     false,
+		},
+		{
+			name:      "empty secret",
+			secret:    "",
+			signature: sign("", body),
+			valid:     false,
+		},
+		{
+			name:      "unknown method",
+			secret:    "secret",
+			signature: "md5=abcdef",
+			valid:     false,
+		},
+		{
+			name:      "malformed header",
+			secret:    "secret",
+			signature: "garbage",
+			valid:     false,
+		},
+	}
+
+	for _, ex := range examples {
+		t.Run(ex.name, func(t *testing.T) {
+			assert.Equal(t, ex.valid, websub.VerifySignature(ex.secret, ex.signature, body))
+		})
+	}
+}
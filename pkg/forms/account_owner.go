@@ -0,0 +1,18 @@
+package forms
+
+import (
+	"github.com/can3p/gogo/forms"
+	"github.com/can3p/pcom/pkg/auth"
+	"github.com/gin-gonic/gin"
+)
+
+// requireRecentLogin stands in for the password check of the accounts without a password,
+// it's the login itself that proves the user owns the account as long as it's recent
+func requireRecentLogin(c *gin.Context, form interface{ SetFormError(message string) }) error {
+	if !auth.RecentlyLoggedIn(c) {
+		form.SetFormError("Please log in again to confirm it's you")
+		return forms.ErrValidationFailed
+	}
+
+	return nil
+}
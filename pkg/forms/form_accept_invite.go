@@ -26,8 +26,8 @@ type AcceptInviteForm struct {
 	Invite *core.UserInvitation
 }
 
-func AcceptInviteFormNew(sender sender.Sender, invite *core.UserInvitation) forms.Form {
-	var form forms.Form = &AcceptInviteForm{
+func AcceptInviteFormNew(sender sender.Sender, invite *core.UserInvitation) *AcceptInviteForm {
+	return &AcceptInviteForm{
 		FormBase: &forms.FormBase[AcceptInviteFormInput]{
 			Name:         "accept_invite",
 			FormTemplate: "form--accept-invite.html",
@@ -39,8 +39,15 @@ func AcceptInviteFormNew(sender sender.Sender, invite *core.UserInvitation) form
 		Sender: sender,
 		Invite: invite,
 	}
+}
+
+// Throttle counts every attempt, the invite is the account being targeted
+func (f *AcceptInviteForm) Throttle(c *gin.Context, db boil.ContextExecutor) error {
+	if err := auth.CheckAttempts(c, db, auth.AttemptAcceptInvite, c.ClientIP(), f.Invite.ID); err != nil {
+		return err
+	}
 
-	return form
+	return auth.RecordAttempt(c, db, auth.AttemptAcceptInvite, c.ClientIP(), f.Invite.ID, false)
 }
 
 func (f *AcceptInviteForm) Validate(c *gin.Context, db boil.ContextExecutor) error {
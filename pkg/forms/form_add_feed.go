@@ -13,7 +13,8 @@ import (
 )
 
 type AddFeedFormInput struct {
-	URL string `form:"url"`
+	URL    string `form:"url"`
+	Folder string `form:"folder"`
 }
 
 type AddFeedForm struct {
@@ -21,12 +22,15 @@ type AddFeedForm struct {
 	User *core.User
 }
 
-func NewAddFeedForm(u *core.User) *AddFeedForm {
+func NewAddFeedForm(u *core.User, folders []*feedops.FeedFolder) *AddFeedForm {
 	return &AddFeedForm{
 		FormBase: &forms.FormBase[AddFeedFormInput]{
 			Name:         "add_rss_feed",
 			FormTemplate: "form--settings-feeds.html",
 			Input:        &AddFeedFormInput{},
+			ExtraTemplateData: map[string]any{
+				"Folders": folders,
+			},
 		},
 		User: u,
 	}
@@ -41,13 +45,19 @@ func (f *AddFeedForm) Validate(c *gin.Context, db boil.ContextExecutor) error {
 		f.AddError("url", "url should have http or https protocol")
 	}
 
+	if folder := strings.TrimSpace(f.Input.Folder); folder != "" {
+		if err := validation.ValidateMinMax("folder", folder, 1, 50); err != nil {
+			f.AddError("folder", err.Error())
+		}
+	}
+
 	return f.Errors.PassedValidation()
 }
 
 func (f *AddFeedForm) Save(c context.Context, exec boil.ContextExecutor) (forms.FormSaveAction, error) {
 	url := strings.TrimSpace(f.Input.URL)
 
-	if err := feedops.SubscribeToFeed(c, exec, f.User.ID, url); err != nil {
+	if err := feedops.SubscribeToFeed(c, exec, f.User.ID, url, f.Input.Folder); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,119 @@
+package forms
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/can3p/gogo/forms"
+	"github.com/can3p/pcom/pkg/feedops"
+	"github.com/can3p/pcom/pkg/feedops/rules"
+	"github.com/can3p/pcom/pkg/forms/validation"
+	"github.com/can3p/pcom/pkg/forms/values"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type AddFeedRuleFormInput struct {
+	SubscriptionID string `form:"subscription_id"`
+	Field          string `form:"field"`
+	MatchType      string `form:"match_type"`
+	Pattern        string `form:"pattern"`
+	Action         string `form:"action"`
+}
+
+type AddFeedRuleForm struct {
+	*forms.FormBase[AddFeedRuleFormInput]
+	User *core.User
+}
+
+func NewAddFeedRuleForm(u *core.User, feeds []*feedops.RssFeed) *AddFeedRuleForm {
+	return &AddFeedRuleForm{
+		FormBase: &forms.FormBase[AddFeedRuleFormInput]{
+			Name:         "add_feed_rule",
+			FormTemplate: "form--settings-feed-rules.html",
+			Input:        &AddFeedRuleFormInput{},
+			ExtraTemplateData: map[string]any{
+				"Feeds":      feeds,
+				"Fields":     values.FeedRuleFieldValues,
+				"MatchTypes": values.FeedRuleMatchTypeValues,
+				"Actions":    values.FeedRuleActionValues,
+			},
+		},
+		User: u,
+	}
+}
+
+func (f *AddFeedRuleForm) Validate(c *gin.Context, db boil.ContextExecutor) error {
+	if f.Input.SubscriptionID != "" {
+		exists, err := core.UserFeedSubscriptions(
+			core.UserFeedSubscriptionWhere.ID.EQ(f.Input.SubscriptionID),
+			core.UserFeedSubscriptionWhere.UserID.EQ(f.User.ID),
+		).Exists(c, db)
+
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			f.AddError("subscription_id", "Unknown feed")
+		}
+	}
+
+	if err := core.FeedRuleField(f.Input.Field).IsValid(); err != nil {
+		f.AddError("field", fmt.Sprintf("Invalid value [%s]", f.Input.Field))
+	}
+
+	if err := core.FeedRuleMatchType(f.Input.MatchType).IsValid(); err != nil {
+		f.AddError("match_type", fmt.Sprintf("Invalid value [%s]", f.Input.MatchType))
+	}
+
+	if err := core.FeedRuleAction(f.Input.Action).IsValid(); err != nil {
+		f.AddError("action", fmt.Sprintf("Invalid value [%s]", f.Input.Action))
+	}
+
+	pattern := strings.TrimSpace(f.Input.Pattern)
+
+	if err := validation.ValidateMinMax("pattern", pattern, 1, 200); err != nil {
+		f.AddError("pattern", err.Error())
+	} else if core.FeedRuleMatchType(f.Input.MatchType) == core.FeedRuleMatchTypeRegex {
+		if _, err := regexp.Compile(pattern); err != nil {
+			f.AddError("pattern", fmt.Sprintf("Invalid regular expression: %s", err.Error()))
+		}
+	}
+
+	return f.Errors.PassedValidation()
+}
+
+func (f *AddFeedRuleForm) Save(c context.Context, exec boil.ContextExecutor) (forms.FormSaveAction, error) {
+	ruleID, err := uuid.NewV7()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rule := &core.UserFeedRule{
+		ID:             ruleID.String(),
+		UserID:         f.User.ID,
+		SubscriptionID: null.NewString(f.Input.SubscriptionID, f.Input.SubscriptionID != ""),
+		Field:          core.FeedRuleField(f.Input.Field),
+		MatchType:      core.FeedRuleMatchType(f.Input.MatchType),
+		Pattern:        strings.TrimSpace(f.Input.Pattern),
+		Action:         core.FeedRuleAction(f.Input.Action),
+	}
+
+	if err := rule.Insert(c, exec, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	// new rule should affect the items that are already in the feed
+	if err := rules.Rerun(c, exec, f.User.ID); err != nil {
+		return nil, err
+	}
+
+	return forms.FormSaveFullReload, nil
+}
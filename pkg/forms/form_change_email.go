@@ -0,0 +1,115 @@
+package forms
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/can3p/gogo/forms"
+	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/auth"
+	"github.com/can3p/pcom/pkg/forms/validation"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/pkg/pgsession"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type ChangeEmailFormInput struct {
+	Email    string `form:"email"`
+	Password string `form:"password"`
+}
+
+type ChangeEmailForm struct {
+	*forms.FormBase[ChangeEmailFormInput]
+	Sender sender.Sender
+	User   *core.User
+	rehash bool
+}
+
+func ChangeEmailFormNew(sender sender.Sender, u *core.User) *ChangeEmailForm {
+	return &ChangeEmailForm{
+		FormBase: &forms.FormBase[ChangeEmailFormInput]{
+			Name:         "change_email",
+			FormTemplate: "form--settings-change-email.html",
+			Input:        &ChangeEmailFormInput{},
+			ExtraTemplateData: map[string]any{
+				"NoPassword": !auth.HasPassword(u),
+			},
+		},
+		Sender: sender,
+		User:   u,
+	}
+}
+
+func (f *ChangeEmailForm) email() string {
+	return strings.TrimSpace(strings.ToLower(f.Input.Email))
+}
+
+func (f *ChangeEmailForm) Validate(c *gin.Context, db boil.ContextExecutor) error {
+	email := f.email()
+
+	if email == "" {
+		f.AddError("email", "email is required")
+	} else if email == f.User.Email {
+		f.AddError("email", "this is your current email")
+	} else if reason, isOK := validation.EmailOKToSignup(c, db, f.Sender, email); !isOK {
+		f.AddError("email", reason)
+	}
+
+	if auth.HasPassword(f.User) && f.Input.Password == "" {
+		f.AddError("password", "password is required")
+	}
+
+	if err := f.Errors.PassedValidation(); err != nil {
+		return err
+	}
+
+	if !auth.HasPassword(f.User) {
+		return requireRecentLogin(c, f)
+	}
+
+	ok, rehash, err := pgsession.CheckPassword(f.User.Email, f.Input.Password, f.User.Pwdhash.String)
+
+	if errors.Is(err, pgsession.ErrPasswordExpired) {
+		f.AddError("password", "password has expired, please reset it")
+		return forms.ErrValidationFailed
+	}
+
+	if err != nil {
+		return errors.Wrapf(err, "Failed to check user password, cannot proceed")
+	}
+
+	if !ok {
+		f.AddError("password", "password is not correct")
+		return forms.ErrValidationFailed
+	}
+
+	f.rehash = rehash
+
+	return nil
+}
+
+func (f *ChangeEmailForm) Save(c context.Context, exec boil.ContextExecutor) (forms.FormSaveAction, error) {
+	// legacy hashes are salted with the email, the new one has to be in place before it changes
+	if f.rehash {
+		if err := auth.SetPassword(c, exec, f.User, f.Input.Password); err != nil {
+			return nil, errors.Wrapf(err, "failed to upgrade password hash")
+		}
+	}
+
+	if err := auth.RequestEmailChange(c, exec, f.Sender, f.User, f.email()); err != nil {
+		return nil, err
+	}
+
+	oldEmail := f.User.Email
+	newEmail := f.email()
+
+	return func(c *gin.Context, f forms.Form) {
+		c.HTML(http.StatusOK, "partial--email-change-sent.html", map[string]any{
+			"OldEmail": oldEmail,
+			"NewEmail": newEmail,
+		})
+	}, nil
+}
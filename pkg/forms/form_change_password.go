@@ -4,12 +4,12 @@ import (
 	"context"
 
 	"github.com/can3p/gogo/forms"
+	"github.com/can3p/pcom/pkg/auth"
 	"github.com/can3p/pcom/pkg/forms/validation"
 	"github.com/can3p/pcom/pkg/model/core"
 	"github.com/can3p/pcom/pkg/pgsession"
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
-	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
@@ -20,17 +20,22 @@ type ChangePasswordFormInput struct {
 
 type ChangePasswordForm struct {
 	*forms.FormBase[ChangePasswordFormInput]
-	User *core.User
+	User  *core.User
+	store pgsession.Store
 }
 
-func ChangePasswordFormNew(u *core.User) forms.Form {
+func ChangePasswordFormNew(store pgsession.Store, u *core.User) forms.Form {
 	var form forms.Form = &ChangePasswordForm{
 		FormBase: &forms.FormBase[ChangePasswordFormInput]{
 			Name:         "change_password",
 			FormTemplate: "form--settings-change-password.html",
 			Input:        &ChangePasswordFormInput{},
+			ExtraTemplateData: map[string]any{
+				"NoPassword": !auth.HasPassword(u),
+			},
 		},
-		User: u,
+		User:  u,
+		store: store,
 	}
 
 	return form
@@ -42,23 +47,33 @@ func (f *ChangePasswordForm) Validate(c *gin.Context, db boil.ContextExecutor) e
 		return forms.ErrValidationFailed
 	}
 
+	if err := validation.ValidatePassword(f.Input.Password); err != nil {
+		f.AddError("password", err.Error())
+		return forms.ErrValidationFailed
+	}
+
+	// the first password is set by the accounts created without one
+	if !auth.HasPassword(f.User) {
+		return requireRecentLogin(c, f)
+	}
+
 	if f.Input.OldPassword == "" {
 		f.AddError("old_password", "old password is required")
 		return forms.ErrValidationFailed
 	}
 
-	if err := validation.ValidatePassword(f.Input.Password); err != nil {
-		f.AddError("password", err.Error())
+	ok, _, err := pgsession.CheckPassword(f.User.Email, f.Input.OldPassword, f.User.Pwdhash.String)
+
+	if errors.Is(err, pgsession.ErrPasswordExpired) {
+		f.AddError("old_password", "old password has expired, please reset it")
 		return forms.ErrValidationFailed
 	}
 
-	h := pgsession.HashUserPwd(f.User.Email, f.Input.OldPassword)
-
-	if h == "" {
-		return errors.Errorf("Failed to has user password, cannot proceed")
+	if err != nil {
+		return errors.Wrapf(err, "Failed to check user password, cannot proceed")
 	}
 
-	if h != f.User.Pwdhash.String {
+	if !ok {
 		f.AddError("old_password", "old password is not correct")
 		return forms.ErrValidationFailed
 	}
@@ -67,11 +82,14 @@ func (f *ChangePasswordForm) Validate(c *gin.Context, db boil.ContextExecutor) e
 }
 
 func (f *ChangePasswordForm) Save(c context.Context, exec boil.ContextExecutor) (forms.FormSaveAction, error) {
-	f.User.Pwdhash = null.StringFrom(pgsession.HashUserPwd(f.User.Email, f.Input.Password))
-
-	if _, err := f.User.Update(c, exec, boil.Infer()); err != nil {
+	if err := auth.SetPassword(c, exec, f.User, f.Input.Password); err != nil {
 		return nil, errors.Wrapf(err, "failed to save to the db")
 	}
 
+	// whoever knew the old password should not stay logged in
+	if err := auth.RevokeOtherSessions(c.(*gin.Context), exec, f.store, f.User.ID); err != nil {
+		return nil, errors.Wrapf(err, "failed to log out other sessions")
+	}
+
 	return f.FormBase.Save(c, exec)
 }
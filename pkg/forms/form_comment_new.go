@@ -83,6 +83,7 @@ func (f *NewCommentForm) Validate(c *gin.Context, db boil.ContextExecutor) error
 		exists, err := core.PostComments(
 			core.PostCommentWhere.ID.EQ(f.Input.ReplyTo),
 			core.PostCommentWhere.PostID.EQ(f.Input.PostID),
+			core.PostCommentWhere.DeletedAt.IsNull(),
 		).Exists(c, db)
 
 		if err != nil {
@@ -125,7 +126,7 @@ func (f *NewCommentForm) Save(c context.Context, exec boil.ContextExecutor) (for
 
 	comment := &core.PostComment{
 		ID:              commentID,
-		UserID:          f.User.ID,
+		UserID:          null.StringFrom(f.User.ID),
 		Body:            body,
 		PostID:          f.Input.PostID,
 		ParentCommentID: null.NewString(f.Input.ReplyTo, f.Input.ReplyTo != ""),
@@ -136,6 +137,10 @@ func (f *NewCommentForm) Save(c context.Context, exec boil.ContextExecutor) (for
 		return nil, err
 	}
 
+	if err := postops.SyncCommentMediaReferences(c, exec, comment); err != nil {
+		return nil, err
+	}
+
 	post, err := core.Posts(
 		core.PostWhere.ID.EQ(f.Input.PostID),
 		qm.Load(core.PostRels.User),
@@ -161,7 +166,7 @@ func (f *NewCommentForm) Save(c context.Context, exec boil.ContextExecutor) (for
 	{
 		comments, err := core.PostComments(
 			core.PostCommentWhere.PostID.EQ(post.ID),
-			core.PostCommentWhere.UserID.NEQ(post.UserID),
+			core.PostCommentWhere.UserID.NEQ(null.StringFrom(post.UserID)),
 			qm.Distinct(core.PostCommentColumns.UserID),
 			qm.Load(core.PostCommentRels.User),
 		).All(c, exec)
@@ -0,0 +1,82 @@
+package forms
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/can3p/gogo/forms"
+	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/auth"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type ConfirmEmailChangeFormInput struct{}
+
+// ConfirmEmailChangeForm confirms one of the addresses, following the link
+// from the email should not be enough, since mail scanners follow them too
+type ConfirmEmailChangeForm struct {
+	*forms.FormBase[ConfirmEmailChangeFormInput]
+	Sender sender.Sender
+	Token  string
+}
+
+func ConfirmEmailChangeFormNew(sender sender.Sender, token string) forms.Form {
+	var form forms.Form = &ConfirmEmailChangeForm{
+		FormBase: &forms.FormBase[ConfirmEmailChangeFormInput]{
+			Name:         "confirm_email_change",
+			FormTemplate: "form--confirm-email-change.html",
+			Input:        &ConfirmEmailChangeFormInput{},
+			ExtraTemplateData: map[string]any{
+				"Token": token,
+			},
+		},
+		Sender: sender,
+		Token:  token,
+	}
+
+	return form
+}
+
+func (f *ConfirmEmailChangeForm) Validate(c *gin.Context, db boil.ContextExecutor) error {
+	request, err := auth.FindEmailChange(c, db, f.Token)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return errors.Errorf("The link is not valid anymore. It might have expired or the change has been completed already")
+	}
+
+	if err != nil {
+		return err
+	}
+
+	f.AddTemplateData("Request", request)
+
+	return nil
+}
+
+func (f *ConfirmEmailChangeForm) Save(c context.Context, exec boil.ContextExecutor) (forms.FormSaveAction, error) {
+	request, err := auth.ConfirmEmailChange(c, exec, f.Sender, f.Token)
+
+	data := map[string]any{
+		"Request": request,
+	}
+
+	switch {
+	// the other tab might have been faster
+	case errors.Is(err, sql.ErrNoRows):
+	case errors.Is(err, auth.ErrEmailTaken):
+		data["Taken"] = true
+	case err != nil:
+		return nil, err
+	default:
+		data["Valid"] = true
+	}
+
+	return func(c *gin.Context, f forms.Form) {
+		data["IsLoggedIn"] = auth.GetUserData(c).IsLoggedIn
+
+		c.HTML(http.StatusOK, "partial--email-change-confirmed.html", data)
+	}, nil
+}
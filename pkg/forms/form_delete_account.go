@@ -0,0 +1,80 @@
+package forms
+
+import (
+	"context"
+
+	"github.com/can3p/gogo/forms"
+	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/auth"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/pkg/pgsession"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type DeleteAccountFormInput struct {
+	Password string `form:"password"`
+}
+
+type DeleteAccountForm struct {
+	*forms.FormBase[DeleteAccountFormInput]
+	Sender sender.Sender
+	User   *core.User
+}
+
+func DeleteAccountFormNew(sender sender.Sender, u *core.User) *DeleteAccountForm {
+	return &DeleteAccountForm{
+		FormBase: &forms.FormBase[DeleteAccountFormInput]{
+			Name:         "delete_account",
+			FormTemplate: "form--settings-delete-account.html",
+			Input:        &DeleteAccountFormInput{},
+			ExtraTemplateData: map[string]any{
+				"NoPassword": !auth.HasPassword(u),
+			},
+		},
+		Sender: sender,
+		User:   u,
+	}
+}
+
+func (f *DeleteAccountForm) Validate(c *gin.Context, db boil.ContextExecutor) error {
+	if f.User.DeletionScheduledAt.Valid {
+		return errors.New("The deletion of the account is already scheduled")
+	}
+
+	if !auth.HasPassword(f.User) {
+		return requireRecentLogin(c, f)
+	}
+
+	if f.Input.Password == "" {
+		f.AddError("password", "password is required")
+		return forms.ErrValidationFailed
+	}
+
+	ok, _, err := pgsession.CheckPassword(f.User.Email, f.Input.Password, f.User.Pwdhash.String)
+
+	if errors.Is(err, pgsession.ErrPasswordExpired) {
+		f.AddError("password", "password has expired, please reset it")
+		return forms.ErrValidationFailed
+	}
+
+	if err != nil {
+		return errors.Wrapf(err, "Failed to check user password, cannot proceed")
+	}
+
+	if !ok {
+		f.AddError("password", "password is not correct")
+		return forms.ErrValidationFailed
+	}
+
+	return nil
+}
+
+func (f *DeleteAccountForm) Save(c context.Context, exec boil.ContextExecutor) (forms.FormSaveAction, error) {
+	if err := auth.ScheduleAccountDeletion(c, exec, f.Sender, f.User); err != nil {
+		return nil, err
+	}
+
+	return forms.FormSaveFullReload, nil
+}
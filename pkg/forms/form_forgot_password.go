@@ -0,0 +1,76 @@
+package forms
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/can3p/gogo/forms"
+	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/auth"
+	"github.com/can3p/pcom/pkg/forms/validation"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type ForgotPasswordFormInput struct {
+	Email string `form:"email"`
+}
+
+type ForgotPasswordForm struct {
+	*forms.FormBase[ForgotPasswordFormInput]
+	Sender   sender.Sender
+	ClientIP string
+}
+
+func ForgotPasswordFormNew(sender sender.Sender, clientIP string) forms.Form {
+	var form forms.Form = &ForgotPasswordForm{
+		FormBase: &forms.FormBase[ForgotPasswordFormInput]{
+			Name:         "forgot_password",
+			FormTemplate: "form--forgot-password.html",
+			Input:        &ForgotPasswordFormInput{},
+		},
+		Sender:   sender,
+		ClientIP: clientIP,
+	}
+
+	return form
+}
+
+func (f *ForgotPasswordForm) email() string {
+	return strings.TrimSpace(strings.ToLower(f.Input.Email))
+}
+
+func (f *ForgotPasswordForm) Validate(c *gin.Context, db boil.ContextExecutor) error {
+	email := f.email()
+
+	if email == "" {
+		f.AddError("email", "email is required")
+		return forms.ErrValidationFailed
+	}
+
+	if !validation.EmailRE.MatchString(email) {
+		f.AddError("email", "Invalid email")
+		return forms.ErrValidationFailed
+	}
+
+	err := auth.CheckPasswordResetLimits(c, db, email, f.ClientIP)
+
+	if errors.Is(err, auth.ErrTooManyResets) {
+		return errors.Errorf("Too many attempts, please try again later")
+	}
+
+	return err
+}
+
+func (f *ForgotPasswordForm) Save(c context.Context, exec boil.ContextExecutor) (forms.FormSaveAction, error) {
+	if err := auth.RequestPasswordReset(c, exec, f.Sender, f.email(), f.ClientIP); err != nil {
+		return nil, err
+	}
+
+	// the same answer no matter if the user exists
+	return func(c *gin.Context, f forms.Form) {
+		c.HTML(http.StatusOK, "partial--password-reset-sent.html", map[string]any{})
+	}, nil
+}
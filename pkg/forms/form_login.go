@@ -4,10 +4,13 @@ import (
 	"context"
 
 	"github.com/can3p/gogo/forms"
+	"github.com/can3p/gogo/sender"
 	"github.com/can3p/pcom/pkg/auth"
 	"github.com/can3p/pcom/pkg/links"
+	"github.com/can3p/pcom/pkg/model/core"
 	"github.com/can3p/pcom/pkg/util"
 	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
@@ -20,18 +23,26 @@ type LoginFormInput struct {
 
 type LoginForm struct {
 	*forms.FormBase[LoginFormInput]
+	Sender sender.Sender
+
+	// the password is only checked in Validate, Save logs in the user found there
+	user   *core.User
+	rehash bool
 }
 
-func LoginFormNew() forms.Form {
-	var form forms.Form = &LoginForm{
+func LoginFormNew(sender sender.Sender) *LoginForm {
+	return &LoginForm{
 		FormBase: &forms.FormBase[LoginFormInput]{
 			Name:         "login",
 			FormTemplate: "form--login.html",
 			Input:        &LoginFormInput{},
 		},
+		Sender: sender,
 	}
+}
 
-	return form
+func (f *LoginForm) Throttle(c *gin.Context, db boil.ContextExecutor) error {
+	return auth.CheckAttempts(c, db, auth.AttemptLogin, c.ClientIP(), f.Input.Email)
 }
 
 func (f *LoginForm) Validate(c *gin.Context, db boil.ContextExecutor) error {
@@ -45,11 +56,39 @@ func (f *LoginForm) Validate(c *gin.Context, db boil.ContextExecutor) error {
 		return forms.ErrValidationFailed
 	}
 
-	return auth.CheckCredentials(c, db, f.Input.Email, f.Input.Password)
+	user, rehash, err := auth.CheckCredentials(c, db, f.Input.Email, f.Input.Password)
+
+	if errors.Is(err, auth.ErrBadCredentials) {
+		if err := auth.RecordLoginFailure(c, db, f.Sender, c.ClientIP(), f.Input.Email); err != nil {
+			return err
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	f.user = user
+	f.rehash = rehash
+
+	return nil
 }
 
 func (f *LoginForm) Save(c context.Context, exec boil.ContextExecutor) (forms.FormSaveAction, error) {
-	if err := auth.Login(c.(*gin.Context), exec, f.Input.Email, f.Input.Password); err != nil {
+	if f.rehash {
+		if err := auth.UpgradePasswordHash(c, exec, f.user, f.Input.Password); err != nil {
+			return nil, err
+		}
+	}
+
+	err := auth.LoginUser(c.(*gin.Context), exec, f.user)
+
+	// the signed return url is passed along to the second step
+	if errors.Is(err, auth.ErrSecondFactorRequired) {
+		return forms.FormSaveRedirect(links.Link("login_two_factor", "return_url", f.Input.ReturnURL, "sign", f.Input.Sign)), nil
+	}
+
+	if err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,82 @@
+package forms
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/can3p/gogo/forms"
+	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/auth"
+	"github.com/can3p/pcom/pkg/forms/validation"
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type LoginEmailFormInput struct {
+	Email     string `form:"email"`
+	ReturnURL string `form:"return_url"`
+	Sign      string `form:"sign"`
+}
+
+type LoginEmailForm struct {
+	*forms.FormBase[LoginEmailFormInput]
+	Sender sender.Sender
+}
+
+func LoginEmailFormNew(sender sender.Sender) *LoginEmailForm {
+	return &LoginEmailForm{
+		FormBase: &forms.FormBase[LoginEmailFormInput]{
+			Name:         "login_email",
+			FormTemplate: "form--login-email.html",
+			Input:        &LoginEmailFormInput{},
+		},
+		Sender: sender,
+	}
+}
+
+func (f *LoginEmailForm) email() string {
+	return strings.TrimSpace(strings.ToLower(f.Input.Email))
+}
+
+// Throttle counts every request, the link is sent by email after all
+func (f *LoginEmailForm) Throttle(c *gin.Context, db boil.ContextExecutor) error {
+	if err := auth.CheckAttempts(c, db, auth.AttemptLoginLink, c.ClientIP(), f.email()); err != nil {
+		return err
+	}
+
+	return auth.RecordAttempt(c, db, auth.AttemptLoginLink, c.ClientIP(), f.email(), false)
+}
+
+func (f *LoginEmailForm) Validate(c *gin.Context, db boil.ContextExecutor) error {
+	email := f.email()
+
+	if email == "" {
+		f.AddError("email", "email is required")
+		return forms.ErrValidationFailed
+	}
+
+	if !validation.EmailRE.MatchString(email) {
+		f.AddError("email", "Invalid email")
+		return forms.ErrValidationFailed
+	}
+
+	return nil
+}
+
+func (f *LoginEmailForm) Save(c context.Context, exec boil.ContextExecutor) (forms.FormSaveAction, error) {
+	returnURL, sign := f.Input.ReturnURL, f.Input.Sign
+
+	if auth.HashValue(returnURL) != sign {
+		returnURL, sign = "", ""
+	}
+
+	if err := auth.RequestLoginLink(c, exec, f.Sender, f.email(), returnURL, sign); err != nil {
+		return nil, err
+	}
+
+	// the same answer no matter if the user exists
+	return func(c *gin.Context, f forms.Form) {
+		c.HTML(http.StatusOK, "partial--login-link-sent.html", map[string]any{})
+	}, nil
+}
@@ -0,0 +1,79 @@
+package forms
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/can3p/gogo/forms"
+	"github.com/can3p/pcom/pkg/auth"
+	"github.com/can3p/pcom/pkg/links"
+	"github.com/can3p/pcom/pkg/util"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type LoginLinkFormInput struct {
+	ReturnURL string `form:"return_url"`
+	Sign      string `form:"sign"`
+}
+
+// LoginLinkForm only confirms the login, following the link from the
+// email should not be enough, since mail scanners follow them too
+type LoginLinkForm struct {
+	*forms.FormBase[LoginLinkFormInput]
+	Token string
+}
+
+func LoginLinkFormNew(token string) forms.Form {
+	var form forms.Form = &LoginLinkForm{
+		FormBase: &forms.FormBase[LoginLinkFormInput]{
+			Name:         "login_link",
+			FormTemplate: "form--login-link.html",
+			Input:        &LoginLinkFormInput{},
+			ExtraTemplateData: map[string]any{
+				"Token": token,
+			},
+		},
+		Token: token,
+	}
+
+	return form
+}
+
+func (f *LoginLinkForm) Validate(c *gin.Context, db boil.ContextExecutor) error {
+	_, err := auth.FindLoginLink(c, db, f.Token)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return errors.Errorf("The link has expired or has been used already, please ask for a new one")
+	}
+
+	return err
+}
+
+func (f *LoginLinkForm) Save(c context.Context, exec boil.ContextExecutor) (forms.FormSaveAction, error) {
+	ginCtx := c.(*gin.Context)
+
+	user, err := auth.UseLoginLink(c, exec, f.Token)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = auth.LoginUser(ginCtx, exec, user)
+
+	// the signed return url is passed along to the second step
+	if errors.Is(err, auth.ErrSecondFactorRequired) {
+		return forms.FormSaveRedirect(links.Link("login_two_factor", "return_url", f.Input.ReturnURL, "sign", f.Input.Sign)), nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if f.Input.ReturnURL != "" && auth.HashValue(f.Input.ReturnURL) == f.Input.Sign {
+		return forms.FormSaveRedirect(util.SiteRoot() + f.Input.ReturnURL), nil
+	}
+
+	return forms.FormSaveRedirect(links.DefaultAuthorizedHome()), nil
+}
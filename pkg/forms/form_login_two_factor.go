@@ -0,0 +1,105 @@
+package forms
+
+import (
+	"context"
+
+	"github.com/can3p/gogo/forms"
+	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/auth"
+	"github.com/can3p/pcom/pkg/links"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/pkg/util"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type LoginTwoFactorFormInput struct {
+	Code      string `form:"code"`
+	Remember  bool   `form:"remember"`
+	ReturnURL string `form:"return_url"`
+	Sign      string `form:"sign"`
+}
+
+type LoginTwoFactorForm struct {
+	*forms.FormBase[LoginTwoFactorFormInput]
+	Sender sender.Sender
+	user   *core.User
+}
+
+func LoginTwoFactorFormNew(sender sender.Sender) *LoginTwoFactorForm {
+	return &LoginTwoFactorForm{
+		FormBase: &forms.FormBase[LoginTwoFactorFormInput]{
+			Name:         "login_two_factor",
+			FormTemplate: "form--login-two-factor.html",
+			Input:        &LoginTwoFactorFormInput{},
+		},
+		Sender: sender,
+	}
+}
+
+// Throttle shares the delays and the lockout with the password step,
+// the wrong codes are counted as the failed logins of the account
+func (f *LoginTwoFactorForm) Throttle(c *gin.Context, db boil.ContextExecutor) error {
+	user, err := auth.PendingSecondFactorUser(c, db)
+
+	if err != nil {
+		return err
+	}
+
+	var account string
+
+	if user != nil {
+		account = user.Email
+	}
+
+	return auth.CheckAttempts(c, db, auth.AttemptLogin, c.ClientIP(), account)
+}
+
+func (f *LoginTwoFactorForm) Validate(c *gin.Context, db boil.ContextExecutor) error {
+	user, err := auth.PendingSecondFactorUser(c, db)
+
+	if err != nil {
+		return err
+	}
+
+	if user == nil {
+		return errors.Errorf("The login attempt has expired, please log in again")
+	}
+
+	if f.Input.Code == "" {
+		f.AddError("code", "code is required")
+		return forms.ErrValidationFailed
+	}
+
+	ok, err := auth.VerifySecondFactor(c, db, user, f.Input.Code)
+
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		if err := auth.FailSecondFactor(c, db, f.Sender, user); err != nil {
+			return err
+		}
+
+		f.AddError("code", "code is not correct")
+		return forms.ErrValidationFailed
+	}
+
+	f.user = user
+
+	return nil
+}
+
+func (f *LoginTwoFactorForm) Save(c context.Context, exec boil.ContextExecutor) (forms.FormSaveAction, error) {
+	if err := auth.CompleteSecondFactor(c.(*gin.Context), exec, f.user, f.Input.Remember); err != nil {
+		return nil, err
+	}
+
+	if f.Input.ReturnURL != "" && auth.HashValue(f.Input.ReturnURL) == f.Input.Sign {
+		return forms.FormSaveRedirect(util.SiteRoot() + f.Input.ReturnURL), nil
+	}
+
+	return forms.FormSaveRedirect(links.DefaultAuthorizedHome()), nil
+}
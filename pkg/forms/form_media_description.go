@@ -0,0 +1,65 @@
+package forms
+
+import (
+	"context"
+
+	"github.com/can3p/gogo/forms"
+	"github.com/can3p/pcom/pkg/forms/validation"
+	"github.com/can3p/pcom/pkg/media"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type MediaDescriptionFormInput struct {
+	AltText string `form:"alt_text"`
+	Caption string `form:"caption"`
+}
+
+type MediaDescriptionForm struct {
+	*forms.FormBase[MediaDescriptionFormInput]
+	User   *core.User
+	Upload *core.MediaUpload
+}
+
+func MediaDescriptionFormNew(u *core.User, upload *core.MediaUpload) *MediaDescriptionForm {
+	form := &MediaDescriptionForm{
+		FormBase: &forms.FormBase[MediaDescriptionFormInput]{
+			Name:                "media_description",
+			FormTemplate:        "form--media-description.html",
+			KeepValuesAfterSave: true,
+			Input: &MediaDescriptionFormInput{
+				AltText: upload.AltText.String,
+				Caption: upload.Caption.String,
+			},
+			ExtraTemplateData: map[string]any{
+				"Upload": upload,
+			},
+		},
+		User:   u,
+		Upload: upload,
+	}
+
+	return form
+}
+
+func (f *MediaDescriptionForm) Validate(c *gin.Context, db boil.ContextExecutor) error {
+	if err := validation.ValidateMinMax("alt text", f.Input.AltText, 0, media.MaxDescriptionLength); err != nil {
+		f.AddError("alt_text", err.Error())
+	}
+
+	if err := validation.ValidateMinMax("caption", f.Input.Caption, 0, media.MaxDescriptionLength); err != nil {
+		f.AddError("caption", err.Error())
+	}
+
+	return f.Errors.PassedValidation()
+}
+
+func (f *MediaDescriptionForm) Save(c context.Context, exec boil.ContextExecutor) (forms.FormSaveAction, error) {
+	if err := media.SetDescription(c, exec, f.User.ID, f.Upload.UploadedFname, f.Input.AltText, f.Input.Caption); err != nil {
+		return nil, errors.Wrapf(err, "failed to save to the db")
+	}
+
+	return f.FormBase.Save(c, exec)
+}
@@ -0,0 +1,122 @@
+package forms
+
+import (
+	"context"
+	"strings"
+
+	"github.com/can3p/gogo/forms"
+	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/auth"
+	"github.com/can3p/pcom/pkg/links"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/pkg/util"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type OIDCLinkFormInput struct {
+	Email    string `form:"email"`
+	Password string `form:"password"`
+}
+
+// OIDCLinkForm links the external identity to the existing account,
+// the password proves that the account belongs to the user
+type OIDCLinkForm struct {
+	*forms.FormBase[OIDCLinkFormInput]
+	Sender sender.Sender
+	Flow   *auth.OIDCFlow
+
+	// the password is only checked in Validate, Save links the user found there
+	user   *core.User
+	rehash bool
+}
+
+func OIDCLinkFormNew(sender sender.Sender, flow *auth.OIDCFlow) *OIDCLinkForm {
+	return &OIDCLinkForm{
+		FormBase: &forms.FormBase[OIDCLinkFormInput]{
+			Name:         "oidc_link",
+			FormTemplate: "form--oidc-link.html",
+			Input:        &OIDCLinkFormInput{},
+		},
+		Sender: sender,
+		Flow:   flow,
+	}
+}
+
+// Throttle shares the limits with the login form, it's the same password check
+func (f *OIDCLinkForm) Throttle(c *gin.Context, db boil.ContextExecutor) error {
+	return auth.CheckAttempts(c, db, auth.AttemptLogin, c.ClientIP(), strings.TrimSpace(f.Input.Email))
+}
+
+func (f *OIDCLinkForm) Validate(c *gin.Context, db boil.ContextExecutor) error {
+	email := strings.TrimSpace(f.Input.Email)
+
+	if email == "" {
+		f.AddError("email", "email is required")
+		return forms.ErrValidationFailed
+	}
+
+	if f.Input.Password == "" {
+		f.AddError("password", "password is required")
+		return forms.ErrValidationFailed
+	}
+
+	user, rehash, err := auth.CheckCredentials(c, db, email, f.Input.Password)
+
+	if errors.Is(err, auth.ErrBadCredentials) {
+		if err := auth.RecordLoginFailure(c, db, f.Sender, c.ClientIP(), email); err != nil {
+			return err
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	f.user = user
+	f.rehash = rehash
+
+	return nil
+}
+
+func (f *OIDCLinkForm) Save(c context.Context, exec boil.ContextExecutor) (forms.FormSaveAction, error) {
+	ginCtx := c.(*gin.Context)
+
+	if f.rehash {
+		if err := auth.UpgradePasswordHash(c, exec, f.user, f.Input.Password); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := auth.LinkIdentity(c, exec, f.user, f.Flow.Identity); err != nil {
+		return nil, err
+	}
+
+	if err := auth.ClearOIDCFlow(ginCtx); err != nil {
+		return nil, err
+	}
+
+	return oidcLoginRedirect(ginCtx, exec, f.user, f.Flow)
+}
+
+// oidcLoginRedirect logs the user in and sends them where the flow
+// has been started from, the second factor is still asked for
+func oidcLoginRedirect(c *gin.Context, exec boil.ContextExecutor, user *core.User, flow *auth.OIDCFlow) (forms.FormSaveAction, error) {
+	err := auth.LoginUser(c, exec, user)
+
+	// the signed return url is passed along to the second step
+	if errors.Is(err, auth.ErrSecondFactorRequired) {
+		return forms.FormSaveRedirect(links.Link("login_two_factor", "return_url", flow.ReturnURL, "sign", flow.Sign)), nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if flow.ReturnURL != "" && auth.HashValue(flow.ReturnURL) == flow.Sign {
+		return forms.FormSaveRedirect(util.SiteRoot() + flow.ReturnURL), nil
+	}
+
+	return forms.FormSaveRedirect(links.DefaultAuthorizedHome()), nil
+}
@@ -0,0 +1,106 @@
+package forms
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/can3p/gogo/forms"
+	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/auth"
+	"github.com/can3p/pcom/pkg/forms/validation"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type OIDCSignupFormInput struct {
+	Username string `form:"username"`
+}
+
+// OIDCSignupForm creates the account for the external identity, it's only
+// allowed with an invite or when the registration is open
+type OIDCSignupForm struct {
+	*forms.FormBase[OIDCSignupFormInput]
+	Sender           sender.Sender
+	Flow             *auth.OIDCFlow
+	Invite           *core.UserInvitation
+	RegistrationOpen bool
+}
+
+func OIDCSignupFormNew(sender sender.Sender, flow *auth.OIDCFlow, invite *core.UserInvitation, registrationOpen bool) *OIDCSignupForm {
+	return &OIDCSignupForm{
+		FormBase: &forms.FormBase[OIDCSignupFormInput]{
+			Name:         "oidc_signup",
+			FormTemplate: "form--oidc-signup.html",
+			Input:        &OIDCSignupFormInput{},
+			ExtraTemplateData: map[string]any{
+				"Email": flow.Identity.Email,
+			},
+		},
+		Sender:           sender,
+		Flow:             flow,
+		Invite:           invite,
+		RegistrationOpen: registrationOpen,
+	}
+}
+
+// Throttle counts every attempt, failed validation included
+func (f *OIDCSignupForm) Throttle(c *gin.Context, db boil.ContextExecutor) error {
+	if err := auth.CheckAttempts(c, db, auth.AttemptSignup, c.ClientIP(), f.Flow.Identity.Email); err != nil {
+		return err
+	}
+
+	return auth.RecordAttempt(c, db, auth.AttemptSignup, c.ClientIP(), f.Flow.Identity.Email, false)
+}
+
+func (f *OIDCSignupForm) Validate(c *gin.Context, db boil.ContextExecutor) error {
+	if f.Invite == nil && !f.RegistrationOpen {
+		return errors.Errorf("The registration is closed, you need an invite to sign up")
+	}
+
+	if !f.Flow.Identity.EmailVerified {
+		return errors.Errorf("Your email has not been verified by the provider, please sign up with the password")
+	}
+
+	if reason, isOK := validation.EmailOKToSignup(c, db, f.Sender, f.Flow.Identity.Email); !isOK {
+		return errors.New(reason)
+	}
+
+	username := strings.TrimSpace(strings.ToLower(f.Input.Username))
+
+	if username == "" {
+		f.AddError("username", "username is required")
+	} else if err := validation.ValidateUsername(username); err != nil {
+		f.AddError("username", err.Error())
+	} else {
+		exists, err := core.Users(core.UserWhere.Username.EQ(username)).Exists(c, db)
+
+		if err != nil {
+			log.Printf("Failed to check username [%s] for duplication: %s", username, err.Error())
+			f.AddError("username", "internal error")
+		} else if exists {
+			f.AddError("username", "this username is not available")
+		}
+	}
+
+	return f.Errors.PassedValidation()
+}
+
+func (f *OIDCSignupForm) Save(c context.Context, exec boil.ContextExecutor) (forms.FormSaveAction, error) {
+	ginCtx := c.(*gin.Context)
+	username := strings.TrimSpace(strings.ToLower(f.Input.Username))
+
+	user, err := auth.SignupWithIdentity(c, exec, f.Sender, f.Flow.Identity, username, f.Invite)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := auth.ClearOIDCFlow(ginCtx); err != nil {
+		return nil, err
+	}
+
+	return oidcLoginRedirect(ginCtx, exec, user, f.Flow)
+}
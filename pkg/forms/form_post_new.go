@@ -3,6 +3,7 @@ package forms
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"strings"
 	"time"
 
@@ -15,12 +16,14 @@ import (
 	"github.com/can3p/pcom/pkg/postops"
 	"github.com/can3p/pcom/pkg/types"
 	"github.com/can3p/pcom/pkg/userops"
+	"github.com/can3p/pcom/pkg/util"
 	"github.com/can3p/pcom/pkg/util/formhelpers"
 	"github.com/can3p/pcom/pkg/util/ginhelpers"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 type PostFormInput struct {
@@ -29,6 +32,10 @@ type PostFormInput struct {
 	Body       string              `form:"body"`
 	Visibility core.PostVisibility `form:"visibility"`
 	SaveAction PostFormAction      `form:"save_action"`
+	// only makes sense when the user asked to be warned about missing alt text
+	IgnoreAltTextWarning bool `form:"ignore_alt_text_warning"`
+	// set when the fields came from the bookmarklet or the share target
+	Prefilled bool `form:"prefilled"`
 }
 
 type PostForm struct {
@@ -38,6 +45,11 @@ type PostForm struct {
 	MediaReplacer types.Replacer[string]
 	Post          *core.Post
 	Prompt        *postops.PostPrompt
+	RSSItem       *core.RSSItem
+	// MissingAltText is filled during the validation of the publish action
+	MissingAltText []string
+	// the rss item was requested but is not in the feed of the user
+	missingRSSItem bool
 }
 
 type PostFormAction string
@@ -54,8 +66,9 @@ const (
 	PostFormActionAutosave  PostFormAction = "autosave"
 )
 
-func NewPostFormNew(ctx context.Context, db boil.ContextExecutor, sender sender.Sender, u *core.User, mediaReplacer types.Replacer[string], promptID string) (*PostForm, error) {
+func NewPostFormNew(ctx context.Context, db boil.ContextExecutor, sender sender.Sender, u *core.User, mediaReplacer types.Replacer[string], promptID string, rssItemID string) (*PostForm, error) {
 	var prompt *postops.PostPrompt
+	var rssItem *core.RSSItem
 	var err error
 
 	if promptID != "" {
@@ -69,6 +82,14 @@ func NewPostFormNew(ctx context.Context, db boil.ContextExecutor, sender sender.
 		}
 	}
 
+	if rssItemID != "" {
+		rssItem, err = postops.GetFeedRSSItem(ctx, db, u.ID, rssItemID)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	form := &PostForm{
 		FormBase: &forms.FormBase[PostFormInput]{
 			Name:                "new_post",
@@ -76,14 +97,17 @@ func NewPostFormNew(ctx context.Context, db boil.ContextExecutor, sender sender.
 			KeepValuesAfterSave: true,
 			Input:               &PostFormInput{},
 			ExtraTemplateData: map[string]any{
-				"User":   u,
-				"Prompt": prompt,
+				"User":    u,
+				"Prompt":  prompt,
+				"RSSItem": rssItem,
 			},
 		},
-		User:          u,
-		Sender:        sender,
-		MediaReplacer: mediaReplacer,
-		Prompt:        prompt,
+		User:           u,
+		Sender:         sender,
+		MediaReplacer:  mediaReplacer,
+		Prompt:         prompt,
+		RSSItem:        rssItem,
+		missingRSSItem: rssItemID != "" && rssItem == nil,
 	}
 
 	return form, nil
@@ -134,6 +158,12 @@ func EditPostFormNew(ctx context.Context, db boil.ContextExecutor, sender sender
 }
 
 func (f *PostForm) Validate(c *gin.Context, db boil.ContextExecutor) error {
+	// the form is rendered without the item, the next save goes through
+	if f.missingRSSItem {
+		f.SetFormError("The feed item is not available anymore, the post will be saved without the link to it")
+		return forms.ErrValidationFailed
+	}
+
 	if err := validation.ValidateMinMax("subject", f.Input.Subject, 0, 100); err != nil {
 		f.AddError("subject", err.Error())
 	}
@@ -164,6 +194,26 @@ func (f *PostForm) Validate(c *gin.Context, db boil.ContextExecutor) error {
 		f.AddError("visibility", err.Error())
 	}
 
+	if saveAction == PostFormActionPublish && f.User.AltTextPolicy != core.AltTextPolicyOff {
+		missing, err := postops.ImagesMissingAltText(c, db, f.Input.Body)
+
+		if err != nil {
+			return err
+		}
+
+		f.MissingAltText = missing
+
+		if len(missing) > 0 {
+			switch {
+			case f.User.AltTextPolicy == core.AltTextPolicyBlock:
+				f.AddError("body", fmt.Sprintf("%s without alt text, describe them before publishing", imagesCount(len(missing))))
+			case !f.Input.IgnoreAltTextWarning:
+				f.AddError("body", fmt.Sprintf("%s without alt text, describe them or confirm publishing as is", imagesCount(len(missing))))
+				f.AddTemplateData("AltTextWarning", true)
+			}
+		}
+	}
+
 	// this sounds like too much, but this way
 	// we put the permission logic into a single place
 	// and do not rely on adhoc queries
@@ -186,6 +236,14 @@ func (f *PostForm) Validate(c *gin.Context, db boil.ContextExecutor) error {
 	return f.Errors.PassedValidation()
 }
 
+func imagesCount(n int) string {
+	if n == 1 {
+		return "1 image is"
+	}
+
+	return fmt.Sprintf("%d images are", n)
+}
+
 func (f *PostForm) Save(c context.Context, exec boil.ContextExecutor) (forms.FormSaveAction, error) {
 	subject := strings.TrimSpace(f.Input.Subject)
 	url := strings.TrimSpace(f.Input.URL)
@@ -197,6 +255,20 @@ func (f *PostForm) Save(c context.Context, exec boil.ContextExecutor) (forms.For
 		saveAction = PostFormActionAutosave
 	}
 
+	// reloading the page or sharing the link twice is common,
+	// the draft made from the same prefill is opened instead
+	if f.Post == nil && f.Input.Prefilled && saveAction != PostFormActionPublish {
+		draftID, err := findPrefilledDraft(c, exec, f.User.ID, f.Input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if draftID != "" {
+			return forms.FormSaveRedirect(links.Link("edit_post", draftID)), nil
+		}
+	}
+
 	if f.Post != nil && f.Input.SaveAction == PostFormActionDelete {
 		err := postops.DeletePost(c, exec, f.Post.ID)
 
@@ -247,6 +319,10 @@ func (f *PostForm) Save(c context.Context, exec boil.ContextExecutor) (forms.For
 
 		post.ID = postID.String()
 
+		if f.RSSItem != nil {
+			post.RSSItemID = null.StringFrom(f.RSSItem.ID)
+		}
+
 		if saveAction == PostFormActionPublish {
 			// not null value means a published post
 			post.PublishedAt = null.TimeFrom(time.Now())
@@ -283,6 +359,7 @@ func (f *PostForm) Save(c context.Context, exec boil.ContextExecutor) (forms.For
 		f.AddTemplateData("LastUpdatedAt", post.UpdatedAt.Time)
 	} else {
 		post.ID = f.Post.ID
+		post.RSSItemID = f.Post.RSSItemID
 
 		switch saveAction {
 		case PostFormActionMakeDraft:
@@ -319,6 +396,14 @@ func (f *PostForm) Save(c context.Context, exec boil.ContextExecutor) (forms.For
 		f.AddTemplateData("LastUpdatedAt", post.UpdatedAt.Time)
 	}
 
+	if err := postops.RequestLinkPreviews(c, exec, post); err != nil {
+		return nil, err
+	}
+
+	if err := postops.SyncPostMediaReferences(c, exec, post); err != nil {
+		return nil, err
+	}
+
 	if sendPublishNotification {
 		if f.Prompt != nil && f.Prompt.Prompt.DismissedAt.IsZero() {
 			dbPrompt := f.Prompt.Prompt
@@ -358,3 +443,56 @@ func (f *PostForm) Save(c context.Context, exec boil.ContextExecutor) (forms.For
 
 	return action, nil
 }
+
+// findPrefilledDraft returns the id of the draft with the same url,
+// or with the same text in case there is no url. Empty id means there is none
+func findPrefilledDraft(ctx context.Context, exec boil.ContextExecutor, userID string, input *PostFormInput) (string, error) {
+	mods := []qm.QueryMod{
+		core.PostWhere.UserID.EQ(userID),
+		core.PostWhere.PublishedAt.IsNull(),
+		qm.OrderBy(fmt.Sprintf("%s DESC", core.PostColumns.CreatedAt)),
+	}
+
+	if rawURL := strings.TrimSpace(input.URL); rawURL != "" {
+		normalizedURL, err := util.NormalizeURL(rawURL)
+
+		// the form is going to complain about it
+		if err != nil {
+			return "", nil
+		}
+
+		storedURL, err := core.NormalizedUrls(
+			core.NormalizedURLWhere.URL.EQ(normalizedURL),
+		).One(ctx, exec)
+
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		mods = append(mods, core.PostWhere.URLID.EQ(null.StringFrom(storedURL.ID)))
+	} else {
+		subject := strings.TrimSpace(input.Subject)
+
+		mods = append(mods,
+			core.PostWhere.URLID.IsNull(),
+			core.PostWhere.Subject.EQ(null.NewString(subject, subject != "")),
+			core.PostWhere.Body.EQ(strings.TrimSpace(input.Body)),
+		)
+	}
+
+	draft, err := core.Posts(mods...).One(ctx, exec)
+
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return draft.ID, nil
+}
@@ -0,0 +1,78 @@
+package forms
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/can3p/gogo/forms"
+	"github.com/can3p/pcom/pkg/auth"
+	"github.com/can3p/pcom/pkg/forms/validation"
+	"github.com/can3p/pcom/pkg/pgsession"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type ResetPasswordFormInput struct {
+	Password string `form:"password"`
+}
+
+type ResetPasswordForm struct {
+	*forms.FormBase[ResetPasswordFormInput]
+	Store pgsession.Store
+	Token string
+}
+
+func ResetPasswordFormNew(store pgsession.Store, token string) forms.Form {
+	var form forms.Form = &ResetPasswordForm{
+		FormBase: &forms.FormBase[ResetPasswordFormInput]{
+			Name:         "reset_password",
+			FormTemplate: "form--reset-password.html",
+			Input:        &ResetPasswordFormInput{},
+			ExtraTemplateData: map[string]any{
+				"Token": token,
+			},
+		},
+		Store: store,
+		Token: token,
+	}
+
+	return form
+}
+
+func (f *ResetPasswordForm) Validate(c *gin.Context, db boil.ContextExecutor) error {
+	if f.Input.Password == "" {
+		f.AddError("password", "password is required")
+		return forms.ErrValidationFailed
+	}
+
+	if err := validation.ValidatePassword(f.Input.Password); err != nil {
+		f.AddError("password", err.Error())
+		return forms.ErrValidationFailed
+	}
+
+	return nil
+}
+
+func (f *ResetPasswordForm) Save(c context.Context, exec boil.ContextExecutor) (forms.FormSaveAction, error) {
+	request, err := auth.LockPasswordReset(c, exec, f.Token)
+
+	// the link has been used while the form was being submitted
+	if errors.Is(err, sql.ErrNoRows) {
+		return func(c *gin.Context, form forms.Form) {
+			form.SetFormError("The link has expired or has already been used")
+			form.RenderForm(c)
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := auth.ResetPassword(c, exec, f.Store, request, f.Input.Password); err != nil {
+		return nil, err
+	}
+
+	return func(c *gin.Context, f forms.Form) {
+		c.HTML(http.StatusOK, "partial--password-reset-done.html", map[string]any{})
+	}, nil
+}
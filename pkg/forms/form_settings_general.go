@@ -15,8 +15,10 @@ import (
 )
 
 type SettingsGeneralFormInput struct {
-	Timezone          string `form:"timezone"`
-	ProfileVisibility string `form:"profile_visibility"`
+	Timezone           string `form:"timezone"`
+	ProfileVisibility  string `form:"profile_visibility"`
+	KeepCameraMetadata bool   `form:"keep_camera_metadata"`
+	AltTextPolicy      string `form:"alt_text_policy"`
 }
 
 type SettingsGeneralForm struct {
@@ -34,6 +36,7 @@ func SettingsGeneralFormNew(u *core.User) *SettingsGeneralForm {
 			ExtraTemplateData: map[string]any{
 				"User":              u,
 				"ProfileVisibility": values.ProfileVisibilityValues,
+				"AltTextPolicy":     values.AltTextPolicyValues,
 			},
 		},
 		User: u,
@@ -65,16 +68,25 @@ func (f *SettingsGeneralForm) Validate(c *gin.Context, db boil.ContextExecutor)
 		return forms.ErrValidationFailed
 	}
 
+	if err := core.AltTextPolicy(f.Input.AltTextPolicy).IsValid(); err != nil {
+		f.AddError("alt_text_policy", fmt.Sprintf("Invalid value [%s]", f.Input.AltTextPolicy))
+		return forms.ErrValidationFailed
+	}
+
 	return nil
 }
 
 func (f *SettingsGeneralForm) Save(c context.Context, exec boil.ContextExecutor) (forms.FormSaveAction, error) {
 	f.User.Timezone = f.Input.Timezone
 	f.User.ProfileVisibility = core.ProfileVisibility(f.Input.ProfileVisibility)
+	f.User.KeepCameraMetadata = f.Input.KeepCameraMetadata
+	f.User.AltTextPolicy = core.AltTextPolicy(f.Input.AltTextPolicy)
 
 	if _, err := f.User.Update(c, exec, boil.Whitelist(
 		core.UserColumns.Timezone,
 		core.UserColumns.ProfileVisibility,
+		core.UserColumns.KeepCameraMetadata,
+		core.UserColumns.AltTextPolicy,
 		core.UserColumns.UpdatedAt,
 	)); err != nil {
 		return nil, errors.Wrapf(err, "failed to save to the db")
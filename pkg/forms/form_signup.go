@@ -28,8 +28,8 @@ type SignupForm struct {
 	Sender sender.Sender
 }
 
-func SignupFormNew(sender sender.Sender) forms.Form {
-	var form forms.Form = &SignupForm{
+func SignupFormNew(sender sender.Sender) *SignupForm {
+	return &SignupForm{
 		FormBase: &forms.FormBase[SignupFormInput]{
 			Name:         "signup",
 			FormTemplate: "form--signup.html",
@@ -37,8 +37,15 @@ func SignupFormNew(sender sender.Sender) forms.Form {
 		},
 		Sender: sender,
 	}
+}
+
+// Throttle counts every attempt, failed validation included
+func (f *SignupForm) Throttle(c *gin.Context, db boil.ContextExecutor) error {
+	if err := auth.CheckAttempts(c, db, auth.AttemptSignup, c.ClientIP(), f.Input.Email); err != nil {
+		return err
+	}
 
-	return form
+	return auth.RecordAttempt(c, db, auth.AttemptSignup, c.ClientIP(), f.Input.Email, false)
 }
 
 func (f *SignupForm) Validate(c *gin.Context, db boil.ContextExecutor) error {
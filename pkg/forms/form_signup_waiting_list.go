@@ -8,6 +8,7 @@ import (
 	"github.com/can3p/gogo/forms"
 	"github.com/can3p/gogo/sender"
 	"github.com/can3p/pcom/pkg/admin"
+	"github.com/can3p/pcom/pkg/auth"
 	"github.com/can3p/pcom/pkg/forms/validation"
 	"github.com/can3p/pcom/pkg/mail"
 	"github.com/can3p/pcom/pkg/model/core"
@@ -28,8 +29,8 @@ type SignupWaitingListForm struct {
 	Sender sender.Sender
 }
 
-func SignupWaitingListFormNew(sender sender.Sender) forms.Form {
-	var form forms.Form = &SignupWaitingListForm{
+func SignupWaitingListFormNew(sender sender.Sender) *SignupWaitingListForm {
+	return &SignupWaitingListForm{
 		FormBase: &forms.FormBase[SignupWaitingListFormInput]{
 			Name:         "signup_waitlist",
 			FormTemplate: "form--signup-waitlist.html",
@@ -37,8 +38,15 @@ func SignupWaitingListFormNew(sender sender.Sender) forms.Form {
 		},
 		Sender: sender,
 	}
+}
+
+// Throttle counts every attempt, failed validation included
+func (f *SignupWaitingListForm) Throttle(c *gin.Context, db boil.ContextExecutor) error {
+	if err := auth.CheckAttempts(c, db, auth.AttemptWaitingList, c.ClientIP(), f.Input.Email); err != nil {
+		return err
+	}
 
-	return form
+	return auth.RecordAttempt(c, db, auth.AttemptWaitingList, c.ClientIP(), f.Input.Email, false)
 }
 
 func (f *SignupWaitingListForm) Validate(c *gin.Context, db boil.ContextExecutor) error {
@@ -0,0 +1,215 @@
+package forms
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/can3p/gogo/forms"
+	"github.com/can3p/pcom/pkg/auth"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/pquerna/otp"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type EnableTwoFactorFormInput struct {
+	KeyURL string `form:"key_url"`
+	Sign   string `form:"sign"`
+	Code   string `form:"code"`
+}
+
+// EnableTwoFactorForm carries the freshly generated key in the form itself,
+// the signature makes sure it's the one the server has generated
+type EnableTwoFactorForm struct {
+	*forms.FormBase[EnableTwoFactorFormInput]
+	User *core.User
+	key  *otp.Key
+}
+
+func EnableTwoFactorFormNew(u *core.User) *EnableTwoFactorForm {
+	return &EnableTwoFactorForm{
+		FormBase: &forms.FormBase[EnableTwoFactorFormInput]{
+			Name:              "enable_two_factor",
+			FormTemplate:      "form--settings-enable-two-factor.html",
+			Input:             &EnableTwoFactorFormInput{},
+			ExtraTemplateData: map[string]any{},
+		},
+		User: u,
+	}
+}
+
+// WithNewKey prepares the form to be shown for the first time
+func (f *EnableTwoFactorForm) WithNewKey() (*EnableTwoFactorForm, error) {
+	key, err := auth.NewTOTPKey(f.User)
+
+	if err != nil {
+		return nil, err
+	}
+
+	f.Input.KeyURL = key.URL()
+	f.Input.Sign = f.sign(f.Input.KeyURL)
+
+	return f, f.setKey(key)
+}
+
+func (f *EnableTwoFactorForm) sign(keyURL string) string {
+	return auth.HashValue(f.User.ID + ":" + keyURL)
+}
+
+func (f *EnableTwoFactorForm) setKey(key *otp.Key) error {
+	qrCode, err := auth.TOTPQRCode(key)
+
+	if err != nil {
+		return err
+	}
+
+	f.key = key
+	f.AddTemplateData("QRCode", qrCode)
+	f.AddTemplateData("Secret", key.Secret())
+
+	return nil
+}
+
+func (f *EnableTwoFactorForm) Validate(c *gin.Context, db boil.ContextExecutor) error {
+	if auth.HasTOTP(f.User) {
+		return errors.Errorf("Two-factor authentication is enabled already")
+	}
+
+	if f.Input.KeyURL == "" || f.sign(f.Input.KeyURL) != f.Input.Sign {
+		return errors.Errorf("The form is broken, please reload the page")
+	}
+
+	key, err := otp.NewKeyFromURL(f.Input.KeyURL)
+
+	if err != nil {
+		return errors.Errorf("The form is broken, please reload the page")
+	}
+
+	if err := f.setKey(key); err != nil {
+		return err
+	}
+
+	if f.Input.Code == "" {
+		f.AddError("code", "code is required")
+		return forms.ErrValidationFailed
+	}
+
+	if !auth.ValidateTOTPCode(key.Secret(), f.Input.Code) {
+		f.AddError("code", "code is not correct, please check the time on your device")
+		return forms.ErrValidationFailed
+	}
+
+	return nil
+}
+
+func (f *EnableTwoFactorForm) Save(c context.Context, exec boil.ContextExecutor) (forms.FormSaveAction, error) {
+	codes, err := auth.EnableTwoFactor(c, exec, f.User, f.key.Secret())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return renderRecoveryCodes(codes), nil
+}
+
+const (
+	TwoFactorActionDisable    = "disable"
+	TwoFactorActionRegenerate = "regenerate_codes"
+)
+
+type ManageTwoFactorFormInput struct {
+	Action string `form:"action"`
+	Code   string `form:"code"`
+}
+
+// ManageTwoFactorForm asks for the code before anything
+// can be changed about the second factor
+type ManageTwoFactorForm struct {
+	*forms.FormBase[ManageTwoFactorFormInput]
+	User *core.User
+}
+
+func ManageTwoFactorFormNew(u *core.User) *ManageTwoFactorForm {
+	return &ManageTwoFactorForm{
+		FormBase: &forms.FormBase[ManageTwoFactorFormInput]{
+			Name:         "manage_two_factor",
+			FormTemplate: "form--settings-manage-two-factor.html",
+			Input:        &ManageTwoFactorFormInput{},
+		},
+		User: u,
+	}
+}
+
+func (f *ManageTwoFactorForm) Validate(c *gin.Context, db boil.ContextExecutor) error {
+	if !auth.HasTOTP(f.User) {
+		return errors.Errorf("Two-factor authentication is not enabled")
+	}
+
+	switch f.Input.Action {
+	case TwoFactorActionRegenerate:
+	case TwoFactorActionDisable:
+		required, err := auth.TwoFactorRequired(c, db)
+
+		if err != nil {
+			return err
+		}
+
+		hasPasskeys, err := auth.HasPasskeys(c, db, f.User)
+
+		if err != nil {
+			return err
+		}
+
+		if required && !hasPasskeys {
+			return errors.Errorf("Two-factor authentication is required for everyone and cannot be disabled")
+		}
+	default:
+		return errors.Errorf("Unknown action")
+	}
+
+	if f.Input.Code == "" {
+		f.AddError("code", "code is required")
+		return forms.ErrValidationFailed
+	}
+
+	ok, err := auth.VerifySecondFactor(c, db, f.User, f.Input.Code)
+
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		f.AddError("code", "code is not correct")
+		return forms.ErrValidationFailed
+	}
+
+	return nil
+}
+
+func (f *ManageTwoFactorForm) Save(c context.Context, exec boil.ContextExecutor) (forms.FormSaveAction, error) {
+	if f.Input.Action == TwoFactorActionDisable {
+		if err := auth.DisableTwoFactor(c, exec, f.User); err != nil {
+			return nil, err
+		}
+
+		return forms.FormSaveFullReload, nil
+	}
+
+	codes, err := auth.RegenerateRecoveryCodes(c, exec, f.User)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return renderRecoveryCodes(codes), nil
+}
+
+// the codes are shown only once, they are stored as hashes
+func renderRecoveryCodes(codes []string) forms.FormSaveAction {
+	return func(c *gin.Context, f forms.Form) {
+		c.HTML(http.StatusOK, "partial--two-factor-recovery-codes.html", map[string]any{
+			"Codes": codes,
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package forms
+
+import (
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/can3p/gogo/forms"
+	"github.com/can3p/pcom/pkg/auth"
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// throttledForm checks the rate limits before the validation,
+// there is no point to even look at the input of the throttled request
+type throttledForm interface {
+	forms.Form
+	Throttle(c *gin.Context, exec boil.ContextExecutor) error
+}
+
+// ThrottledHandler is the default handler that responds with 429
+// and the form showing the error once the limits have been hit
+func ThrottledHandler(c *gin.Context, db *sqlx.DB, form throttledForm) {
+	if err := form.ShouldBind(c); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"explanation": "Failed to process request", "err": err.Error()})
+		return
+	}
+
+	var throttled *auth.ThrottledError
+
+	if err := form.Throttle(c, db); errors.As(err, &throttled) {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(throttled.RetryAfter.Seconds()))))
+		c.Writer = &statusWriter{ResponseWriter: c.Writer, status: http.StatusTooManyRequests}
+
+		form.SetFormError(throttled.Error())
+		form.RenderForm(c)
+		return
+	} else if err != nil {
+		slog.Error("Failed to check rate limits", "name", form.FormName(), "err", err.Error())
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
+	forms.DefaultHandler(c, db, form)
+}
+
+// statusWriter makes the form rendering respond with the status of our choice
+type statusWriter struct {
+	gin.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(int) {
+	w.ResponseWriter.WriteHeader(w.status)
+}
@@ -14,3 +14,36 @@ var ProfileVisibilityValues = ValueList{
 	{Label: "Direct and indirect connections", Value: string(core.ProfileVisibilityConnections)},
 	{Label: "Public", Value: string(core.ProfileVisibilityPublic)},
 }
+
+var FeedRuleFieldValues = ValueList{
+	{Label: "Title", Value: string(core.FeedRuleFieldTitle)},
+	{Label: "Description", Value: string(core.FeedRuleFieldDescription)},
+	{Label: "Link host", Value: string(core.FeedRuleFieldHost)},
+}
+
+var FeedRuleMatchTypeValues = ValueList{
+	{Label: "Contains keyword", Value: string(core.FeedRuleMatchTypeKeyword)},
+	{Label: "Matches regex", Value: string(core.FeedRuleMatchTypeRegex)},
+}
+
+var FeedRuleActionValues = ValueList{
+	{Label: "Dismiss", Value: string(core.FeedRuleActionDismiss)},
+	{Label: "Highlight", Value: string(core.FeedRuleActionHighlight)},
+	{Label: "Create a draft post", Value: string(core.FeedRuleActionDraftPost)},
+}
+
+var AltTextPolicyValues = ValueList{
+	{Label: "Do nothing", Value: string(core.AltTextPolicyOff)},
+	{Label: "Warn before publishing", Value: string(core.AltTextPolicyWarn)},
+	{Label: "Do not allow publishing", Value: string(core.AltTextPolicyBlock)},
+}
+
+func (l ValueList) Label(value string) string {
+	for _, v := range l {
+		if v.Value == value {
+			return v.Label
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,85 @@
+package linkpreview
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/pkg/types"
+	"github.com/can3p/pcom/pkg/util"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	cacheSize = 4096
+	// previews appear in the background, missing ones should not be cached forever
+	cacheTTL = 5 * time.Minute
+)
+
+var cache = expirable.NewLRU[string, *types.LinkPreview](cacheSize, nil, cacheTTL)
+
+// Getter returns the previews that are fetched already,
+// it never goes to the network by itself
+func Getter(ctx context.Context, exec boil.ContextExecutor) types.LinkPreviewGetter {
+	return func(rawURL string) (types.LinkPreview, bool) {
+		normalized, err := util.NormalizeURL(rawURL)
+
+		if err != nil {
+			return types.LinkPreview{}, false
+		}
+
+		preview, ok := cache.Get(normalized)
+
+		if !ok {
+			preview, err = loadPreview(ctx, exec, normalized)
+
+			if err != nil {
+				slog.Warn("Failed to load link preview", "url", normalized, "err", err.Error())
+				return types.LinkPreview{}, false
+			}
+
+			cache.Add(normalized, preview)
+		}
+
+		if preview == nil {
+			return types.LinkPreview{}, false
+		}
+
+		return *preview, true
+	}
+}
+
+func loadPreview(ctx context.Context, exec boil.ContextExecutor, normalized string) (*types.LinkPreview, error) {
+	preview, err := core.LinkPreviews(
+		qm.InnerJoin(fmt.Sprintf("%s on %s = %s",
+			core.TableNames.NormalizedUrls,
+			core.NormalizedURLTableColumns.ID,
+			core.LinkPreviewTableColumns.URLID,
+		)),
+		core.NormalizedURLWhere.URL.EQ(normalized),
+		core.LinkPreviewWhere.Status.EQ(core.LinkPreviewStatusReady),
+	).One(ctx, exec)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.LinkPreview{
+		URL:         normalized,
+		Title:       preview.Title.String,
+		Description: preview.Description.String,
+		SiteName:    preview.SiteName.String,
+		Image:       preview.ImageFname.String,
+		PlayerURL:   preview.PlayerURL.String,
+	}, nil
+}
@@ -0,0 +1,284 @@
+package linkpreview
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"github.com/can3p/gogo/util/transact"
+	"github.com/can3p/pcom/pkg/feedops/reader"
+	"github.com/can3p/pcom/pkg/media"
+	"github.com/can3p/pcom/pkg/media/server"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	fetchEvery       = 10 * time.Second
+	fetchBatchSize   = 20
+	fetchTimeout     = 30 * time.Second
+	maxTitleLength   = 300
+	maxTextLength    = 1000
+	maxSiteLength    = 100
+	maxPlayerURLSize = 2000
+)
+
+// the claim outlives the fetch of the page along with the image,
+// anything older belongs to an instance that has died halfway
+const fetchClaimTTL = 10 * time.Minute
+
+type fetcher interface {
+	FetchPreview(ctx context.Context, pageURL string) (*reader.Preview, error)
+	FetchMedia(ctx context.Context, mediaURL string) (io.ReadCloser, error)
+}
+
+type Previewer struct {
+	db           *sqlx.DB
+	fetcher      fetcher
+	mediaStorage server.MediaStorage
+}
+
+func NewPreviewer(db *sqlx.DB, fetcher fetcher, mediaStorage server.MediaStorage) *Previewer {
+	return &Previewer{
+		db:           db,
+		fetcher:      fetcher,
+		mediaStorage: mediaStorage,
+	}
+}
+
+func DefaultPreviewer(db *sqlx.DB, mediaStorage server.MediaStorage) *Previewer {
+	httpClient := &http.Client{
+		Timeout: 10 * time.Second,
+	}
+
+	return NewPreviewer(db, reader.NewFetcher(httpClient), mediaStorage)
+}
+
+func (p *Previewer) Run(ctx context.Context) {
+	ticker := time.NewTicker(fetchEvery)
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := p.processPending(ctx); err != nil {
+				slog.Warn("Failed to fetch link previews", "err", err.Error())
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (p *Previewer) processPending(ctx context.Context) (err error) {
+	// a single broken page should never crash the scheduler
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = fmt.Errorf("processPending panicked: %v - %s", panicErr, string(debug.Stack()))
+		}
+	}()
+
+	pending, err := core.LinkPreviews(
+		claimablePreviews(time.Now()),
+		qm.OrderBy(core.LinkPreviewColumns.CreatedAt),
+		qm.Limit(fetchBatchSize),
+	).All(ctx, p.db)
+
+	if err != nil {
+		return err
+	}
+
+	// the preview is claimed and stored in short transactions, nothing
+	// is locked while the page is fetched
+	for _, pp := range pending {
+		preview, err := claimPreview(ctx, p.db, pp.ID, time.Now())
+
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		} else if err != nil {
+			slog.Warn("Failed to claim the link preview", "url_id", pp.URLID, "err", err.Error())
+			continue
+		}
+
+		if err := p.process(ctx, preview); err != nil {
+			slog.Warn("Failed to fetch the link preview", "url_id", pp.URLID, "err", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// claimablePreviews matches the pending previews that nobody works on
+// along with the ones claimed by the instances that have died halfway
+func claimablePreviews(now time.Time) qm.QueryMod {
+	return qm.Expr(
+		core.LinkPreviewWhere.Status.EQ(core.LinkPreviewStatusPending),
+		qm.Expr(
+			core.LinkPreviewWhere.ClaimedAt.IsNull(),
+			qm.Or2(core.LinkPreviewWhere.ClaimedAt.LT(null.TimeFrom(now.Add(-fetchClaimTTL)))),
+		),
+	)
+}
+
+// claimPreview marks the preview as being fetched, sql.ErrNoRows means
+// that somebody else has got it first
+func claimPreview(ctx context.Context, db *sqlx.DB, id string, now time.Time) (*core.LinkPreview, error) {
+	var preview *core.LinkPreview
+
+	err := transact.Transact(db, func(tx *sql.Tx) error {
+		var err error
+
+		preview, err = core.LinkPreviews(
+			core.LinkPreviewWhere.ID.EQ(id),
+			claimablePreviews(now),
+			qm.Load(core.LinkPreviewRels.URL),
+			qm.For("UPDATE SKIP LOCKED"),
+		).One(ctx, tx)
+
+		if err != nil {
+			return err
+		}
+
+		// postgres keeps microseconds, the claim has to match when the preview is stored
+		preview.ClaimedAt = null.TimeFrom(now.Truncate(time.Microsecond))
+
+		_, err = preview.Update(ctx, tx, boil.Whitelist(
+			core.LinkPreviewColumns.ClaimedAt,
+			core.LinkPreviewColumns.UpdatedAt,
+		))
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return preview, nil
+}
+
+// process fetches the preview once, failures are stored
+// and are never retried, most of them are permanent anyway
+func (p *Previewer) process(ctx context.Context, preview *core.LinkPreview) error {
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+
+	pageURL := preview.R.URL.URL
+
+	fetched, err := p.fetcher.FetchPreview(ctx, pageURL)
+
+	preview.FetchedAt = null.TimeFrom(time.Now())
+
+	if err != nil {
+		preview.Status = core.LinkPreviewStatusFailed
+		preview.FetchError = null.StringFrom(truncate(err.Error(), maxTextLength))
+	} else {
+		preview.Status = core.LinkPreviewStatusReady
+		preview.Title = nullString(fetched.Title, maxTitleLength)
+		preview.Description = nullString(fetched.Description, maxTextLength)
+		preview.SiteName = nullString(fetched.SiteName, maxSiteLength)
+
+		// a truncated url is useless
+		if len(fetched.PlayerURL) <= maxPlayerURLSize {
+			preview.PlayerURL = nullString(fetched.PlayerURL, maxPlayerURLSize)
+		}
+
+		if fetched.ImageURL != "" {
+			fname, err := p.rehostImage(ctx, preview.URLID, fetched.ImageURL)
+
+			// the preview is good enough without the image
+			if err != nil {
+				slog.Warn("Failed to rehost the preview image", "url", pageURL, "image", fetched.ImageURL, "err", err.Error())
+			} else {
+				preview.ImageFname = null.StringFrom(fname)
+			}
+		}
+	}
+
+	return storePreview(ctx, p.db, preview)
+}
+
+// storePreview stores the outcome and releases the claim. Nothing is stored
+// in case the claim has gone stale and the preview has been claimed again
+func storePreview(ctx context.Context, exec boil.ContextExecutor, preview *core.LinkPreview) error {
+	updated, err := core.LinkPreviews(
+		core.LinkPreviewWhere.ID.EQ(preview.ID),
+		core.LinkPreviewWhere.Status.EQ(core.LinkPreviewStatusPending),
+		core.LinkPreviewWhere.ClaimedAt.EQ(preview.ClaimedAt),
+	).UpdateAll(ctx, exec, core.M{
+		core.LinkPreviewColumns.Status:      preview.Status,
+		core.LinkPreviewColumns.Title:       preview.Title,
+		core.LinkPreviewColumns.Description: preview.Description,
+		core.LinkPreviewColumns.SiteName:    preview.SiteName,
+		core.LinkPreviewColumns.ImageFname:  preview.ImageFname,
+		core.LinkPreviewColumns.PlayerURL:   preview.PlayerURL,
+		core.LinkPreviewColumns.FetchError:  preview.FetchError,
+		core.LinkPreviewColumns.FetchedAt:   preview.FetchedAt,
+		core.LinkPreviewColumns.ClaimedAt:   nil,
+		core.LinkPreviewColumns.UpdatedAt:   time.Now(),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if updated == 0 {
+		slog.Warn("The claim of the link preview has gone stale before it was stored", "url_id", preview.URLID)
+	}
+
+	return nil
+}
+
+// rehostImage uses a transaction of its own, the error is ignored by
+// the caller and a failed upload should not leave its row behind.
+// The image is downloaded before the transaction is opened
+func (p *Previewer) rehostImage(ctx context.Context, urlID string, imageURL string) (string, error) {
+	image, err := p.fetcher.FetchMedia(ctx, imageURL)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer func() { _ = image.Close() }()
+
+	data, err := io.ReadAll(image)
+
+	if err != nil {
+		return "", errors.Wrap(err, "failed to download the image")
+	}
+
+	var fname string
+
+	err = transact.Transact(p.db, func(tx *sql.Tx) error {
+		fname, err = media.HandleUpload(ctx, tx, p.mediaStorage, nil, nil, &urlID, bytes.NewReader(data))
+
+		return err
+	})
+
+	return fname, err
+}
+
+func nullString(s string, maxLength int) null.String {
+	s = truncate(s, maxLength)
+
+	return null.NewString(s, s != "")
+}
+
+func truncate(s string, maxLength int) string {
+	runes := []rune(s)
+
+	if len(runes) <= maxLength {
+		return s
+	}
+
+	return string(runes[:maxLength-1]) + "…"
+}
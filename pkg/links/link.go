@@ -1,6 +1,8 @@
 package links
 
 import (
+	"fmt"
+	"html/template"
 	"os"
 
 	"github.com/can3p/gogo/links"
@@ -24,6 +26,10 @@ func Link(name string, args ...string) string {
 		out = "/controls"
 	case "settings":
 		out = "/controls/settings"
+	case "security":
+		out = "/controls/settings/security"
+	case "media_library":
+		out = "/controls/media"
 	case "write":
 		out = "/write"
 	case "feed":
@@ -64,24 +70,56 @@ func Link(name string, args ...string) string {
 		out = "/form/accept_invite/" + builder.Shift()
 	case "form_login":
 		out = "/form/login"
+	case "form_login_two_factor":
+		out = "/form/login_two_factor"
+	case "form_passkey":
+		out = "/form/passkey/" + builder.Shift()
+	case "form_login_email":
+		out = "/form/login_email"
+	case "form_login_link":
+		out = "/form/login_link/" + builder.Shift()
+	case "form_confirm_email_change":
+		out = "/form/confirm_email_change/" + builder.Shift()
+	case "form_oidc_link":
+		out = "/form/oidc_link"
+	case "form_oidc_signup":
+		out = "/form/oidc_signup"
+	case "form_forgot_password":
+		out = "/form/forgot_password"
+	case "form_reset_password":
+		out = "/form/reset_password/" + builder.Shift()
 	case "confirm_waiting_list":
 		out = "/confirm_waiting_list/" + builder.Shift()
 	case "confirm_signup":
 		out = "/confirm_signup/" + builder.Shift()
+	case "confirm_email_change":
+		out = "/confirm_email_change/" + builder.Shift()
 	case "form_edit_post":
 		out = "/controls/form/edit_post"
 	case "form_new_comment":
 		out = "/controls/form/new_comment"
 	case "form_save_settings":
 		out = "/controls/form/save_settings"
+	case "form_media_description":
+		out = "/controls/form/media_description"
 	case "form_user_styles":
 		out = "/controls/form/save_user_styles"
 	case "form_add_user_feed":
 		out = "/controls/form/add_user_feed"
+	case "form_add_feed_rule":
+		out = "/controls/form/add_feed_rule"
 	case "form_send_invite":
 		out = "/controls/form/send_invite"
 	case "form_change_password":
 		out = "/controls/form/change_password"
+	case "form_change_email":
+		out = "/controls/form/change_email"
+	case "form_delete_account":
+		out = "/controls/form/delete_account"
+	case "form_enable_two_factor":
+		out = "/controls/form/enable_two_factor"
+	case "form_manage_two_factor":
+		out = "/controls/form/manage_two_factor"
 	case "form_whitelist_connection":
 		out = "/controls/form/whitelist_connection"
 	case "form_prompt_post":
@@ -90,12 +128,35 @@ func Link(name string, args ...string) string {
 		out = "/controls/action/" + builder.Shift()
 	case "uploaded_media":
 		out = "/user-media/" + builder.Shift()
+	case "signed_media":
+		expires := builder.Shift()
+		signature := builder.Shift()
+
+		out = "/signed-media/" + expires + "/" + signature + "/" + builder.Shift()
 	case "public_blog_feed":
 		out = "/rss/public/" + builder.Shift()
 	case "private_user_feed":
 		out = "/rss/private/" + builder.Shift()
+	case "websub_callback":
+		out = "/websub/" + builder.Shift()
 	case "login":
 		out = "/login"
+	case "login_two_factor":
+		out = "/login/two_factor"
+	case "login_email":
+		out = "/login/email"
+	case "login_link":
+		out = "/login/email/" + builder.Shift()
+	case "oidc_login":
+		out = "/login/oidc"
+	case "oidc_callback":
+		out = "/login/oidc/callback"
+	case "oidc_finish":
+		out = "/login/oidc/finish"
+	case "forgot_password":
+		out = "/forgot_password"
+	case "reset_password":
+		out = "/reset_password/" + builder.Shift()
 	case "signup":
 		out = "/signup"
 	}
@@ -118,3 +179,14 @@ func AbsLink(name string, args ...string) string {
 
 	return util.SiteRoot() + Link(name, args...)
 }
+
+// Bookmarklet opens the write page prefilled with the
+// title, the url and the selected text of the current page
+func Bookmarklet() template.URL {
+	js := fmt.Sprintf(
+		`javascript:(function(){location.href=%q+'?title='+encodeURIComponent(document.title)+'&url='+encodeURIComponent(location.href)+'&body='+encodeURIComponent(String(window.getSelection()))})()`,
+		AbsLink("write"),
+	)
+
+	return template.URL(js)
+}
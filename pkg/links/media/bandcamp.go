@@ -0,0 +1,65 @@
+package media
+
+import (
+	"fmt"
+	"html/template"
+	"regexp"
+	"strings"
+)
+
+var bandcampRE *regexp.Regexp = regexp.MustCompile(`^https://([a-z0-9-]+)\.bandcamp\.com/(album|track)/([\w-]+)/?$`)
+
+// only the players served by bandcamp itself are embedded
+const bandcampPlayerPrefix = "https://bandcamp.com/EmbeddedPlayer/"
+
+type BandcampLink struct {
+	Artist string
+	Kind   string
+	Slug   string
+	Player string
+}
+
+func (l *BandcampLink) Key() string {
+	return fmt.Sprintf("bandcamp: %s/%s/%s", l.Artist, l.Kind, l.Slug)
+}
+
+func (l *BandcampLink) EmbedCode() template.HTML {
+	return template.HTML(
+		fmt.Sprintf(`<iframe class="audio-embed" height="120" src="%s" seamless loading="lazy"></iframe>`, template.HTMLEscapeString(l.Player)),
+	)
+}
+
+func (l *BandcampLink) URL() string {
+	return fmt.Sprintf(`https://%s.bandcamp.com/%s/%s`, l.Artist, l.Kind, l.Slug)
+}
+
+// BandcampParser needs to know the player, since the link has
+// no id of the album or the track, the page itself has to be fetched
+type BandcampParser struct {
+	Players PlayerLookup
+}
+
+func (p *BandcampParser) Parse(url string) MediaLink {
+	if p.Players == nil {
+		return nil
+	}
+
+	groups := bandcampRE.FindStringSubmatch(url)
+
+	if len(groups) != 4 {
+		return nil
+	}
+
+	player := p.Players(url)
+
+	if !strings.HasPrefix(player, bandcampPlayerPrefix) {
+		return nil
+	}
+
+	return &BandcampLink{
+		Artist: groups[1],
+		Kind:   groups[2],
+		Slug:   groups[3],
+		Player: player,
+	}
+}
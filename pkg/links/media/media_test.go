@@ -0,0 +1,102 @@
+package media
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultParser(t *testing.T) {
+	players := func(pageURL string) string {
+		switch pageURL {
+		case "https://band.bandcamp.com/album/first":
+			return "https://bandcamp.com/EmbeddedPlayer/v=2/album=123/size=large/"
+		case "https://band.bandcamp.com/track/elsewhere":
+			return "https://evil.example.com/player"
+		case "https://framatube.org/w/9c9de5e8-0a1e-484a-b099-e80766180a6d":
+			return "https://framatube.org/videos/embed/9c9de5e8-0a1e-484a-b099-e80766180a6d"
+		case "https://framatube.org/w/kkGMgK9ZtnKfYAgnEtQxbv":
+			return "https://phishing.example.com/videos/embed/kkGMgK9ZtnKfYAgnEtQxbv"
+		case "https://phishing.example.com/w/9c9de5e8-0a1e-484a-b099-e80766180a6d":
+			return "https://phishing.example.com/videos/embed/9c9de5e8-0a1e-484a-b099-e80766180a6d"
+		}
+
+		return ""
+	}
+
+	examples := []struct {
+		url   string
+		key   string
+		embed string
+	}{
+		{
+			url:   "https://www.youtube.com/watch?v=J2dQCd_kzkA",
+			key:   "youtube: J2dQCd_kzkA",
+			embed: `<lite-youtube videoid="J2dQCd_kzkA" playlabel="Play Video" nocookie></lite-youtube>`,
+		},
+		{
+			url:   "https://vimeo.com/76979871",
+			key:   "vimeo: 76979871",
+			embed: `<iframe class="video-embed" src="https://player.vimeo.com/video/76979871?dnt=1" allow="fullscreen; picture-in-picture" allowfullscreen loading="lazy"></iframe>`,
+		},
+		{
+			url:   "https://vimeo.com/76979871/8272103f6e",
+			key:   "vimeo: 76979871",
+			embed: `<iframe class="video-embed" src="https://player.vimeo.com/video/76979871?dnt=1&amp;h=8272103f6e" allow="fullscreen; picture-in-picture" allowfullscreen loading="lazy"></iframe>`,
+		},
+		{
+			url:   "https://framatube.org/w/9c9de5e8-0a1e-484a-b099-e80766180a6d",
+			key:   "peertube: framatube.org/9c9de5e8-0a1e-484a-b099-e80766180a6d",
+			embed: `<iframe class="video-embed" src="https://framatube.org/videos/embed/9c9de5e8-0a1e-484a-b099-e80766180a6d" sandbox="allow-same-origin allow-scripts allow-popups" allowfullscreen loading="lazy"></iframe>`,
+		},
+		{
+			url:   "https://soundcloud.com/artist/song-name",
+			key:   "soundcloud: artist/song-name",
+			embed: `<iframe class="audio-embed" height="166" allow="autoplay" src="https://w.soundcloud.com/player/?url=https%3A%2F%2Fsoundcloud.com%2Fartist%2Fsong-name&amp;visual=false" loading="lazy"></iframe>`,
+		},
+		{
+			url:   "https://band.bandcamp.com/album/first",
+			key:   "bandcamp: band/album/first",
+			embed: `<iframe class="audio-embed" height="120" src="https://bandcamp.com/EmbeddedPlayer/v=2/album=123/size=large/" seamless loading="lazy"></iframe>`,
+		},
+		{
+			url: "https://band.bandcamp.com/track/unknown",
+		},
+		{
+			url: "https://band.bandcamp.com/track/elsewhere",
+		},
+		{
+			url: "https://example.com/w/9c9de5e8",
+		},
+		{
+			// the page has not confirmed the player yet
+			url: "https://framatube.org/w/9c9de5e8-0a1e-484a-b099-e80766180a6e",
+		},
+		{
+			// the player lives elsewhere
+			url: "https://framatube.org/w/kkGMgK9ZtnKfYAgnEtQxbv",
+		},
+		{
+			// the instance is not on the list
+			url: "https://phishing.example.com/w/9c9de5e8-0a1e-484a-b099-e80766180a6d",
+		},
+	}
+
+	t.Setenv("PEERTUBE_HOSTS", "framatube.org, peertube.example.com")
+
+	parser := DefaultParser(players)
+
+	for idx, ex := range examples {
+		link := parser.Parse(ex.url)
+
+		if ex.key == "" {
+			assert.Nil(t, link, "[ex %d]", idx+1)
+			continue
+		}
+
+		if assert.NotNil(t, link, "[ex %d]", idx+1) {
+			assert.Equal(t, ex.key, link.Key(), "[ex %d]", idx+1)
+			assert.Equal(t, ex.embed, string(link.EmbedCode()), "[ex %d]", idx+1)
+		}
+	}
+}
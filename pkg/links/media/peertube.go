@@ -0,0 +1,73 @@
+package media
+
+import (
+	"fmt"
+	"html/template"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// peertube can be hosted anywhere, the path is the only thing in common.
+// Ids are either uuids or their short form
+var peertubeRE *regexp.Regexp = regexp.MustCompile(`^https://([a-z0-9.-]+\.[a-z]{2,})/(?:w|videos/watch)/([0-9a-zA-Z]{22}|[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})/?$`)
+
+// PeerTubeHosts returns the instances the admins trust enough to embed
+// their players, the list is space or comma separated
+func PeerTubeHosts() []string {
+	return strings.FieldsFunc(strings.ToLower(os.Getenv("PEERTUBE_HOSTS")), func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+}
+
+type PeerTubeLink struct {
+	Host   string
+	ID     string
+	Player string
+}
+
+func (l *PeerTubeLink) Key() string {
+	return fmt.Sprintf("peertube: %s/%s", l.Host, l.ID)
+}
+
+func (l *PeerTubeLink) EmbedCode() template.HTML {
+	return template.HTML(
+		fmt.Sprintf(`<iframe class="video-embed" src="%s" sandbox="allow-same-origin allow-scripts allow-popups" allowfullscreen loading="lazy"></iframe>`, template.HTMLEscapeString(l.Player)),
+	)
+}
+
+func (l *PeerTubeLink) URL() string {
+	return fmt.Sprintf(`https://%s/w/%s`, l.Host, l.ID)
+}
+
+// PeerTubeParser only embeds the players of the known instances, and only
+// once the page itself has confirmed that it's a peertube video
+type PeerTubeParser struct {
+	Hosts   []string
+	Players PlayerLookup
+}
+
+func (p *PeerTubeParser) Parse(url string) MediaLink {
+	if p.Players == nil {
+		return nil
+	}
+
+	groups := peertubeRE.FindStringSubmatch(url)
+
+	if len(groups) != 3 || !slices.Contains(p.Hosts, groups[1]) {
+		return nil
+	}
+
+	player := p.Players(url)
+
+	if !strings.HasPrefix(player, "https://"+groups[1]+"/videos/embed/") {
+		return nil
+	}
+
+	return &PeerTubeLink{
+		Host:   groups[1],
+		ID:     groups[2],
+		Player: player,
+	}
+}
@@ -0,0 +1,45 @@
+package media
+
+import (
+	"fmt"
+	"html/template"
+	"net/url"
+	"regexp"
+)
+
+var soundcloudRE *regexp.Regexp = regexp.MustCompile(`^https://(?:www\.|m\.)?soundcloud\.com/([\w-]+)/((?:sets/)?[\w-]+)/?$`)
+
+type SoundCloudLink struct {
+	Artist string
+	Track  string
+}
+
+func (l *SoundCloudLink) Key() string {
+	return fmt.Sprintf("soundcloud: %s/%s", l.Artist, l.Track)
+}
+
+func (l *SoundCloudLink) EmbedCode() template.HTML {
+	// soundcloud widget accepts the page url as is
+	return template.HTML(
+		fmt.Sprintf(`<iframe class="audio-embed" height="166" allow="autoplay" src="https://w.soundcloud.com/player/?url=%s&amp;visual=false" loading="lazy"></iframe>`, url.QueryEscape(l.URL())),
+	)
+}
+
+func (l *SoundCloudLink) URL() string {
+	return fmt.Sprintf(`https://soundcloud.com/%s/%s`, l.Artist, l.Track)
+}
+
+type SoundCloudParser struct {
+}
+
+func (p *SoundCloudParser) Parse(url string) MediaLink {
+	groups := soundcloudRE.FindStringSubmatch(url)
+
+	if len(groups) == 3 {
+		return &SoundCloudLink{
+			Artist: groups[1],
+			Track:  groups[2],
+		}
+	}
+	return nil
+}
@@ -44,10 +44,21 @@ func (p *AggregateParser) Parse(url string) MediaLink {
 	return nil
 }
 
-func DefaultParser() MediaParser {
+// PlayerLookup returns the embeddable player the page advertises,
+// empty string means the page is unknown or has no player
+type PlayerLookup func(pageURL string) string
+
+// DefaultParser returns the parser for all the supported providers.
+// players is optional and is only needed for the providers that do not
+// have the player address in the link itself
+func DefaultParser(players PlayerLookup) MediaParser {
 	return &AggregateParser{
 		Parsers: []MediaParser{
 			&YoutubeParser{},
+			&VimeoParser{},
+			&PeerTubeParser{Hosts: PeerTubeHosts(), Players: players},
+			&SoundCloudParser{},
+			&BandcampParser{Players: players},
 		},
 	}
 }
@@ -0,0 +1,54 @@
+package media
+
+import (
+	"fmt"
+	"html/template"
+	"regexp"
+)
+
+// unlisted videos come with a hash, which is required to play them
+var vimeoRE *regexp.Regexp = regexp.MustCompile(`^https://(?:www\.)?vimeo\.com/(\d+)(?:/([0-9a-f]+))?/?$`)
+
+type VimeoLink struct {
+	ID   string
+	Hash string
+}
+
+func (l *VimeoLink) Key() string {
+	return fmt.Sprintf("vimeo: %s", l.ID)
+}
+
+func (l *VimeoLink) EmbedCode() template.HTML {
+	src := fmt.Sprintf("https://player.vimeo.com/video/%s?dnt=1", l.ID)
+
+	if l.Hash != "" {
+		src += "&amp;h=" + l.Hash
+	}
+
+	return template.HTML(
+		fmt.Sprintf(`<iframe class="video-embed" src="%s" allow="fullscreen; picture-in-picture" allowfullscreen loading="lazy"></iframe>`, src),
+	)
+}
+
+func (l *VimeoLink) URL() string {
+	if l.Hash != "" {
+		return fmt.Sprintf(`https://vimeo.com/%s/%s`, l.ID, l.Hash)
+	}
+
+	return fmt.Sprintf(`https://vimeo.com/%s`, l.ID)
+}
+
+type VimeoParser struct {
+}
+
+func (p *VimeoParser) Parse(url string) MediaLink {
+	groups := vimeoRE.FindStringSubmatch(url)
+
+	if len(groups) == 3 {
+		return &VimeoLink{
+			ID:   groups[1],
+			Hash: groups[2],
+		}
+	}
+	return nil
+}
@@ -1,25 +1,109 @@
 package links
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/pkg/types"
+	"github.com/can3p/pcom/pkg/util"
 	"github.com/google/uuid"
 )
 
+// SignedMediaTTL is the minimal time the signed media link stays valid.
+// Links are rotated once per period to let browsers cache them
+const SignedMediaTTL = 24 * time.Hour
+
 // the whole idea there is to keep only an identifier in the markdown
 // source text and give us flexibility to serve the image from
 // any source like cdn without touching saved text
 func MediaReplacer(inURL string) (bool, string) {
+	if !isMediaUpload(inURL) {
+		return false, ""
+	}
+
+	// all the checks are postponed till the actual call
+	return true, AbsLink("uploaded_media", inURL)
+}
+
+// SignedMediaReplacer works as MediaReplacer, but produces links that do not
+// require a session. Rendered content is only shown to those who can see it,
+// hence the link is enough to prove the access for a limited period of time
+func SignedMediaReplacer(inURL string) (bool, string) {
+	if !isMediaUpload(inURL) {
+		return false, ""
+	}
+
+	expires := signedMediaExpiry(time.Now())
+
+	// signed links are never served from cdn, since they are private
+	return true, util.SiteRoot() + Link("signed_media", strconv.FormatInt(expires, 10), SignMedia(inURL, expires), inURL)
+}
+
+// PostMediaReplacer picks the links for the media of a post, private media
+// is not reachable without a signature, while public one can be cached by anyone
+func PostMediaReplacer(visibility core.PostVisibility) types.Replacer[string] {
+	if visibility == core.PostVisibilityPublic {
+		return MediaReplacer
+	}
+
+	return SignedMediaReplacer
+}
+
+func isMediaUpload(inURL string) bool {
 	parts := strings.Split(inURL, ".")
 
 	if len(parts) != 2 {
-		return false, ""
+		return false
 	}
 
-	if _, err := uuid.Parse(parts[0]); err != nil {
-		return false, ""
+	_, err := uuid.Parse(parts[0])
+
+	return err == nil
+}
+
+func signedMediaExpiry(now time.Time) int64 {
+	return now.Truncate(SignedMediaTTL).Add(2 * SignedMediaTTL).Unix()
+}
+
+func mediaSigningKey() []byte {
+	if key := os.Getenv("MEDIA_SIGNING_KEY"); key != "" {
+		return []byte(key)
 	}
 
-	// all the checks are postponed till the actual call
-	return true, AbsLink("uploaded_media", inURL)
+	return []byte(os.Getenv("SESSION_SALT"))
+}
+
+func SignMedia(fname string, expires int64) string {
+	mac := hmac.New(sha256.New, mediaSigningKey())
+	mac.Write([]byte(fname + ":" + strconv.FormatInt(expires, 10)))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyMediaSignature returns the time till the link stays valid,
+// zero time means the signature is invalid or expired
+func VerifyMediaSignature(fname string, rawExpires string, signature string, now time.Time) time.Time {
+	expires, err := strconv.ParseInt(rawExpires, 10, 64)
+
+	if err != nil {
+		return time.Time{}
+	}
+
+	validUntil := time.Unix(expires, 0)
+
+	if !now.Before(validUntil) {
+		return time.Time{}
+	}
+
+	if !hmac.Equal([]byte(SignMedia(fname, expires)), []byte(signature)) {
+		return time.Time{}
+	}
+
+	return validUntil
 }
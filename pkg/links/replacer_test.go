@@ -0,0 +1,44 @@
+package links
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyMediaSignature(t *testing.T) {
+	now := time.Date(2026, 10, 19, 15, 30, 0, 0, time.UTC)
+	fname := "0192a0d2-7c4e-7d6a-9a4b-3f1c2d3e4f50.jpeg"
+
+	expires := signedMediaExpiry(now)
+	rawExpires := strconv.FormatInt(expires, 10)
+	signature := SignMedia(fname, expires)
+
+	// links generated within the same day are identical to let browsers cache them
+	assert.Equal(t, expires, signedMediaExpiry(now.Add(time.Hour)))
+
+	validUntil := VerifyMediaSignature(fname, rawExpires, signature, now)
+	assert.Equal(t, time.Unix(expires, 0), validUntil)
+
+	assert.True(t, VerifyMediaSignature("other.jpeg", rawExpires, signature, now).IsZero(), "signature is bound to the file")
+	assert.True(t, VerifyMediaSignature(fname, strconv.FormatInt(expires+1, 10), signature, now).IsZero(), "signature is bound to the expiry")
+	assert.True(t, VerifyMediaSignature(fname, rawExpires, signature, time.Unix(expires, 0)).IsZero(), "expired signature")
+	assert.True(t, VerifyMediaSignature(fname, "garbage", signature, now).IsZero(), "malformed expiry")
+}
+
+func TestPostMediaReplacer(t *testing.T) {
+	fname := "0192a0d2-7c4e-7d6a-9a4b-3f1c2d3e4f50.jpeg"
+
+	for _, visibility := range core.AllPostVisibility() {
+		ok, out := PostMediaReplacer(visibility)(fname)
+		assert.True(t, ok)
+
+		// only public posts get the links that do not expire
+		signed := strings.Contains(out, "/signed-media/")
+		assert.Equal(t, visibility != core.PostVisibilityPublic, signed, visibility)
+	}
+}
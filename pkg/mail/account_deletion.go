@@ -0,0 +1,52 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"os"
+	"time"
+
+	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/links"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// AccountDeletionScheduled tells the user when the account goes away
+// and where the deletion can be cancelled until then
+func AccountDeletionScheduled(ctx context.Context, exec boil.ContextExecutor, s sender.Sender, user *core.User, deleteAt time.Time) error {
+	settingsLink := links.AbsLink("settings")
+	when := deleteAt.UTC().Format("January 2, 2006")
+
+	mail := &sender.Mail{
+		From: mail.Address{
+			Address: os.Getenv("SENDER_ADDRESS"),
+			Name:    "Your pcom",
+		},
+		To: []mail.Address{
+			{
+				Address: user.Email,
+			},
+		},
+		Subject: "Your pcom account is going to be deleted",
+		Text: fmt.Sprintf(`
+	Hi!
+
+	Your pcom account is going to be deleted on %s along with all the posts, comments and media. Until then you can cancel the deletion in the settings
+
+	%s
+
+	If it wasn't you, please cancel the deletion and change your password right away.`, when, settingsLink),
+		Html: fmt.Sprintf(`
+	<p>Hi!</p>
+
+	<p>Your pcom account is going to be deleted on %s along with all the posts, comments and media. Until then you can cancel the deletion in the settings</p>
+
+	<a href="%s">%s</a>
+
+	<p>If it wasn't you, please cancel the deletion and change your password right away.</p>`, when, settingsLink, settingsLink),
+	}
+
+	return s.Send(ctx, exec, fmt.Sprintf("%s-%d", user.ID, deleteAt.Unix()), "account_deletion_scheduled", mail)
+}
@@ -0,0 +1,87 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"os"
+
+	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/links"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// ConfirmEmailChange sends the confirmation link to one of the addresses,
+// both the old and the new one get a token of their own
+func ConfirmEmailChange(ctx context.Context, exec boil.ContextExecutor, s sender.Sender, request *core.EmailChangeRequest, to string, token string) error {
+	link := links.AbsLink("confirm_email_change", token)
+	mailType := "email_change_new"
+	explanation := "Somebody has asked to use this address for a pcom account."
+
+	if to == request.OldEmail {
+		mailType = "email_change_old"
+		explanation = fmt.Sprintf("Somebody has asked to change the email of your pcom account to %s.", request.NewEmail)
+	}
+
+	mail := &sender.Mail{
+		From: mail.Address{
+			Address: os.Getenv("SENDER_ADDRESS"),
+			Name:    "Your pcom",
+		},
+		To: []mail.Address{
+			{
+				Address: to,
+			},
+		},
+		Subject: "Confirm the email change on pcom",
+		Text: fmt.Sprintf(`
+	Hi!
+
+	%s The change happens once both the old and the new address are confirmed. Please follow the link to confirm it, the link is valid for a day
+
+	%s
+
+	If it wasn't you, just ignore this email, nothing is going to change.`, explanation, link),
+		Html: fmt.Sprintf(`
+	<p>Hi!</p>
+
+	<p>%s The change happens once both the old and the new address are confirmed. Please follow the link to confirm it, the link is valid for a day</p>
+
+	<a href="%s">%s</a>
+
+	<p>If it wasn't you, just ignore this email, nothing is going to change.</p>`, explanation, link, link),
+	}
+
+	return s.Send(ctx, exec, request.ID, mailType, mail)
+}
+
+// EmailChanged lets the old address know that it's not used anymore
+func EmailChanged(ctx context.Context, exec boil.ContextExecutor, s sender.Sender, request *core.EmailChangeRequest) error {
+	mail := &sender.Mail{
+		From: mail.Address{
+			Address: os.Getenv("SENDER_ADDRESS"),
+			Name:    "Your pcom",
+		},
+		To: []mail.Address{
+			{
+				Address: request.OldEmail,
+			},
+		},
+		Subject: "Your pcom email has been changed",
+		Text: fmt.Sprintf(`
+	Hi!
+
+	The email of your pcom account has been changed to %s, this address is not going to get any emails from us anymore.
+
+	If it wasn't you, please reach out to us via the support form right away.`, request.NewEmail),
+		Html: fmt.Sprintf(`
+	<p>Hi!</p>
+
+	<p>The email of your pcom account has been changed to %s, this address is not going to get any emails from us anymore.</p>
+
+	<p>If it wasn't you, please reach out to us via the support form right away.</p>`, request.NewEmail),
+	}
+
+	return s.Send(ctx, exec, request.ID, "email_changed", mail)
+}
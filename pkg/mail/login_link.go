@@ -0,0 +1,54 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"os"
+
+	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/links"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// LoginLink sends the link that logs the user in without the password,
+// the signed return url is carried along to get the user back where they were
+func LoginLink(ctx context.Context, exec boil.ContextExecutor, s sender.Sender, user *core.User, loginLink *core.LoginLink, token string, returnURL string, sign string) error {
+	link := links.AbsLink("login_link", token)
+
+	if returnURL != "" {
+		link = links.AbsLink("login_link", token, "return_url", returnURL, "sign", sign)
+	}
+
+	mail := &sender.Mail{
+		From: mail.Address{
+			Address: os.Getenv("SENDER_ADDRESS"),
+			Name:    "Your pcom",
+		},
+		To: []mail.Address{
+			{
+				Address: user.Email,
+			},
+		},
+		Subject: "Your pcom login link",
+		Text: fmt.Sprintf(`
+	Hi!
+
+	Somebody has asked for a link to log into your pcom account. Please follow the link to log in, the link works once and is valid for 15 minutes
+
+	%s
+
+	If it wasn't you, just ignore this email, nobody can use the link without access to your inbox.`, link),
+		Html: fmt.Sprintf(`
+	<p>Hi!</p>
+
+	<p>Somebody has asked for a link to log into your pcom account. Please follow the link to log in, the link works once and is valid for 15 minutes</p>
+
+	<a href="%s">%s</a>
+
+	<p>If it wasn't you, just ignore this email, nobody can use the link without access to your inbox.</p>`, link, link),
+	}
+
+	return s.Send(ctx, exec, loginLink.ID, "login_link", mail)
+}
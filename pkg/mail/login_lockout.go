@@ -0,0 +1,52 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"os"
+
+	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/links"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// LoginLockout tells the user that somebody keeps trying to guess the password
+func LoginLockout(ctx context.Context, exec boil.ContextExecutor, s sender.Sender, user *core.User, lockout *core.LoginLockout) error {
+	link := links.AbsLink("forgot_password")
+	until := lockout.LockedUntil.UTC().Format("15:04 MST")
+
+	mail := &sender.Mail{
+		From: mail.Address{
+			Address: os.Getenv("SENDER_ADDRESS"),
+			Name:    "Your pcom",
+		},
+		To: []mail.Address{
+			{
+				Address: user.Email,
+			},
+		},
+		Subject: "Too many failed logins to your pcom account",
+		Text: fmt.Sprintf(`
+	Hi!
+
+	There were too many attempts to log in to your pcom account with a wrong password, the last one came from %s. Logging in is blocked until %s.
+
+	If it wasn't you, somebody might be trying to guess your password. Consider changing it to a stronger one and enabling two-factor authentication in the settings.
+
+	If you have forgotten your password, you can reset it here:
+
+	%s`, lockout.IP, until, link),
+		Html: fmt.Sprintf(`
+	<p>Hi!</p>
+
+	<p>There were too many attempts to log in to your pcom account with a wrong password, the last one came from %s. Logging in is blocked until %s.</p>
+
+	<p>If it wasn't you, somebody might be trying to guess your password. Consider changing it to a stronger one and enabling two-factor authentication in the settings.</p>
+
+	<p>If you have forgotten your password, you can <a href="%s">reset it</a>.</p>`, lockout.IP, until, link),
+	}
+
+	return s.Send(ctx, exec, lockout.ID, "login_lockout", mail)
+}
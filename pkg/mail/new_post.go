@@ -8,8 +8,10 @@ import (
 	"os"
 
 	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/linkpreview"
 	"github.com/can3p/pcom/pkg/links"
 	"github.com/can3p/pcom/pkg/markdown"
+	"github.com/can3p/pcom/pkg/media"
 	"github.com/can3p/pcom/pkg/model/core"
 	"github.com/can3p/pcom/pkg/postops"
 	"github.com/can3p/pcom/pkg/types"
@@ -25,7 +27,7 @@ func NewPost(ctx context.Context, exec boil.ContextExecutor, s sender.Sender, me
 	link := links.AbsLink("post", post.ID)
 	// there reason to omit body in the text version is that we should redo the logic with cut, gallery etc
 	// and I have no desire to spend time on that
-	htmlbody := markdown.ToEnrichedTemplate(post.Body, types.ViewEmail, mediaReplacer, func(in string, add2 ...string) string {
+	htmlbody := markdown.ToEnrichedTemplate(post.Body, types.ViewEmail, mediaReplacer, media.MetaGetter(ctx, exec), linkpreview.Getter(ctx, exec), func(in string, add2 ...string) string {
 		if in == "single_post_special" {
 			args := []string{post.ID}
 			args = append(args, add2...)
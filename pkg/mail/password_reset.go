@@ -0,0 +1,50 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"os"
+
+	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/links"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// PasswordReset sends the link with the reset token, the token
+// itself is never stored, hence it's passed separately
+func PasswordReset(ctx context.Context, exec boil.ContextExecutor, s sender.Sender, user *core.User, token string) error {
+	link := links.AbsLink("reset_password", token)
+
+	mail := &sender.Mail{
+		From: mail.Address{
+			Address: os.Getenv("SENDER_ADDRESS"),
+			Name:    "Your pcom",
+		},
+		To: []mail.Address{
+			{
+				Address: user.Email,
+			},
+		},
+		Subject: "Reset your pcom password",
+		Text: fmt.Sprintf(`
+	Hi!
+
+	Somebody has asked to reset the password of your pcom account. Please follow the link to set a new one, the link is valid for an hour
+
+	%s
+
+	If it wasn't you, just ignore this email, your password stays the same.`, link),
+		Html: fmt.Sprintf(`
+	<p>Hi!</p>
+
+	<p>Somebody has asked to reset the password of your pcom account. Please follow the link to set a new one, the link is valid for an hour</p>
+
+	<a href="%s">%s</a>
+
+	<p>If it wasn't you, just ignore this email, your password stays the same.</p>`, link, link),
+	}
+
+	return s.Send(ctx, exec, user.ID, "password_reset", mail)
+}
@@ -9,8 +9,10 @@ import (
 	"strings"
 
 	"github.com/can3p/gogo/sender"
+	"github.com/can3p/pcom/pkg/linkpreview"
 	"github.com/can3p/pcom/pkg/links"
 	"github.com/can3p/pcom/pkg/markdown"
+	"github.com/can3p/pcom/pkg/media"
 	"github.com/can3p/pcom/pkg/model/core"
 	"github.com/can3p/pcom/pkg/postops"
 	"github.com/can3p/pcom/pkg/types"
@@ -25,7 +27,7 @@ func PostCommentAuthor(ctx context.Context, exec boil.ContextExecutor, s sender.
 
 	link := links.AbsLink("comment", post.ID, comment.ID)
 	body := markdown.ReplaceImageUrls(comment.Body, mediaReplacer)
-	htmlBody := markdown.ToEnrichedTemplate(comment.Body, types.ViewEmail, mediaReplacer, links.AbsLink)
+	htmlBody := markdown.ToEnrichedTemplate(comment.Body, types.ViewEmail, mediaReplacer, media.MetaGetter(ctx, exec), linkpreview.Getter(ctx, exec), links.AbsLink)
 
 	subject := postops.PostSubject(post.Subject)
 
@@ -20,9 +20,9 @@ type parsedText struct {
 	parser goldmark.Markdown
 }
 
-func Parse(s string, view types.HTMLView, mediaReplacer types.Replacer[string], link types.Link) *parsedText {
+func Parse(s string, view types.HTMLView, mediaReplacer types.Replacer[string], mediaMeta types.MediaMetaGetter, linkPreview types.LinkPreviewGetter, link types.Link) *parsedText {
 	r := goldmarkText.NewReader([]byte(s))
-	parser := NewParser(view, mediaReplacer, link)
+	parser := NewParser(view, mediaReplacer, mediaMeta, linkPreview, link)
 	ast := parser.Parser().Parse(r)
 
 	return &parsedText{
@@ -10,7 +10,9 @@ import (
 	"github.com/can3p/pcom/pkg/markdown/mdext/blocktags"
 	"github.com/can3p/pcom/pkg/markdown/mdext/headershift"
 	"github.com/can3p/pcom/pkg/markdown/mdext/lazyload"
+	"github.com/can3p/pcom/pkg/markdown/mdext/linkcard"
 	"github.com/can3p/pcom/pkg/markdown/mdext/linkrenderer"
+	"github.com/can3p/pcom/pkg/markdown/mdext/mediaplayer"
 	"github.com/can3p/pcom/pkg/markdown/mdext/videoembed"
 	"github.com/can3p/pcom/pkg/types"
 	"github.com/yuin/goldmark"
@@ -27,7 +29,18 @@ import (
 // Note: proper fix is probably something else
 var urlRegexpNoClosingBrace = regexp.MustCompile(`^(?:http|https|ftp)://[-a-zA-Z0-9@:%._\+~#=]{1,256}\.[a-z]+(?::\d+)?(?:[/#?][-a-zA-Z0-9@:%_+.~#$!?&/=\(;,'">\^{}\[\]` + "`" + `]*)?`) //nolint:golint,lll
 
-func NewParser(view types.HTMLView, mediaReplacer types.Replacer[string], link types.Link) goldmark.Markdown {
+func NewParser(view types.HTMLView, mediaReplacer types.Replacer[string], mediaMeta types.MediaMetaGetter, linkPreview types.LinkPreviewGetter, link types.Link) goldmark.Markdown {
+	// some players can only be found on the page itself
+	players := func(pageURL string) string {
+		if linkPreview == nil {
+			return ""
+		}
+
+		preview, _ := linkPreview(pageURL)
+
+		return preview.PlayerURL
+	}
+
 	extensions := []goldmark.Extender{
 		extension.NewLinkify(
 			extension.WithLinkifyAllowedProtocols([][]byte{
@@ -37,7 +50,9 @@ func NewParser(view types.HTMLView, mediaReplacer types.Replacer[string], link t
 			extension.WithLinkifyURLRegexp(urlRegexpNoClosingBrace),
 		),
 		mdext.NewHandle(),
-		videoembed.NewVideoEmbedExtender(view),
+		videoembed.NewVideoEmbedExtender(view, players),
+		mediaplayer.NewMediaPlayerExtender(view, mediaReplacer, mediaMeta),
+		linkcard.NewLinkCardExtender(view, mediaReplacer, linkPreview),
 		headershift.NewHeaderShiftExtender(1),
 	}
 
@@ -55,7 +70,7 @@ func NewParser(view types.HTMLView, mediaReplacer types.Replacer[string], link t
 
 	nodeRenderers := util.PrioritizedSlice{
 		// we let gallery block do it's own business
-		util.Prioritized(lazyload.NewImgLazyLoadRenderer(view, mediaReplacer, func(n ast.Node) bool {
+		util.Prioritized(lazyload.NewImgLazyLoadRenderer(view, mediaReplacer, mediaMeta, func(n ast.Node) bool {
 			t, ok := n.(*blocktags.BlockTag)
 
 			if !ok {
@@ -90,8 +105,8 @@ func NewParser(view types.HTMLView, mediaReplacer types.Replacer[string], link t
 	)
 }
 
-func ToEnrichedTemplate(s string, view types.HTMLView, mediaReplacer types.Replacer[string], link types.Link) template.HTML {
-	text := Parse(s, view, mediaReplacer, link)
+func ToEnrichedTemplate(s string, view types.HTMLView, mediaReplacer types.Replacer[string], mediaMeta types.MediaMetaGetter, linkPreview types.LinkPreviewGetter, link types.Link) template.HTML {
+	text := Parse(s, view, mediaReplacer, mediaMeta, linkPreview, link)
 
 	var buf bytes.Buffer
 	if err := text.Render(&buf); err != nil {
@@ -48,7 +48,7 @@ func TestFeedViewLinksOpenInNewTab(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			result := ToEnrichedTemplate(tt.input, types.ViewFeed, func(in string) (bool, string) {
 				return false, in
-			}, func(name string, args ...string) string {
+			}, nil, nil, func(name string, args ...string) string {
 				return "/" + name
 			})
 
@@ -85,7 +85,7 @@ func TestNonFeedViewLinksDoNotOpenInNewTab(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			result := ToEnrichedTemplate(input, tt.view, func(in string) (bool, string) {
 				return false, in
-			}, func(name string, args ...string) string {
+			}, nil, nil, func(name string, args ...string) string {
 				return "/" + name
 			})
 
@@ -110,7 +110,7 @@ This is a paragraph with a [link](https://example.com).
 
 	result := ToEnrichedTemplate(input, types.ViewFeed, func(in string) (bool, string) {
 		return false, in
-	}, func(name string, args ...string) string {
+	}, nil, nil, func(name string, args ...string) string {
 		return "/" + name
 	})
 
@@ -132,7 +132,7 @@ func TestFeedViewEmailAutolink(t *testing.T) {
 
 	result := ToEnrichedTemplate(input, types.ViewFeed, func(in string) (bool, string) {
 		return false, in
-	}, func(name string, args ...string) string {
+	}, nil, nil, func(name string, args ...string) string {
 		return "/" + name
 	})
 
@@ -141,3 +141,97 @@ func TestFeedViewEmailAutolink(t *testing.T) {
 	// Email links should also open in new tab
 	assert.Contains(t, output, `<a href="mailto:user@example.com" target="_blank" rel="noopener noreferrer">user@example.com</a>`)
 }
+
+func TestImageMeta(t *testing.T) {
+	input := "![cat](cat.png) ![dog](https://example.com/dog.png)"
+
+	mediaReplacer := func(in string) (bool, string) {
+		if in != "cat.png" {
+			return false, ""
+		}
+
+		return true, "/media/" + in
+	}
+
+	mediaMeta := func(fname string) (types.MediaMeta, bool) {
+		assert.Equal(t, "cat.png", fname, "only our own images should be looked up")
+
+		return types.MediaMeta{
+			Width:       640,
+			Height:      480,
+			Placeholder: "data:image/png;base64,AAAA",
+			Color:       "#a0b0c0",
+		}, true
+	}
+
+	link := func(name string, args ...string) string {
+		return "/" + name
+	}
+
+	feed := string(ToEnrichedTemplate(input, types.ViewFeed, mediaReplacer, mediaMeta, nil, link))
+	assert.Contains(t, feed, `src="data:image/png;base64,AAAA" data-src="/media/cat.png/thumb" width="640" height="480" style="background-color:#a0b0c0" alt="cat"`)
+	assert.Contains(t, feed, `data-src="https://example.com/dog.png" alt="dog"`)
+
+	email := string(ToEnrichedTemplate(input, types.ViewEmail, mediaReplacer, mediaMeta, nil, link))
+	assert.Contains(t, email, `<img src="/media/cat.png/thumb" width="640" height="480" style="background-color:#a0b0c0;background-image:url(data:image/png;base64,AAAA);background-size:cover" alt="cat"`)
+
+	gallery := string(ToEnrichedTemplate("{gallery}\n![cat](cat.png)\n{/gallery}", types.ViewSinglePost, mediaReplacer, mediaMeta, nil, link))
+	assert.Contains(t, gallery, `<img src="/media/cat.png/thumb" width="640" height="480"`)
+
+	noMeta := string(ToEnrichedTemplate(input, types.ViewFeed, mediaReplacer, nil, nil, link))
+	assert.Contains(t, noMeta, `data-src="/media/cat.png/thumb" alt="cat"`)
+}
+
+func TestImageAltTextFallback(t *testing.T) {
+	mediaReplacer := func(in string) (bool, string) {
+		return true, "/media/" + in
+	}
+
+	mediaMeta := func(fname string) (types.MediaMeta, bool) {
+		return types.MediaMeta{
+			AltText: "a cat <on a mat>",
+			Caption: "Taken in the garden",
+		}, true
+	}
+
+	link := func(name string, args ...string) string {
+		return "/" + name
+	}
+
+	output := string(ToEnrichedTemplate("![](cat.png)", types.ViewFeed, mediaReplacer, mediaMeta, nil, link))
+	assert.Contains(t, output, `alt="a cat &lt;on a mat&gt;" title="Taken in the garden"`)
+	assert.NotContains(t, output, "width=", "no dimensions for legacy uploads")
+
+	output = string(ToEnrichedTemplate(`![inline](cat.png "inline title")`, types.ViewFeed, mediaReplacer, mediaMeta, nil, link))
+	assert.Contains(t, output, `alt="inline" title="inline title"`)
+
+	output = string(ToEnrichedTemplate("{gallery}\n![](cat.png)\n{/gallery}", types.ViewSinglePost, mediaReplacer, mediaMeta, nil, link))
+	assert.Contains(t, output, `alt="a cat &lt;on a mat&gt;" title="Taken in the garden"`)
+}
+
+func TestVideoBlockTag(t *testing.T) {
+	mediaReplacer := func(in string) (bool, string) {
+		return true, "/media/" + in
+	}
+
+	mediaMeta := func(fname string) (types.MediaMeta, bool) {
+		return types.MediaMeta{
+			Kind:      types.MediaKindVideo,
+			HasPoster: true,
+		}, true
+	}
+
+	link := func(name string, args ...string) string {
+		return "/" + name
+	}
+
+	input := "{video Our trip}\nclip.mp4\n{/video}"
+
+	output := string(ToEnrichedTemplate(input, types.ViewSinglePost, mediaReplacer, mediaMeta, nil, link))
+	assert.Contains(t, output, `<div class="block-container-video-summary">Our trip</div>`)
+	assert.Contains(t, output, `poster="/media/clip.mp4/poster" src="/media/clip.mp4/stream"`)
+
+	output = string(ToEnrichedTemplate(input, types.ViewRSS, mediaReplacer, mediaMeta, nil, link))
+	assert.NotContains(t, output, "block-container-video")
+	assert.Contains(t, output, `<a href="/media/clip.mp4/stream"><img src="/media/clip.mp4/poster"`)
+}
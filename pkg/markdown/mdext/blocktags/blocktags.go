@@ -56,6 +56,14 @@ var DefaultTags = []*TagDef{
 		Name:              "gallery",
 		AllowedParentTags: []string{"spoiler", "cut"},
 	},
+	{
+		Name:              "video",
+		AllowedParentTags: []string{"spoiler", "cut"},
+	},
+	{
+		Name:              "audio",
+		AllowedParentTags: []string{"spoiler", "cut"},
+	},
 }
 
 type DefMap map[string]*TagDef
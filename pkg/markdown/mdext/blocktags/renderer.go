@@ -65,7 +65,7 @@ func (r *BlockTagRenderer) renderBlockTag(w util.BufWriter, source []byte, node
 			}
 		}
 
-		if n.BlockTagName == "gallery" {
+		if n.BlockTagName == "gallery" || n.BlockTagName == "video" || n.BlockTagName == "audio" {
 			return ast.WalkContinue, nil
 		}
 	}
@@ -2,6 +2,8 @@ package lazyload
 
 import (
 	"bytes"
+	"fmt"
+	"strings"
 
 	"github.com/can3p/pcom/pkg/types"
 	"github.com/yuin/goldmark/ast"
@@ -12,11 +14,14 @@ import (
 
 type ParentChecker func(n ast.Node) bool
 
-func NewImgLazyLoadRenderer(view types.HTMLView, mediaReplacer types.Replacer[string], forbiddenParent ParentChecker, opts ...html.Option) renderer.NodeRenderer {
+// NewImgLazyLoadRenderer renders images with lazy loading. mediaMeta is optional,
+// if present dimensions and placeholders are added for the images from our storage
+func NewImgLazyLoadRenderer(view types.HTMLView, mediaReplacer types.Replacer[string], mediaMeta types.MediaMetaGetter, forbiddenParent ParentChecker, opts ...html.Option) renderer.NodeRenderer {
 	r := &LazyLoadRenderer{
 		Config:          html.NewConfig(),
 		view:            view,
 		mediaReplacer:   mediaReplacer,
+		mediaMeta:       mediaMeta,
 		forbiddenParent: forbiddenParent,
 	}
 	for _, opt := range opts {
@@ -29,6 +34,7 @@ type LazyLoadRenderer struct {
 	html.Config
 	view            types.HTMLView
 	mediaReplacer   types.Replacer[string]
+	mediaMeta       types.MediaMetaGetter
 	forbiddenParent ParentChecker
 }
 
@@ -72,22 +78,30 @@ func (r *LazyLoadRenderer) renderImage(w util.BufWriter, source []byte, node ast
 		}
 		_, _ = w.WriteString("\">")
 	}
-	if r.view == types.ViewEmail || r.view == types.ViewRSS {
+	meta, hasMeta := r.getMeta(shouldReplace, n)
+	// there is no js in emails and rss readers to swap the image
+	lazy := r.view != types.ViewEmail && r.view != types.ViewRSS
+
+	if !lazy {
 		_, _ = w.WriteString("<img src=\"")
 	} else {
-		_, _ = w.WriteString("<img class=\"lazyload mx-auto d-block img standalone-img\" data-src=\"")
+		_, _ = w.WriteString("<img class=\"lazyload mx-auto d-block img standalone-img\"")
+		// lazysizes replaces the placeholder once the image is in the viewport
+		if hasMeta && meta.Placeholder != "" {
+			_, _ = w.WriteString(` src="`)
+			_, _ = w.Write(util.EscapeHTML([]byte(meta.Placeholder)))
+			_ = w.WriteByte('"')
+		}
+		_, _ = w.WriteString(" data-src=\"")
 	}
 	if r.Unsafe || !html.IsDangerousURL([]byte(imgUrl)) {
 		_, _ = w.Write(util.EscapeHTML(util.URLEscape([]byte(imgUrl), true)))
 	}
-	_, _ = w.WriteString(`" alt="`)
-	_, _ = w.Write(nodeToHTMLText(n, source))
 	_ = w.WriteByte('"')
-	if n.Title != nil {
-		_, _ = w.WriteString(` title="`)
-		r.Writer.Write(w, n.Title)
-		_ = w.WriteByte('"')
+	if hasMeta {
+		writeMetaAttributes(w, meta, !lazy)
 	}
+	r.writeAltAndTitle(w, source, n, meta)
 	if n.Attributes() != nil {
 		html.RenderAttributes(w, n, html.ImageAttributeFilter)
 	}
@@ -103,6 +117,63 @@ func (r *LazyLoadRenderer) renderImage(w util.BufWriter, source []byte, node ast
 	return ast.WalkSkipChildren, nil
 }
 
+// getMeta only looks up the images from our storage, there is
+// nothing known about the external ones
+func (r *LazyLoadRenderer) getMeta(shouldReplace bool, n *ast.Image) (types.MediaMeta, bool) {
+	if !shouldReplace || r.mediaMeta == nil {
+		return types.MediaMeta{}, false
+	}
+
+	return r.mediaMeta(string(n.Destination))
+}
+
+// writeMetaAttributes lets the browser reserve the space for the image.
+// Placeholder goes to the background if the image is loaded right away
+func writeMetaAttributes(w util.BufWriter, meta types.MediaMeta, placeholderAsBackground bool) {
+	if meta.Width > 0 && meta.Height > 0 {
+		_, _ = fmt.Fprintf(w, ` width="%d" height="%d"`, meta.Width, meta.Height)
+	}
+
+	var style []string
+
+	if meta.Color != "" {
+		style = append(style, "background-color:"+meta.Color)
+	}
+
+	if placeholderAsBackground && meta.Placeholder != "" {
+		style = append(style, "background-image:url("+meta.Placeholder+")", "background-size:cover")
+	}
+
+	if len(style) > 0 {
+		_, _ = w.WriteString(` style="`)
+		_, _ = w.Write(util.EscapeHTML([]byte(strings.Join(style, ";"))))
+		_ = w.WriteByte('"')
+	}
+}
+
+// writeAltAndTitle falls back to the alt text and the caption of the upload
+// when markdown has none. Caption goes to the title, gallery shows it as well
+func (r *LazyLoadRenderer) writeAltAndTitle(w util.BufWriter, source []byte, n *ast.Image, meta types.MediaMeta) {
+	alt := nodeToHTMLText(n, source)
+
+	if len(bytes.TrimSpace(alt)) == 0 && meta.AltText != "" {
+		alt = util.EscapeHTML([]byte(meta.AltText))
+	}
+
+	_, _ = w.WriteString(` alt="`)
+	_, _ = w.Write(alt)
+	_ = w.WriteByte('"')
+	if n.Title != nil {
+		_, _ = w.WriteString(` title="`)
+		r.Writer.Write(w, n.Title)
+		_ = w.WriteByte('"')
+	} else if meta.Caption != "" {
+		_, _ = w.WriteString(` title="`)
+		_, _ = w.Write(util.EscapeHTML([]byte(meta.Caption)))
+		_ = w.WriteByte('"')
+	}
+}
+
 func nodeToHTMLText(n ast.Node, source []byte) []byte {
 	var buf bytes.Buffer
 	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
@@ -137,14 +208,12 @@ func (r *LazyLoadRenderer) renderImageClassic(w util.BufWriter, source []byte, n
 	if r.Unsafe || !html.IsDangerousURL([]byte(updatedLink)) {
 		_, _ = w.Write(util.EscapeHTML(util.URLEscape([]byte(updatedLink), true)))
 	}
-	_, _ = w.WriteString(`" alt="`)
-	_, _ = w.Write(nodeToHTMLText(n, source))
 	_ = w.WriteByte('"')
-	if n.Title != nil {
-		_, _ = w.WriteString(` title="`)
-		r.Writer.Write(w, n.Title)
-		_ = w.WriteByte('"')
+	meta, hasMeta := r.getMeta(shouldReplace, n)
+	if hasMeta {
+		writeMetaAttributes(w, meta, true)
 	}
+	r.writeAltAndTitle(w, source, n, meta)
 	if n.Attributes() != nil {
 		html.RenderAttributes(w, n, html.ImageAttributeFilter)
 	}
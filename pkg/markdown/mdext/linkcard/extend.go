@@ -0,0 +1,40 @@
+package linkcard
+
+import (
+	"github.com/can3p/pcom/pkg/types"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+type linkCard struct {
+	view          types.HTMLView
+	mediaReplacer types.Replacer[string]
+	linkPreview   types.LinkPreviewGetter
+}
+
+// NewLinkCardExtender creates a new [goldmark.Extender] that
+// turns the links that take the whole paragraph into preview cards
+func NewLinkCardExtender(view types.HTMLView, mediaReplacer types.Replacer[string], linkPreview types.LinkPreviewGetter) goldmark.Extender {
+	return &linkCard{
+		view:          view,
+		mediaReplacer: mediaReplacer,
+		linkPreview:   linkPreview,
+	}
+}
+
+func (e *linkCard) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithParagraphTransformers(
+			// embeds go first, card is a fallback for everything else
+			util.Prioritized(NewLinkCardTransformer(e.linkPreview), 400),
+		),
+	)
+
+	m.Renderer().AddOptions(
+		renderer.WithNodeRenderers(
+			util.Prioritized(NewLinkCardRenderer(e.view, e.mediaReplacer), 500),
+		),
+	)
+}
@@ -0,0 +1,81 @@
+package linkcard
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/can3p/pcom/pkg/types"
+	"github.com/yuin/goldmark"
+)
+
+func TestLinkCard(t *testing.T) {
+	mediaReplacer := func(in string) (bool, string) {
+		return true, "/user-media/" + in
+	}
+
+	linkPreview := func(url string) (types.LinkPreview, bool) {
+		switch url {
+		case "https://example.com/article":
+			return types.LinkPreview{
+				URL:         "https://example.com/article/",
+				Title:       "An <article>",
+				Description: "What it is about",
+				SiteName:    "Example",
+				Image:       "cover.png",
+			}, true
+		case "https://example.com/bare":
+			return types.LinkPreview{
+				URL:   "https://example.com/bare",
+				Title: "Bare",
+			}, true
+		}
+
+		return types.LinkPreview{}, false
+	}
+
+	examples := []struct {
+		view types.HTMLView
+		in   string
+		out  string
+	}{
+		{
+			view: types.ViewSinglePost,
+			in:   "https://example.com/article",
+			out:  `<div class="card link-preview mb-3"><a class="d-flex text-reset text-decoration-none" href="https://example.com/article" target="_blank" rel="noopener noreferrer"><img class="link-preview-image flex-shrink-0" src="/user-media/cover.png/thumb" alt="" loading="lazy"><div class="card-body"><div class="link-preview-site small text-muted">Example</div><div class="link-preview-title fw-semibold">An &lt;article&gt;</div><div class="link-preview-description small">What it is about</div></div></a></div>`,
+		},
+		{
+			view: types.ViewFeed,
+			in:   "<https://example.com/bare>",
+			out:  `<div class="card link-preview mb-3"><a class="d-flex text-reset text-decoration-none" href="https://example.com/bare" target="_blank" rel="noopener noreferrer"><div class="card-body"><div class="link-preview-site small text-muted">example.com</div><div class="link-preview-title fw-semibold">Bare</div></div></a></div>`,
+		},
+		{
+			view: types.ViewEmail,
+			in:   "https://example.com/article",
+			out:  `<p><a href="https://example.com/article"><strong>An &lt;article&gt;</strong></a><br>What it is about<br><small>Example</small></p>`,
+		},
+		{
+			view: types.ViewSinglePost,
+			in:   "the link is a part of paragraph https://example.com/article",
+			out:  `<p>the link is a part of paragraph https://example.com/article</p>`,
+		},
+		{
+			view: types.ViewSinglePost,
+			in:   "https://example.com/unknown",
+			out:  `<p>https://example.com/unknown</p>`,
+		},
+	}
+
+	for idx, ex := range examples {
+		parser := goldmark.New(
+			goldmark.WithExtensions(NewLinkCardExtender(ex.view, mediaReplacer, linkPreview)),
+		)
+
+		var writer bytes.Buffer
+
+		_ = parser.Convert([]byte(ex.in), &writer)
+
+		assert.Equal(t, ex.out, strings.TrimSpace(writer.String()), "[ex %d]:", idx+1)
+	}
+}
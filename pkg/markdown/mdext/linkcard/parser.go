@@ -0,0 +1,93 @@
+package linkcard
+
+import (
+	"regexp"
+
+	"github.com/can3p/pcom/pkg/types"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+var standaloneURLRe = regexp.MustCompile(`^<?(https?://[^\s<>]+)>?$`)
+
+// StandaloneURL returns the url in case it's the only thing
+// on the line, empty string otherwise
+func StandaloneURL(line []byte) string {
+	groups := standaloneURLRe.FindSubmatch(util.TrimLeftSpace(util.TrimRightSpace(line)))
+
+	if groups == nil {
+		return ""
+	}
+
+	return string(groups[1])
+}
+
+type LinkCard struct {
+	ast.BaseBlock
+	Preview types.LinkPreview
+}
+
+func (n *LinkCard) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{
+		"URL": n.Preview.URL,
+	}, nil)
+}
+
+var KindLinkCard = ast.NewNodeKind("LinkCard")
+
+func (n *LinkCard) Kind() ast.NodeKind {
+	return KindLinkCard
+}
+
+func NewLinkCard(preview types.LinkPreview) *LinkCard {
+	return &LinkCard{
+		BaseBlock: ast.BaseBlock{},
+		Preview:   preview,
+	}
+}
+
+type linkCardTransformer struct {
+	linkPreview types.LinkPreviewGetter
+}
+
+func NewLinkCardTransformer(linkPreview types.LinkPreviewGetter) *linkCardTransformer {
+	return &linkCardTransformer{
+		linkPreview: linkPreview,
+	}
+}
+
+func (p *linkCardTransformer) Transform(node *ast.Paragraph, reader text.Reader, pc parser.Context) {
+	if p.linkPreview == nil {
+		return
+	}
+
+	lines := node.Lines()
+
+	if lines.Len() != 1 {
+		return
+	}
+
+	line := lines.At(0)
+
+	url := StandaloneURL(line.Value(reader.Source()))
+
+	if url == "" {
+		return
+	}
+
+	preview, ok := p.linkPreview(url)
+
+	if !ok {
+		return
+	}
+
+	// the card should lead exactly where the author wanted
+	preview.URL = url
+
+	newNode := NewLinkCard(preview)
+
+	newNode.SetBlankPreviousLines(node.HasBlankPreviousLines())
+	node.Parent().ReplaceChild(node.Parent(), node, newNode)
+}
@@ -0,0 +1,125 @@
+package linkcard
+
+import (
+	"io"
+	"net/url"
+
+	"github.com/can3p/pcom/pkg/types"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/util"
+)
+
+type LinkCardRenderer struct {
+	html.Config
+	view          types.HTMLView
+	mediaReplacer types.Replacer[string]
+}
+
+func NewLinkCardRenderer(view types.HTMLView, mediaReplacer types.Replacer[string], opts ...html.Option) renderer.NodeRenderer {
+	r := &LinkCardRenderer{
+		Config:        html.NewConfig(),
+		view:          view,
+		mediaReplacer: mediaReplacer,
+	}
+	for _, opt := range opts {
+		opt.SetHTMLOption(&r.Config)
+	}
+	return r
+}
+
+func (r *LinkCardRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(KindLinkCard, r.renderLinkCard)
+}
+
+func (r *LinkCardRenderer) renderLinkCard(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	n := node.(*LinkCard)
+
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	WriteCard(w, r.view, r.mediaReplacer, n.Preview)
+
+	return ast.WalkSkipChildren, nil
+}
+
+// WriteCard renders the preview, it's exported to render
+// the cards of the urls attached to the posts the same way
+func WriteCard(w io.Writer, view types.HTMLView, mediaReplacer types.Replacer[string], preview types.LinkPreview) {
+	write := func(s string) {
+		_, _ = io.WriteString(w, s)
+	}
+
+	escape := func(s string) {
+		_, _ = w.Write(util.EscapeHTML([]byte(s)))
+	}
+
+	href := util.EscapeHTML(util.URLEscape([]byte(preview.URL), true))
+
+	if html.IsDangerousURL(href) {
+		href = nil
+	}
+
+	var imgURL string
+
+	if preview.Image != "" && mediaReplacer != nil {
+		if ok, link := mediaReplacer(preview.Image); ok {
+			imgURL = link + "/thumb"
+		}
+	}
+
+	site := preview.SiteName
+
+	if site == "" {
+		if u, err := url.Parse(preview.URL); err == nil {
+			site = u.Hostname()
+		}
+	}
+
+	// no styles in emails and rss readers, just the essentials
+	if view == types.ViewEmail || view == types.ViewRSS {
+		write(`<p><a href="`)
+		_, _ = w.Write(href)
+		write(`"><strong>`)
+		escape(preview.Title)
+		write("</strong></a>")
+
+		if preview.Description != "" {
+			write("<br>")
+			escape(preview.Description)
+		}
+
+		write("<br><small>")
+		escape(site)
+		write("</small></p>\n")
+
+		return
+	}
+
+	write(`<div class="card link-preview mb-3"><a class="d-flex text-reset text-decoration-none" href="`)
+	_, _ = w.Write(href)
+	write(`" target="_blank" rel="noopener noreferrer">`)
+
+	if imgURL != "" {
+		write(`<img class="link-preview-image flex-shrink-0" src="`)
+		_, _ = w.Write(util.EscapeHTML(util.URLEscape([]byte(imgURL), true)))
+		write(`" alt="" loading="lazy">`)
+	}
+
+	write(`<div class="card-body">`)
+	write(`<div class="link-preview-site small text-muted">`)
+	escape(site)
+	write(`</div><div class="link-preview-title fw-semibold">`)
+	escape(preview.Title)
+	write("</div>")
+
+	if preview.Description != "" {
+		write(`<div class="link-preview-description small">`)
+		escape(preview.Description)
+		write("</div>")
+	}
+
+	write("</div></a></div>\n")
+}
@@ -0,0 +1,39 @@
+package mediaplayer
+
+import (
+	"github.com/can3p/pcom/pkg/types"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+type mediaPlayer struct {
+	view          types.HTMLView
+	mediaReplacer types.Replacer[string]
+	mediaMeta     types.MediaMetaGetter
+}
+
+// NewMediaPlayerExtender creates a new [goldmark.Extender] that
+// turns the links to uploaded video and audio clips into players
+func NewMediaPlayerExtender(view types.HTMLView, mediaReplacer types.Replacer[string], mediaMeta types.MediaMetaGetter) goldmark.Extender {
+	return &mediaPlayer{
+		view:          view,
+		mediaReplacer: mediaReplacer,
+		mediaMeta:     mediaMeta,
+	}
+}
+
+func (e *mediaPlayer) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithParagraphTransformers(
+			util.Prioritized(NewMediaPlayerTransformer(e.mediaReplacer, e.mediaMeta), 500),
+		),
+	)
+
+	m.Renderer().AddOptions(
+		renderer.WithNodeRenderers(
+			util.Prioritized(NewMediaPlayerRenderer(e.view, e.mediaReplacer), 500),
+		),
+	)
+}
@@ -0,0 +1,103 @@
+package mediaplayer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/can3p/pcom/pkg/types"
+	"github.com/yuin/goldmark"
+)
+
+func TestMediaPlayer(t *testing.T) {
+	mediaReplacer := func(in string) (bool, string) {
+		if !strings.HasPrefix(in, "clip-") && !strings.HasPrefix(in, "image-") {
+			return false, ""
+		}
+
+		return true, "/user-media/" + in
+	}
+
+	metas := map[string]types.MediaMeta{
+		"clip-video.mp4":  {Kind: types.MediaKindVideo, HasPoster: true, Caption: "Sunset"},
+		"clip-audio.mp3":  {Kind: types.MediaKindAudio, AltText: "Birds"},
+		"clip-queued.mov": {Kind: types.MediaKindVideo, Processing: true},
+		"clip-broken.wav": {Kind: types.MediaKindAudio, Failed: true},
+		"image-cat.png":   {Kind: types.MediaKindImage},
+	}
+
+	mediaMeta := func(fname string) (types.MediaMeta, bool) {
+		meta, ok := metas[fname]
+		return meta, ok
+	}
+
+	examples := []struct {
+		view types.HTMLView
+		in   string
+		out  string
+	}{
+		{
+			view: types.ViewSinglePost,
+			in:   "![](clip-video.mp4)",
+			out:  `<p><video class="mx-auto d-block standalone-video" controls preload="none" poster="/user-media/clip-video.mp4/poster" src="/user-media/clip-video.mp4/stream" title="Sunset"></video></p>`,
+		},
+		{
+			view: types.ViewSinglePost,
+			in:   "[Morning](clip-audio.mp3)",
+			out:  `<p><audio class="mx-auto d-block standalone-audio" controls preload="none" src="/user-media/clip-audio.mp3/stream" aria-label="Morning"></audio></p>`,
+		},
+		{
+			view: types.ViewSinglePost,
+			in:   "clip-audio.mp3",
+			out:  `<p><audio class="mx-auto d-block standalone-audio" controls preload="none" src="/user-media/clip-audio.mp3/stream" aria-label="Birds"></audio></p>`,
+		},
+		{
+			view: types.ViewSinglePost,
+			in:   "clip-queued.mov",
+			out:  `<p class="media-processing">The video is still being processed, check back in a few minutes</p>`,
+		},
+		{
+			view: types.ViewSinglePost,
+			in:   "clip-broken.wav",
+			out:  `<p class="media-processing">The audio could not be processed</p>`,
+		},
+		{
+			view: types.ViewSinglePost,
+			in:   "the clip is a part of paragraph and should be left alone clip-audio.mp3",
+			out:  `<p>the clip is a part of paragraph and should be left alone clip-audio.mp3</p>`,
+		},
+		{
+			view: types.ViewSinglePost,
+			in:   "image-cat.png",
+			out:  `<p>image-cat.png</p>`,
+		},
+		{
+			view: types.ViewSinglePost,
+			in:   "clip-unknown.mp4",
+			out:  `<p>clip-unknown.mp4</p>`,
+		},
+		{
+			view: types.ViewEmail,
+			in:   "![Sunset](clip-video.mp4)",
+			out:  `<p><a href="/user-media/clip-video.mp4/stream"><img src="/user-media/clip-video.mp4/poster" alt="Sunset"></a></p>`,
+		},
+		{
+			view: types.ViewRSS,
+			in:   "clip-audio.mp3",
+			out:  `<p><a href="/user-media/clip-audio.mp3/stream">Listen to the audio</a></p>`,
+		},
+	}
+
+	for idx, ex := range examples {
+		parser := goldmark.New(
+			goldmark.WithExtensions(NewMediaPlayerExtender(ex.view, mediaReplacer, mediaMeta)),
+		)
+
+		var writer bytes.Buffer
+
+		_ = parser.Convert([]byte(ex.in), &writer)
+
+		assert.Equal(t, ex.out, strings.TrimSpace(writer.String()), "[ex %d]:", idx+1)
+	}
+}
@@ -0,0 +1,102 @@
+package mediaplayer
+
+import (
+	"regexp"
+
+	"github.com/can3p/pcom/pkg/types"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// both links and images are fine, editor inserts the latter for all uploads
+var linkRe = regexp.MustCompile(`^!?\[([^\]]*)\]\((\S+)\)$`)
+
+var bareNameRe = regexp.MustCompile(`^\S+$`)
+
+type MediaPlayer struct {
+	ast.BaseBlock
+	Fname string
+	Label string
+	Meta  types.MediaMeta
+}
+
+func (n *MediaPlayer) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{
+		"Fname": n.Fname,
+		"Kind":  string(n.Meta.Kind),
+	}, nil)
+}
+
+var KindMediaPlayer = ast.NewNodeKind("MediaPlayer")
+
+func (n *MediaPlayer) Kind() ast.NodeKind {
+	return KindMediaPlayer
+}
+
+func NewMediaPlayer(fname string, label string, meta types.MediaMeta) *MediaPlayer {
+	return &MediaPlayer{
+		BaseBlock: ast.BaseBlock{},
+		Fname:     fname,
+		Label:     label,
+		Meta:      meta,
+	}
+}
+
+type mediaPlayerTransformer struct {
+	mediaReplacer types.Replacer[string]
+	mediaMeta     types.MediaMetaGetter
+}
+
+func NewMediaPlayerTransformer(mediaReplacer types.Replacer[string], mediaMeta types.MediaMetaGetter) *mediaPlayerTransformer {
+	return &mediaPlayerTransformer{
+		mediaReplacer: mediaReplacer,
+		mediaMeta:     mediaMeta,
+	}
+}
+
+// Transform replaces the paragraphs that consist of a single link
+// or a file name of the uploaded clip with the player
+func (p *mediaPlayerTransformer) Transform(node *ast.Paragraph, reader text.Reader, pc parser.Context) {
+	// only the uploads have meta, there is nothing to play otherwise
+	if p.mediaMeta == nil {
+		return
+	}
+
+	lines := node.Lines()
+
+	if lines.Len() != 1 {
+		return
+	}
+
+	line := lines.At(0)
+
+	content := util.TrimLeftSpace(util.TrimRightSpace(line.Value(reader.Source())))
+
+	var fname, label string
+
+	if groups := linkRe.FindSubmatch(content); groups != nil {
+		label = string(groups[1])
+		fname = string(groups[2])
+	} else if bareNameRe.Match(content) {
+		fname = string(content)
+	} else {
+		return
+	}
+
+	if ok, _ := p.mediaReplacer(fname); !ok {
+		return
+	}
+
+	meta, ok := p.mediaMeta(fname)
+
+	if !ok || (meta.Kind != types.MediaKindVideo && meta.Kind != types.MediaKindAudio) {
+		return
+	}
+
+	newNode := NewMediaPlayer(fname, label, meta)
+
+	newNode.SetBlankPreviousLines(node.HasBlankPreviousLines())
+	node.Parent().ReplaceChild(node.Parent(), node, newNode)
+}
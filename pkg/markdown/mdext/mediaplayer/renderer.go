@@ -0,0 +1,113 @@
+package mediaplayer
+
+import (
+	"github.com/can3p/pcom/pkg/types"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/util"
+)
+
+type MediaPlayerRenderer struct {
+	html.Config
+	view          types.HTMLView
+	mediaReplacer types.Replacer[string]
+}
+
+func NewMediaPlayerRenderer(view types.HTMLView, mediaReplacer types.Replacer[string], opts ...html.Option) renderer.NodeRenderer {
+	r := &MediaPlayerRenderer{
+		Config:        html.NewConfig(),
+		view:          view,
+		mediaReplacer: mediaReplacer,
+	}
+	for _, opt := range opts {
+		opt.SetHTMLOption(&r.Config)
+	}
+	return r
+}
+
+func (r *MediaPlayerRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(KindMediaPlayer, r.renderMediaPlayer)
+}
+
+func (r *MediaPlayerRenderer) renderMediaPlayer(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	n := node.(*MediaPlayer)
+
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	kind := "video"
+
+	if n.Meta.Kind == types.MediaKindAudio {
+		kind = "audio"
+	}
+
+	if n.Meta.Processing {
+		_, _ = w.WriteString(`<p class="media-processing">The ` + kind + " is still being processed, check back in a few minutes</p>\n")
+		return ast.WalkSkipChildren, nil
+	}
+
+	if n.Meta.Failed {
+		_, _ = w.WriteString(`<p class="media-processing">The ` + kind + " could not be processed</p>\n")
+		return ast.WalkSkipChildren, nil
+	}
+
+	_, link := r.mediaReplacer(n.Fname)
+	label := n.Label
+
+	if label == "" {
+		label = n.Meta.AltText
+	}
+
+	// there is no way to play anything in emails and rss readers
+	if r.view == types.ViewEmail || r.view == types.ViewRSS {
+		_, _ = w.WriteString(`<p><a href="`)
+		_, _ = w.Write(util.EscapeHTML(util.URLEscape([]byte(link+"/stream"), true)))
+		_, _ = w.WriteString(`">`)
+
+		if n.Meta.HasPoster {
+			_, _ = w.WriteString(`<img src="`)
+			_, _ = w.Write(util.EscapeHTML(util.URLEscape([]byte(link+"/poster"), true)))
+			_, _ = w.WriteString(`" alt="`)
+			_, _ = w.Write(util.EscapeHTML([]byte(label)))
+			_, _ = w.WriteString(`">`)
+		} else if kind == "audio" {
+			_, _ = w.WriteString("Listen to the audio")
+		} else {
+			_, _ = w.WriteString("Watch the video")
+		}
+
+		_, _ = w.WriteString("</a></p>\n")
+
+		return ast.WalkSkipChildren, nil
+	}
+
+	_, _ = w.WriteString(`<p><` + kind + ` class="mx-auto d-block standalone-` + kind + `" controls preload="none"`)
+
+	if n.Meta.HasPoster {
+		_, _ = w.WriteString(` poster="`)
+		_, _ = w.Write(util.EscapeHTML(util.URLEscape([]byte(link+"/poster"), true)))
+		_ = w.WriteByte('"')
+	}
+
+	_, _ = w.WriteString(` src="`)
+	_, _ = w.Write(util.EscapeHTML(util.URLEscape([]byte(link+"/stream"), true)))
+	_ = w.WriteByte('"')
+
+	if label != "" {
+		_, _ = w.WriteString(` aria-label="`)
+		_, _ = w.Write(util.EscapeHTML([]byte(label)))
+		_ = w.WriteByte('"')
+	}
+
+	if n.Meta.Caption != "" {
+		_, _ = w.WriteString(` title="`)
+		_, _ = w.Write(util.EscapeHTML([]byte(n.Meta.Caption)))
+		_ = w.WriteByte('"')
+	}
+
+	_, _ = w.WriteString("></" + kind + "></p>\n")
+
+	return ast.WalkSkipChildren, nil
+}
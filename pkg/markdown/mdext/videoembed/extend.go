@@ -10,21 +10,23 @@ import (
 )
 
 type videoEmbed struct {
-	view types.HTMLView
+	view    types.HTMLView
+	players media.PlayerLookup
 }
 
 // NewVideoEmbed creates a new [goldmark.Extender] that
 // allow you to parse text that seems like a @uservideoEmbed
-func NewVideoEmbedExtender(view types.HTMLView) goldmark.Extender {
+func NewVideoEmbedExtender(view types.HTMLView, players media.PlayerLookup) goldmark.Extender {
 	return &videoEmbed{
-		view: view,
+		view:    view,
+		players: players,
 	}
 }
 
 func (e *videoEmbed) Extend(m goldmark.Markdown) {
 	m.Parser().AddOptions(
 		parser.WithParagraphTransformers(
-			util.Prioritized(NewVideoEmbedTransformer(media.DefaultParser()), 500),
+			util.Prioritized(NewVideoEmbedTransformer(media.DefaultParser(e.players)), 500),
 		),
 	)
 
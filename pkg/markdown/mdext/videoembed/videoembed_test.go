@@ -18,7 +18,7 @@ func TestVideoEmbed(t *testing.T) {
 	parser := goldmark.New(
 		goldmark.WithParserOptions(
 			parser.WithParagraphTransformers(
-				util.Prioritized(NewVideoEmbedTransformer(media.DefaultParser()), 999)),
+				util.Prioritized(NewVideoEmbedTransformer(media.DefaultParser(nil)), 999)),
 		),
 		goldmark.WithRendererOptions(
 			renderer.WithNodeRenderers(
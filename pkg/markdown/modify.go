@@ -6,6 +6,8 @@ import (
 	"log"
 	"strings"
 
+	"github.com/can3p/pcom/pkg/markdown/mdext/blocktags"
+	"github.com/can3p/pcom/pkg/markdown/mdext/linkcard"
 	"github.com/can3p/pcom/pkg/types"
 	markdown "github.com/teekennedy/goldmark-markdown"
 	"github.com/yuin/goldmark"
@@ -165,6 +167,107 @@ func ExtractImageUrls(md string) []string {
 	return urls
 }
 
+// ExtractMediaUrls works as ExtractImageUrls, but also returns the destinations
+// of the links and the paragraphs of a single word, since both
+// can be used to embed uploaded video and audio
+func ExtractMediaUrls(md string) []string {
+	var urls []string
+
+	source := []byte(md)
+	reader := text.NewReader(source)
+	// block tags are parsed to not confuse their lines with the names of the files
+	doc := parser.NewParser(
+		parser.WithBlockParsers(append(parser.DefaultBlockParsers(),
+			util.Prioritized(blocktags.NewBlockTagParser(blocktags.DefaultTags), 999))...),
+		parser.WithInlineParsers(parser.DefaultInlineParsers()...),
+		parser.WithParagraphTransformers(parser.DefaultParagraphTransformers()...),
+	).Parse(reader)
+
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch n := node.(type) {
+		case *ast.Image:
+			urls = append(urls, string(n.Destination))
+		case *ast.Link:
+			urls = append(urls, string(n.Destination))
+		case *ast.Paragraph:
+			if n.Lines().Len() != 1 {
+				break
+			}
+
+			line := n.Lines().At(0)
+			word := strings.TrimSpace(string(line.Value(source)))
+
+			if word != "" && !strings.ContainsAny(word, " \t") {
+				urls = append(urls, word)
+			}
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	return urls
+}
+
+// ExtractStandaloneLinks returns the links that take the whole paragraph,
+// these are the ones rendered as embeds or preview cards
+func ExtractStandaloneLinks(md string) []string {
+	var urls []string
+
+	source := []byte(md)
+	reader := text.NewReader(source)
+	doc := goldmark.DefaultParser().Parse(reader)
+
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		if p, ok := node.(*ast.Paragraph); ok && p.Lines().Len() == 1 {
+			line := p.Lines().At(0)
+
+			if url := linkcard.StandaloneURL(line.Value(source)); url != "" {
+				urls = append(urls, url)
+			}
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	return urls
+}
+
+// ExtractImageUrlsWithoutAltText returns the urls of the images
+// that have no alt text in the markdown itself
+func ExtractImageUrlsWithoutAltText(md string) []string {
+	var urls []string
+
+	source := []byte(md)
+	reader := text.NewReader(source)
+	doc := goldmark.DefaultParser().Parse(reader)
+
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		if node.Kind() == ast.KindImage {
+			imgNode := node.(*ast.Image)
+
+			if strings.TrimSpace(string(imgNode.Text(source))) == "" { //nolint:staticcheck
+				urls = append(urls, string(imgNode.Destination))
+			}
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	return urls
+}
+
 func ReplaceImageUrlsOrLinkify(md string, replace ErrorReplacer) (string, error) {
 	t := &imgReplaceOrLinkifyTransformer{
 		Replacer: replace,
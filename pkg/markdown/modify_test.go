@@ -85,3 +85,39 @@ func TestReplaceImageUrlsOrLinkify(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractImageUrlsWithoutAltText(t *testing.T) {
+	src := `![](empty.jpg) ![a cat](cat.jpg)
+
+![  ](spaces.jpg)
+
+{gallery}
+![](gallery.jpg)
+{/gallery}`
+
+	assert.Equal(t, []string{"empty.jpg", "spaces.jpg", "gallery.jpg"}, ExtractImageUrlsWithoutAltText(src))
+}
+
+func TestExtractMediaUrls(t *testing.T) {
+	src := `![a cat](cat.jpg) and [the clip](clip.mp4)
+
+{video}
+video.mov
+{/video}
+
+just some words`
+
+	assert.Equal(t, []string{"cat.jpg", "clip.mp4", "video.mov"}, ExtractMediaUrls(src))
+}
+
+func TestExtractStandaloneLinks(t *testing.T) {
+	src := `https://example.com/first
+
+<https://example.com/second>
+
+the link is a part of paragraph https://example.com/third
+
+[a link with text](https://example.com/fourth)`
+
+	assert.Equal(t, []string{"https://example.com/first", "https://example.com/second"}, ExtractStandaloneLinks(src))
+}
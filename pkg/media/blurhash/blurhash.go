@@ -0,0 +1,289 @@
+// Package blurhash implements https://blurha.sh encoding, which packs
+// a blurred preview of an image into a short string
+package blurhash
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"strings"
+)
+
+const characters = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz#$%*+,-.:;=?@[]^_{|}~"
+
+// Encode computes the hash of the image with the given number of
+// components on each axis, both should be within [1, 9]
+func Encode(img image.Image, xComponents int, yComponents int) (string, error) {
+	if xComponents < 1 || xComponents > 9 || yComponents < 1 || yComponents > 9 {
+		return "", fmt.Errorf("blurhash components should be between 1 and 9, got %dx%d", xComponents, yComponents)
+	}
+
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	if width == 0 || height == 0 {
+		return "", fmt.Errorf("cannot encode an empty image")
+	}
+
+	// linear rgb values are reused by every component
+	pixels := make([][3]float64, width*height)
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			r, g, b, _ := img.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+
+			pixels[y*width+x] = [3]float64{
+				sRGBToLinear(int(r >> 8)),
+				sRGBToLinear(int(g >> 8)),
+				sRGBToLinear(int(b >> 8)),
+			}
+		}
+	}
+
+	factors := make([][3]float64, 0, xComponents*yComponents)
+
+	for j := 0; j < yComponents; j++ {
+		for i := 0; i < xComponents; i++ {
+			normalisation := 2.0
+			if i == 0 && j == 0 {
+				normalisation = 1.0
+			}
+
+			var factor [3]float64
+
+			for y := 0; y < height; y++ {
+				for x := 0; x < width; x++ {
+					basis := normalisation *
+						math.Cos(math.Pi*float64(i)*float64(x)/float64(width)) *
+						math.Cos(math.Pi*float64(j)*float64(y)/float64(height))
+
+					pixel := pixels[y*width+x]
+
+					factor[0] += basis * pixel[0]
+					factor[1] += basis * pixel[1]
+					factor[2] += basis * pixel[2]
+				}
+			}
+
+			scale := 1.0 / float64(width*height)
+
+			factors = append(factors, [3]float64{factor[0] * scale, factor[1] * scale, factor[2] * scale})
+		}
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(encode83((xComponents-1)+(yComponents-1)*9, 1))
+
+	maximumValue := 1.0
+
+	if len(factors) > 1 {
+		actualMaximumValue := 0.0
+
+		for _, factor := range factors[1:] {
+			actualMaximumValue = math.Max(actualMaximumValue, math.Max(math.Abs(factor[0]), math.Max(math.Abs(factor[1]), math.Abs(factor[2]))))
+		}
+
+		quantisedMaximumValue := int(math.Max(0, math.Min(82, math.Floor(actualMaximumValue*166-0.5))))
+		maximumValue = float64(quantisedMaximumValue+1) / 166
+
+		sb.WriteString(encode83(quantisedMaximumValue, 1))
+	} else {
+		sb.WriteString(encode83(0, 1))
+	}
+
+	dc := factors[0]
+	sb.WriteString(encode83(linearToSRGB(dc[0])<<16+linearToSRGB(dc[1])<<8+linearToSRGB(dc[2]), 4))
+
+	for _, factor := range factors[1:] {
+		quantR := quantiseAC(factor[0], maximumValue)
+		quantG := quantiseAC(factor[1], maximumValue)
+		quantB := quantiseAC(factor[2], maximumValue)
+
+		sb.WriteString(encode83(quantR*19*19+quantG*19+quantB, 2))
+	}
+
+	return sb.String(), nil
+}
+
+// Decode renders the hash into the image of the given size
+func Decode(hash string, width int, height int) (image.Image, error) {
+	xComponents, yComponents, err := components(hash)
+
+	if err != nil {
+		return nil, err
+	}
+
+	quantisedMaximumValue, err := decode83(hash[1:2])
+
+	if err != nil {
+		return nil, err
+	}
+
+	maximumValue := float64(quantisedMaximumValue+1) / 166
+
+	colors := make([][3]float64, xComponents*yComponents)
+
+	for idx := range colors {
+		if idx == 0 {
+			value, err := decode83(hash[2:6])
+
+			if err != nil {
+				return nil, err
+			}
+
+			colors[idx] = decodeDC(value)
+			continue
+		}
+
+		value, err := decode83(hash[4+idx*2 : 6+idx*2])
+
+		if err != nil {
+			return nil, err
+		}
+
+		colors[idx] = decodeAC(value, maximumValue)
+	}
+
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			var r, g, b float64
+
+			for j := 0; j < yComponents; j++ {
+				for i := 0; i < xComponents; i++ {
+					basis := math.Cos(math.Pi*float64(x)*float64(i)/float64(width)) *
+						math.Cos(math.Pi*float64(y)*float64(j)/float64(height))
+
+					c := colors[i+j*xComponents]
+
+					r += c[0] * basis
+					g += c[1] * basis
+					b += c[2] * basis
+				}
+			}
+
+			img.SetNRGBA(x, y, color.NRGBA{
+				R: uint8(linearToSRGB(r)),
+				G: uint8(linearToSRGB(g)),
+				B: uint8(linearToSRGB(b)),
+				A: 255,
+			})
+		}
+	}
+
+	return img, nil
+}
+
+// AverageColor returns the color of the dc component in css hex notation
+func AverageColor(hash string) (string, error) {
+	if _, _, err := components(hash); err != nil {
+		return "", err
+	}
+
+	value, err := decode83(hash[2:6])
+
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("#%06x", value), nil
+}
+
+func components(hash string) (int, int, error) {
+	if len(hash) < 6 {
+		return 0, 0, fmt.Errorf("blurhash is too short: %q", hash)
+	}
+
+	sizeFlag, err := decode83(hash[0:1])
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	xComponents := sizeFlag%9 + 1
+	yComponents := sizeFlag/9 + 1
+
+	if expected := 4 + 2*xComponents*yComponents; len(hash) != expected {
+		return 0, 0, fmt.Errorf("blurhash length should be %d, got %d", expected, len(hash))
+	}
+
+	return xComponents, yComponents, nil
+}
+
+func quantiseAC(value float64, maximumValue float64) int {
+	return int(math.Max(0, math.Min(18, math.Floor(signPow(value/maximumValue, 0.5)*9+9.5))))
+}
+
+func decodeDC(value int) [3]float64 {
+	return [3]float64{
+		sRGBToLinear(value >> 16),
+		sRGBToLinear((value >> 8) & 255),
+		sRGBToLinear(value & 255),
+	}
+}
+
+func decodeAC(value int, maximumValue float64) [3]float64 {
+	quantR := value / (19 * 19)
+	quantG := (value / 19) % 19
+	quantB := value % 19
+
+	return [3]float64{
+		signPow((float64(quantR)-9)/9, 2) * maximumValue,
+		signPow((float64(quantG)-9)/9, 2) * maximumValue,
+		signPow((float64(quantB)-9)/9, 2) * maximumValue,
+	}
+}
+
+func sRGBToLinear(value int) float64 {
+	v := float64(value) / 255
+
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+func linearToSRGB(value float64) int {
+	v := math.Max(0, math.Min(1, value))
+
+	if v <= 0.0031308 {
+		return int(v*12.92*255 + 0.5)
+	}
+
+	return int((1.055*math.Pow(v, 1/2.4)-0.055)*255 + 0.5)
+}
+
+func signPow(value float64, exp float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(value), exp), value)
+}
+
+func encode83(value int, length int) string {
+	out := make([]byte, length)
+
+	for i := 1; i <= length; i++ {
+		digit := (value / int(math.Pow(83, float64(length-i)))) % 83
+		out[i-1] = characters[digit]
+	}
+
+	return string(out)
+}
+
+func decode83(s string) (int, error) {
+	value := 0
+
+	for _, c := range s {
+		digit := strings.IndexRune(characters, c)
+
+		if digit < 0 {
+			return 0, fmt.Errorf("invalid blurhash character %q", c)
+		}
+
+		value = value*83 + digit
+	}
+
+	return value, nil
+}
@@ -0,0 +1,65 @@
+package blurhash
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 32, 24))
+
+	// left half is red, right half is blue
+	for y := 0; y < 24; y++ {
+		for x := 0; x < 32; x++ {
+			c := color.NRGBA{R: 255, A: 255}
+			if x >= 16 {
+				c = color.NRGBA{B: 255, A: 255}
+			}
+			img.SetNRGBA(x, y, c)
+		}
+	}
+
+	hash, err := Encode(img, 4, 3)
+	require.NoError(t, err)
+	assert.Len(t, hash, 4+2*4*3)
+
+	decoded, err := Decode(hash, 32, 24)
+	require.NoError(t, err)
+
+	left := color.NRGBAModel.Convert(decoded.At(2, 12)).(color.NRGBA)
+	right := color.NRGBAModel.Convert(decoded.At(29, 12)).(color.NRGBA)
+
+	assert.Greater(t, left.R, left.B, "left side stays red")
+	assert.Greater(t, right.B, right.R, "right side stays blue")
+
+	avg, err := AverageColor(hash)
+	require.NoError(t, err)
+	assert.Regexp(t, `^#[0-9a-f]{6}$`, avg)
+}
+
+func TestDecodeKnownHash(t *testing.T) {
+	// the example from the reference implementation
+	hash := "LEHV6nWB2yk8pyo0adR*.7kCMdnj"
+
+	_, err := Decode(hash, 8, 6)
+	require.NoError(t, err)
+
+	avg, err := AverageColor(hash)
+	require.NoError(t, err)
+	assert.Equal(t, "#979695", avg)
+}
+
+func TestInvalidHash(t *testing.T) {
+	_, err := Decode("LEHV6n", 8, 6)
+	assert.Error(t, err)
+
+	_, err = AverageColor("too short")
+	assert.Error(t, err)
+
+	_, err = Encode(image.NewNRGBA(image.Rect(0, 0, 4, 4)), 10, 3)
+	assert.Error(t, err)
+}
@@ -0,0 +1,83 @@
+package media
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/can3p/pcom/pkg/media/server"
+	"github.com/can3p/pcom/pkg/model/core"
+)
+
+// Clips are served in classes of their own, images
+// get resized, while clips have a rendition and a poster
+const (
+	ClipClassStream = "stream"
+	ClipClassPoster = "poster"
+)
+
+// ServeClip serves the rendition of the video or audio with range support,
+// so that players can seek, or the poster frame of the video in thumb size
+func ServeClip(ctx context.Context, storage server.MediaStorage, getter server.MediaGetter, req *http.Request, w http.ResponseWriter, upload *core.MediaUpload, class string) error {
+	ready := upload.RenditionStatus.Valid && upload.RenditionStatus.Val == core.MediaRenditionStatusReady
+
+	switch {
+	case class == ClipClassStream && ready && upload.RenditionFname.Valid:
+		return serveRendition(ctx, storage, req, w, upload)
+	case class == ClipClassPoster && upload.PosterFname.Valid:
+		out, ct, err := getter.GetImage(ctx, upload.PosterFname.String, "thumb", server.NegotiateFormat(req))
+
+		if err != nil {
+			return err
+		}
+
+		if closer, ok := out.(io.Closer); ok {
+			defer func() {
+				if err := closer.Close(); err != nil {
+					slog.Warn("Failed to close the reader", "err", err)
+				}
+			}()
+		}
+
+		w.Header().Set("Content-Type", ct)
+		w.Header().Set("Vary", "Accept")
+
+		_, err = io.Copy(w, out)
+
+		return err
+	}
+
+	w.WriteHeader(http.StatusNotFound)
+
+	return nil
+}
+
+func serveRendition(ctx context.Context, storage server.MediaStorage, req *http.Request, w http.ResponseWriter, upload *core.MediaUpload) error {
+	// renditions can be large and players request them in ranges
+	// while seeking, only the requested part is read from the storage
+	f, err := storage.OpenFile(ctx, upload.RenditionFname.String)
+
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := f.Close(); err != nil {
+			slog.Warn("Error closing the rendition", "err", err.Error())
+		}
+	}()
+
+	contentType := "video/mp4"
+
+	if strings.EqualFold(filepath.Ext(upload.RenditionFname.String), ".m4a") {
+		contentType = "audio/mp4"
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	http.ServeContent(w, req, upload.RenditionFname.String, upload.UpdatedAt, f)
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package media
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestServeClipRange(t *testing.T) {
+	storage := &memoryStorage{files: map[string][]byte{
+		"clip.mp4": []byte("0123456789"),
+	}}
+
+	upload := &core.MediaUpload{
+		RenditionStatus: core.NullMediaRenditionStatusFrom(core.MediaRenditionStatusReady),
+		RenditionFname:  null.StringFrom("clip.mp4"),
+	}
+
+	req := httptest.NewRequest("GET", "/user-media/clip.mov/stream", nil)
+	req.Header.Set("Range", "bytes=2-5")
+
+	w := httptest.NewRecorder()
+
+	assert.NoError(t, ServeClip(context.Background(), storage, nil, req, w, upload, ClipClassStream))
+	assert.Equal(t, http.StatusPartialContent, w.Code)
+	assert.Equal(t, "bytes 2-5/10", w.Header().Get("Content-Range"))
+	assert.Equal(t, "video/mp4", w.Header().Get("Content-Type"))
+	assert.Equal(t, "2345", w.Body.String())
+}
@@ -0,0 +1,62 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net/http"
+	"slices"
+)
+
+var (
+	avifBrands = []string{"avif", "avis"}
+	heicBrands = []string{"heic", "heix", "heim", "heis", "hevc", "hevx", "mif1", "msf1"}
+	m4aBrands  = []string{"M4A ", "M4B "}
+)
+
+const quicktimeBrand = "qt  "
+
+// DetectContentType extends http.DetectContentType with the formats
+// based on ISO base media file format, which go knows nothing about
+func DetectContentType(b []byte) string {
+	brands := ftypBrands(b)
+
+	switch {
+	// avif files often declare mif1 as the major brand,
+	// hence avif brands should be checked first
+	case slices.ContainsFunc(brands, func(brand string) bool { return slices.Contains(avifBrands, brand) }):
+		return "image/avif"
+	case slices.ContainsFunc(brands, func(brand string) bool { return slices.Contains(heicBrands, brand) }):
+		return "image/heic"
+	// audio files list generic mp4 brands as compatible ones,
+	// only the major brand tells them apart from videos
+	case len(brands) > 0 && slices.Contains(m4aBrands, brands[0]):
+		return "audio/mp4"
+	case len(brands) > 0 && brands[0] == quicktimeBrand:
+		return "video/quicktime"
+	}
+
+	return http.DetectContentType(b)
+}
+
+// ftypBrands returns major and compatible brands of the ftyp box,
+// which has to be the first box of the file
+func ftypBrands(b []byte) []string {
+	if len(b) < 16 || !bytes.Equal(b[4:8], []byte("ftyp")) {
+		return nil
+	}
+
+	boxSize := int(binary.BigEndian.Uint32(b[0:4]))
+
+	if boxSize < 16 || boxSize > len(b) {
+		return nil
+	}
+
+	brands := []string{string(b[8:12])}
+
+	// bytes 12-16 are the minor version
+	for offset := 16; offset+4 <= boxSize; offset += 4 {
+		brands = append(brands, string(b[offset:offset+4]))
+	}
+
+	return brands
+}
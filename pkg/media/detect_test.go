@@ -0,0 +1,49 @@
+package media
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ftypBox(major string, compatible ...string) []byte {
+	size := 16 + 4*len(compatible)
+	b := make([]byte, size, size+8)
+
+	binary.BigEndian.PutUint32(b[0:4], uint32(size))
+	copy(b[4:8], "ftyp")
+	copy(b[8:12], major)
+
+	for idx, brand := range compatible {
+		copy(b[16+4*idx:], brand)
+	}
+
+	// beginning of the next box
+	return append(b, 0, 0, 0, 8, 'm', 'e', 't', 'a')
+}
+
+func TestDetectContentType(t *testing.T) {
+	testCases := []struct {
+		name     string
+		content  []byte
+		expected string
+	}{
+		{"avif", ftypBox("avif", "mif1", "miaf"), "image/avif"},
+		{"avif with generic major brand", ftypBox("mif1", "avif", "miaf"), "image/avif"},
+		{"animated avif", ftypBox("avis", "msf1"), "image/avif"},
+		{"heic", ftypBox("heic", "mif1", "heic"), "image/heic"},
+		{"heif with generic major brand", ftypBox("mif1", "heic"), "image/heic"},
+		{"mp4 video", ftypBox("isom", "iso2", "mp41"), "video/mp4"},
+		{"quicktime video", ftypBox("qt  ", "qt  "), "video/quicktime"},
+		{"m4a audio", ftypBox("M4A ", "M4A ", "mp42", "isom"), "audio/mp4"},
+		{"gif", []byte("GIF89a\x01\x00\x01\x00"), "image/gif"},
+		{"broken box size", append([]byte{0xff, 0xff, 0xff, 0xff}, ftypBox("avif")[4:]...), "application/octet-stream"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, DetectContentType(tc.content))
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"image/png"
+	"log/slog"
+	"strings"
+
+	"github.com/can3p/pcom/pkg/media/blurhash"
+	"github.com/can3p/pcom/pkg/media/server"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/pkg/types"
+	"github.com/davidbyttow/govips/v2/vips"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// ThumbClass and FullClass are the sizes the uploaded images are served in
+var (
+	ThumbClass = server.ClassParams{Width: 720, Height: 540}
+	FullClass  = server.ClassParams{Width: 1200, Height: 900}
+)
+
+// blurhash is computed from a tiny copy, there is no detail in it anyway
+const (
+	hashSourceSize  = 32
+	hashXComponents = 4
+	hashYComponents = 3
+	// the placeholder is stretched by the browser, no reason to make it bigger
+	placeholderSize = 32
+)
+
+const metaCacheSize = 4096
+
+// MaxDescriptionLength limits both the alt text and the caption
+const MaxDescriptionLength = 1000
+
+// uploads never change once processed, the computed meta can be kept forever
+var metaCache = func() *lru.Cache[string, *types.MediaMeta] {
+	cache, err := lru.New[string, *types.MediaMeta](metaCacheSize)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return cache
+}()
+
+type ImageInfo struct {
+	Width    int
+	Height   int
+	Blurhash string
+}
+
+// AnalyzeImage returns the dimensions and the blurhash of the image.
+// Only the first frame is taken into account for animated images
+func AnalyzeImage(b []byte) (*ImageInfo, error) {
+	img, err := vips.NewImageFromBuffer(b)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer img.Close()
+
+	info := &ImageInfo{
+		Width:  img.Width(),
+		Height: img.Height(),
+	}
+
+	if info.Width == 0 || info.Height == 0 {
+		return nil, errors.Errorf("image has no dimensions")
+	}
+
+	if err := img.Resize(ThumbnailScale(info.Width, info.Height, hashSourceSize), vips.KernelLinear); err != nil {
+		return nil, err
+	}
+
+	small, _, err := img.Export(vips.NewDefaultPNGExportParams())
+
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := png.Decode(bytes.NewReader(small))
+
+	if err != nil {
+		return nil, err
+	}
+
+	info.Blurhash, err = blurhash.Encode(decoded, hashXComponents, hashYComponents)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// ThumbnailScale returns the factor to make the longest side of the image equal to size
+func ThumbnailScale(width int, height int, size int) float64 {
+	return float64(size) / float64(max(width, height))
+}
+
+// MetaGetter returns the meta of the uploaded images with dimensions
+// matching the thumb class, since it's what the renderers show
+func MetaGetter(ctx context.Context, exec boil.ContextExecutor) types.MediaMetaGetter {
+	return func(fname string) (types.MediaMeta, bool) {
+		meta, ok := metaCache.Get(fname)
+
+		if !ok {
+			var err error
+			meta, err = loadMeta(ctx, exec, fname)
+
+			if err != nil {
+				slog.Warn("Failed to load media meta", "fname", fname, "err", err.Error())
+				return types.MediaMeta{}, false
+			}
+
+			// the clips that are still being processed are going to change
+			if meta == nil || !meta.Processing {
+				metaCache.Add(fname, meta)
+			}
+		}
+
+		// files we know nothing about
+		if meta == nil {
+			return types.MediaMeta{}, false
+		}
+
+		out := *meta
+
+		if meta.Width > 0 && meta.Height > 0 {
+			out.Width, out.Height = ThumbClass.Fit(meta.Width, meta.Height)
+		}
+
+		return out, true
+	}
+}
+
+func loadMeta(ctx context.Context, exec boil.ContextExecutor, fname string) (*types.MediaMeta, error) {
+	upload, err := core.MediaUploads(
+		core.MediaUploadWhere.UploadedFname.EQ(fname),
+	).One(ctx, exec)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	meta := &types.MediaMeta{
+		Kind:    types.MediaKind(upload.Kind),
+		AltText: upload.AltText.String,
+		Caption: upload.Caption.String,
+	}
+
+	if upload.RenditionStatus.Valid {
+		meta.Processing = upload.RenditionStatus.Val == core.MediaRenditionStatusPending ||
+			upload.RenditionStatus.Val == core.MediaRenditionStatusProcessing
+		meta.Failed = upload.RenditionStatus.Val == core.MediaRenditionStatusFailed
+		meta.HasPoster = upload.PosterFname.Valid
+	}
+
+	// legacy uploads have no dimensions
+	if !upload.Width.Valid || !upload.Height.Valid {
+		return meta, nil
+	}
+
+	meta.Width = upload.Width.Int
+	meta.Height = upload.Height.Int
+
+	if upload.Blurhash.Valid {
+		if meta.Color, err = blurhash.AverageColor(upload.Blurhash.String); err != nil {
+			return nil, err
+		}
+
+		if meta.Placeholder, err = placeholderURI(upload.Blurhash.String, meta.Width, meta.Height); err != nil {
+			return nil, err
+		}
+	}
+
+	return meta, nil
+}
+
+// SetDescription updates the alt text and the caption the image gets
+// whenever markdown does not specify them
+func SetDescription(ctx context.Context, exec boil.ContextExecutor, userID string, fname string, altText string, caption string) error {
+	upload, err := core.MediaUploads(
+		core.MediaUploadWhere.UserID.EQ(null.StringFrom(userID)),
+		core.MediaUploadWhere.UploadedFname.EQ(fname),
+	).One(ctx, exec)
+
+	if err != nil {
+		return err
+	}
+
+	altText = strings.TrimSpace(altText)
+	caption = strings.TrimSpace(caption)
+
+	upload.AltText = null.NewString(altText, altText != "")
+	upload.Caption = null.NewString(caption, caption != "")
+
+	if _, err := upload.Update(ctx, exec, boil.Whitelist(
+		core.MediaUploadColumns.AltText,
+		core.MediaUploadColumns.Caption,
+		core.MediaUploadColumns.UpdatedAt,
+	)); err != nil {
+		return err
+	}
+
+	metaCache.Remove(fname)
+
+	return nil
+}
+
+func placeholderURI(hash string, width int, height int) (string, error) {
+	scale := ThumbnailScale(width, height, placeholderSize)
+
+	img, err := blurhash.Decode(hash, max(1, int(float64(width)*scale)), max(1, int(float64(height)*scale)))
+
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+
+	if err := png.Encode(&buf, img); err != nil {
+		return "", err
+	}
+
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
@@ -0,0 +1,72 @@
+package media
+
+import (
+	"context"
+
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var ErrQuotaExceeded = errors.New("media storage quota exceeded, delete unused files from the media library")
+
+type Quota struct {
+	UsedBytes  int64
+	LimitBytes int64
+}
+
+func (q Quota) Allows(size int64) bool {
+	return q.UsedBytes+size <= q.LimitBytes
+}
+
+func (q Quota) UsedPercent() int64 {
+	if q.LimitBytes <= 0 {
+		return 100
+	}
+
+	return min(100, q.UsedBytes*100/q.LimitBytes)
+}
+
+// GetUserQuota returns the storage used by the user along with the limit,
+// which is either set for the user specifically or taken from system settings
+func GetUserQuota(ctx context.Context, exec boil.ContextExecutor, userID string) (Quota, error) {
+	user, err := core.FindUser(ctx, exec, userID)
+
+	if err != nil {
+		return Quota{}, err
+	}
+
+	var out Quota
+
+	if user.MediaQuotaBytes.Valid {
+		out.LimitBytes = user.MediaQuotaBytes.Int64
+	} else {
+		settings, err := core.SystemSettings().One(ctx, exec)
+
+		if err != nil {
+			return Quota{}, err
+		}
+
+		out.LimitBytes = settings.DefaultMediaQuotaBytes
+	}
+
+	var used struct {
+		UsedBytes int64 `boil:"used_bytes"`
+	}
+
+	err = core.NewQuery(
+		qm.Select("coalesce(sum(size_bytes), 0) as used_bytes"),
+		qm.From(core.TableNames.MediaUploads),
+		core.MediaUploadWhere.UserID.EQ(null.StringFrom(userID)),
+	).Bind(ctx, exec, &used)
+
+	if err != nil {
+		return Quota{}, err
+	}
+
+	out.UsedBytes = used.UsedBytes
+
+	return out, nil
+}
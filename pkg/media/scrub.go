@@ -0,0 +1,111 @@
+package media
+
+import (
+	"strings"
+
+	"github.com/davidbyttow/govips/v2/vips"
+	"github.com/pkg/errors"
+)
+
+// libvips puts gps tags into the third ifd
+const gpsExifPrefix = "exif-ifd3-"
+
+var scrubExportParams = map[string]func() *vips.ExportParams{
+	"image/png": vips.NewDefaultPNGExportParams,
+	"image/jpeg": func() *vips.ExportParams {
+		ep := vips.NewDefaultJPEGExportParams()
+		ep.Quality = 90
+		return ep
+	},
+	"image/webp": func() *vips.ExportParams {
+		ep := vips.NewDefaultWEBPExportParams()
+		ep.Quality = 90
+		return ep
+	},
+	"image/avif": func() *vips.ExportParams {
+		return &vips.ExportParams{
+			Format:  vips.ImageTypeAVIF,
+			Quality: 80,
+			Speed:   6,
+		}
+	},
+}
+
+// ScrubMetadata rotates the image according to the orientation tag and
+// drops the metadata phones like to embed, location above all.
+// Camera exif can be preserved on request, gps tags are removed anyway.
+// The image keeps its original format where possible, the resulting
+// content type is returned along with the bytes
+func ScrubMetadata(b []byte, contentType string, keepCameraMetadata bool) ([]byte, string, error) {
+	// gif has no exif and re-encoding animations only makes them worse
+	if contentType == "image/gif" {
+		return b, contentType, nil
+	}
+
+	params := vips.NewImportParams()
+	// load all the frames of animated images
+	params.NumPages.Set(-1)
+
+	img, err := vips.LoadImageFromBuffer(b, params)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	defer img.Close()
+
+	outType := contentType
+
+	switch {
+	// heic encoders are rarely available and browsers cannot show heic anyway
+	case contentType == "image/heic":
+		outType = "image/jpeg"
+	// animated avif support is spotty, webp is a safe choice
+	case contentType == "image/avif" && img.Pages() > 1:
+		outType = "image/webp"
+	}
+
+	exportParams, ok := scrubExportParams[outType]
+
+	if !ok {
+		return nil, "", errors.Wrapf(ErrUnsupportedMimeType, "%s", contentType)
+	}
+
+	// animations never have orientation set
+	if img.Pages() == 1 {
+		// removes the orientation tag as well
+		if err := img.AutoRotate(); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err := img.RemoveMetadata(keptMetadataFields(img.ImageFields(), keepCameraMetadata)...); err != nil {
+		return nil, "", err
+	}
+
+	out, _, err := img.Export(exportParams())
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	return out, outType, nil
+}
+
+// keptMetadataFields picks the fields of the image that survive the scrubbing,
+// the exif block is regenerated on save from the fields that are left
+func keptMetadataFields(fields []string, keepCameraMetadata bool) []string {
+	var keep []string
+
+	if !keepCameraMetadata {
+		return keep
+	}
+
+	for _, field := range fields {
+		if strings.HasPrefix(field, "exif-") && !strings.HasPrefix(field, gpsExifPrefix) {
+			keep = append(keep, field)
+		}
+	}
+
+	return keep
+}
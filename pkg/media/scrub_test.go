@@ -0,0 +1,44 @@
+package media
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeptMetadataFields(t *testing.T) {
+	testCases := []struct {
+		field string
+		// whether the field is kept when the user asked to keep camera metadata,
+		// nothing is kept otherwise
+		kept bool
+	}{
+		{"exif-ifd0-Make", true},
+		{"exif-ifd0-Model", true},
+		{"exif-ifd2-ExposureTime", true},
+		{"exif-ifd2-FNumber", true},
+		{"exif-ifd2-ISOSpeedRatings", true},
+		{"exif-ifd2-DateTimeOriginal", true},
+		{"exif-ifd3-GPSLatitude", false},
+		{"exif-ifd3-GPSLongitude", false},
+		{"exif-ifd3-GPSAltitude", false},
+		{"xmp-data", false},
+		{"iptc-data", false},
+		{"orientation", false},
+		{"width", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.field, func(t *testing.T) {
+			kept := keptMetadataFields([]string{tc.field}, true)
+
+			if tc.kept {
+				assert.Equal(t, []string{tc.field}, kept)
+			} else {
+				assert.Empty(t, kept)
+			}
+
+			assert.Empty(t, keptMetadataFields([]string{tc.field}, false))
+		})
+	}
+}
@@ -14,7 +14,9 @@ import (
 
 const (
 	defaultCacheSize = 1000
-	cacheKeyFormat   = "%s/%s" // format: class/filename
+	cacheKeyFormat   = "%s/%s/%s" // format: class/format/filename
+	// variants used to be stored without format, they were always webp
+	legacyCacheKeyFormat = "%s/%s" // format: class/filename
 )
 
 type CachingServer struct {
@@ -41,8 +43,8 @@ func NewCachingServer(server MediaServer, storage MediaStorage, cacheSize int) (
 	}, nil
 }
 
-func (s *CachingServer) getCacheKey(fname, class string) string {
-	return fmt.Sprintf(cacheKeyFormat, class, fname)
+func (s *CachingServer) getCacheKey(fname, class string, format Format) string {
+	return fmt.Sprintf(cacheKeyFormat, class, format, fname)
 }
 
 func (s *CachingServer) getUploadLock(key string) *sync.Mutex {
@@ -55,8 +57,8 @@ func (s *CachingServer) removeUploadLock(key string) {
 	s.uploadLock.Delete(key)
 }
 
-func (s *CachingServer) GetImage(ctx context.Context, fname string, class string) (io.Reader, string, error) {
-	cacheKey := s.getCacheKey(fname, class)
+func (s *CachingServer) GetImage(ctx context.Context, fname string, class string, format Format) (io.Reader, string, error) {
+	cacheKey := s.getCacheKey(fname, class, format)
 
 	slog.Debug("GetImage called", "cacheKey", cacheKey)
 
@@ -128,7 +130,7 @@ func (s *CachingServer) GetImage(ctx context.Context, fname string, class string
 	}
 
 	// Get the image from parent server
-	reader, mime, err := s.MediaServer.GetImage(ctx, fname, class)
+	reader, mime, err := s.MediaServer.GetImage(ctx, fname, class, format)
 	if err != nil {
 		releaseLock()
 		return nil, "", err
@@ -160,3 +162,25 @@ func (s *CachingServer) GetImage(ctx context.Context, fname string, class string
 	// Return the image to the client immediately
 	return bytes.NewReader(buf.Bytes()), mime, nil
 }
+
+// DeleteImage removes the original file together with
+// all the class variants derived from it
+func (s *CachingServer) DeleteImage(ctx context.Context, fname string, classes []string) error {
+	for _, class := range classes {
+		cacheKeys := []string{fmt.Sprintf(legacyCacheKeyFormat, class, fname)}
+
+		for _, format := range Formats {
+			cacheKeys = append(cacheKeys, s.getCacheKey(fname, class, format))
+		}
+
+		for _, cacheKey := range cacheKeys {
+			if err := s.storage.Delete(ctx, cacheKey); err != nil {
+				return fmt.Errorf("failed to delete variant %s: %w", cacheKey, err)
+			}
+
+			s.cache.Remove(cacheKey)
+		}
+	}
+
+	return s.storage.Delete(ctx, fname)
+}
@@ -47,6 +47,21 @@ func (m *mockStorage) DownloadFile(ctx context.Context, fname string) (io.ReadCl
 	return nil, 0, "", errors.ErrNotFound
 }
 
+func (m *mockStorage) OpenFile(ctx context.Context, fname string) (io.ReadSeekCloser, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if data, ok := m.files[fname]; ok {
+		return nopSeekCloser{bytes.NewReader(data)}, nil
+	}
+	return nil, errors.ErrNotFound
+}
+
+type nopSeekCloser struct {
+	io.ReadSeeker
+}
+
+func (nopSeekCloser) Close() error { return nil }
+
 func (m *mockStorage) ObjectExists(ctx context.Context, fname string) (bool, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
@@ -55,12 +70,19 @@ func (m *mockStorage) ObjectExists(ctx context.Context, fname string) (bool, err
 	return exists, nil
 }
 
+func (m *mockStorage) Delete(ctx context.Context, fname string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.files, fname)
+	return nil
+}
+
 type mockServer struct {
 	callCount int
 	mu        sync.Mutex
 }
 
-func (m *mockServer) GetImage(ctx context.Context, fname string, class string) (io.Reader, string, error) {
+func (m *mockServer) GetImage(ctx context.Context, fname string, class string, format Format) (io.Reader, string, error) {
 	m.mu.Lock()
 	m.callCount++
 	m.mu.Unlock()
@@ -81,7 +103,7 @@ func TestCachingServer_GetImage(t *testing.T) {
 			t.Fatalf("Failed to create caching server: %v", err)
 		}
 
-		reader, mime, err := cache.GetImage(ctx, "test.jpg", "thumb")
+		reader, mime, err := cache.GetImage(ctx, "test.jpg", "thumb", FormatWebp)
 		if err != nil {
 			t.Fatalf("Failed to get image: %v", err)
 		}
@@ -102,7 +124,7 @@ func TestCachingServer_GetImage(t *testing.T) {
 
 		addCalls := 3
 		for range addCalls {
-			reader2, _, err2 := cache.GetImage(ctx, "test.jpg", "thumb")
+			reader2, _, err2 := cache.GetImage(ctx, "test.jpg", "thumb", FormatWebp)
 			require.NoError(t, err2)
 			data2, err2 := io.ReadAll(reader2)
 			require.NoError(t, err2)
@@ -112,6 +134,26 @@ func TestCachingServer_GetImage(t *testing.T) {
 		require.Equal(t, addCalls, storage.callCount.download, "Expected storage to be hit exactly the number of additional calls")
 	})
 
+	t.Run("formats are cached separately", func(t *testing.T) {
+		storage := newMockStorage()
+		server := &mockServer{}
+		cache, err := NewCachingServer(server, storage, 10)
+		require.NoError(t, err)
+
+		for _, format := range Formats {
+			reader, _, err := cache.GetImage(ctx, "test.jpg", "thumb", format)
+			require.NoError(t, err)
+			_, _ = io.ReadAll(reader)
+		}
+
+		require.Equal(t, len(Formats), server.callCount, "Expected every format to be generated")
+
+		require.Eventually(t, func() bool {
+			exists, _ := storage.ObjectExists(ctx, "thumb/avif/test.jpg")
+			return exists
+		}, 5*time.Second, 10*time.Millisecond, "Expected avif variant to be cached")
+	})
+
 	t.Run("concurrent requests for same image", func(t *testing.T) {
 		storage := newMockStorage()
 		server := &mockServer{}
@@ -123,7 +165,7 @@ func TestCachingServer_GetImage(t *testing.T) {
 		var wg sync.WaitGroup
 		for range 5 {
 			wg.Go(func() {
-				reader, _, err := cache.GetImage(ctx, "new.jpg", "thumb")
+				reader, _, err := cache.GetImage(ctx, "new.jpg", "thumb", FormatWebp)
 				if err != nil {
 					t.Errorf("Failed to get image: %v", err)
 					return
@@ -137,3 +179,35 @@ func TestCachingServer_GetImage(t *testing.T) {
 		require.Equal(t, 1, server.callCount, "Expected 1 parent server call (from concurrent requests)")
 	})
 }
+
+func TestCachingServer_DeleteImage(t *testing.T) {
+	ctx := context.Background()
+	storage := newMockStorage()
+	server := &mockServer{}
+	cache, err := NewCachingServer(server, storage, 10)
+	require.NoError(t, err)
+
+	require.NoError(t, storage.UploadFile(ctx, "test.jpg", []byte("original"), "image/jpeg"))
+
+	reader, _, err := cache.GetImage(ctx, "test.jpg", "thumb", FormatWebp)
+	require.NoError(t, err)
+	_, _ = io.ReadAll(reader)
+
+	require.Eventually(t, func() bool {
+		exists, _ := storage.ObjectExists(ctx, "thumb/webp/test.jpg")
+		return exists
+	}, 5*time.Second, 10*time.Millisecond, "Expected the variant to be cached")
+
+	require.NoError(t, cache.DeleteImage(ctx, "test.jpg", []string{"thumb", "full"}))
+
+	for _, fname := range []string{"test.jpg", "thumb/webp/test.jpg"} {
+		exists, err := storage.ObjectExists(ctx, fname)
+		require.NoError(t, err)
+		require.False(t, exists, "Expected %s to be deleted", fname)
+	}
+
+	// the image is not served from the in memory cache anymore
+	_, _, err = cache.GetImage(ctx, "test.jpg", "thumb", FormatWebp)
+	require.NoError(t, err)
+	require.Equal(t, 2, server.callCount, "Expected the parent server to be called again")
+}
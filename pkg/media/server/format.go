@@ -0,0 +1,26 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Format is the format images are served in
+type Format string
+
+const (
+	FormatWebp Format = "webp"
+	FormatAvif Format = "avif"
+)
+
+var Formats = []Format{FormatWebp, FormatAvif}
+
+// NegotiateFormat picks avif for the clients that explicitly support it,
+// webp is understood by every browser out there
+func NegotiateFormat(req *http.Request) Format {
+	if strings.Contains(req.Header.Get("Accept"), "image/avif") {
+		return FormatAvif
+	}
+
+	return FormatWebp
+}
@@ -6,7 +6,9 @@ import (
 	"io"
 	"log"
 	"log/slog"
+	"math"
 	"net/http"
+	"sort"
 
 	"github.com/davidbyttow/govips/v2/vips"
 )
@@ -16,6 +18,30 @@ type ClassParams struct {
 	Height int
 }
 
+// Scale returns the factor to fit the image within the bounding box of
+// the class while maintaining aspect ratio. Images are never upscaled
+func (p ClassParams) Scale(width int, height int) float64 {
+	// Calculate the scale factor to fit within the bounding box
+	widthScale := float64(p.Width) / float64(width)
+	heightScale := float64(p.Height) / float64(height)
+
+	// Use the smaller scale to ensure image fits within bounds
+	scale := min(widthScale, heightScale)
+
+	if scale > 1.0 {
+		return 1.0
+	}
+
+	return scale
+}
+
+// Fit returns the dimensions of the image once it's served in the class
+func (p ClassParams) Fit(width int, height int) (int, int) {
+	scale := p.Scale(width, height)
+
+	return int(math.Round(float64(width) * scale)), int(math.Round(float64(height) * scale))
+}
+
 type Server struct {
 	storage MediaStorage
 	options options
@@ -47,10 +73,14 @@ func WithClass(name string, params ClassParams) Option {
 	}
 }
 
+// PermaCacheHeader is the Cache-Control value for the content that never changes
+// and can be cached by anyone
+const PermaCacheHeader = "public, max-age=604800, immutable, stale-while-revalidate=86400"
+
 func WithPermaCache(enabled bool) Option {
 	return func(o *options) {
 		if enabled {
-			o.addHeaders.Add("Cache-Control", "public, max-age=604800, immutable, stale-while-revalidate=86400")
+			o.addHeaders.Add("Cache-Control", PermaCacheHeader)
 		}
 	}
 }
@@ -74,8 +104,9 @@ func New(storage MediaStorage, o ...Option) (*Server, func()) {
 	}, vips.Shutdown
 }
 
-// get the reader with downloaded and transformed image after all transformations
-func (s Server) GetImage(ctx context.Context, fname string, class string) (io.Reader, string, error) {
+// get the reader with downloaded and transformed image after all transformations.
+// Animated images are always served as webp, since avif animations are not widely supported
+func (s Server) GetImage(ctx context.Context, fname string, class string, format Format) (io.Reader, string, error) {
 	dl, _, _, err := s.storage.DownloadFile(ctx, fname)
 
 	if err != nil {
@@ -88,29 +119,28 @@ func (s Server) GetImage(ctx context.Context, fname string, class string) (io.Re
 		}
 	}()
 
-	img, err := vips.NewImageFromReader(dl)
+	b, err := io.ReadAll(dl)
 
 	if err != nil {
 		return nil, "", err
 	}
 
-	if params, ok := s.options.classMap[class]; ok {
-		// Resize to fit within the bounding box while maintaining aspect ratio
-		var scale float64
+	importParams := vips.NewImportParams()
+	// load all the frames of animated images, they are resized one by one
+	importParams.NumPages.Set(-1)
 
-		imgWidth := img.Width()
-		imgHeight := img.Height()
+	img, err := vips.LoadImageFromBuffer(b, importParams)
 
-		// Calculate the scale factor to fit within the bounding box
-		widthScale := float64(params.Width) / float64(imgWidth)
-		heightScale := float64(params.Height) / float64(imgHeight)
+	if err != nil {
+		return nil, "", err
+	}
 
-		// Use the smaller scale to ensure image fits within bounds
-		if widthScale < heightScale {
-			scale = widthScale
-		} else {
-			scale = heightScale
-		}
+	defer img.Close()
+
+	if params, ok := s.options.classMap[class]; ok {
+		// Resize to fit within the bounding box while maintaining aspect ratio.
+		// Frames of animated images are stacked vertically
+		scale := params.Scale(img.Width(), img.PageHeight())
 
 		if scale < 1.0 {
 			err = img.Resize(scale, vips.KernelLanczos3)
@@ -120,14 +150,40 @@ func (s Server) GetImage(ctx context.Context, fname string, class string) (io.Re
 		}
 	}
 
+	if format == FormatAvif && img.Pages() == 1 {
+		out, _, err := img.Export(&vips.ExportParams{
+			Format:  vips.ImageTypeAVIF,
+			Quality: 60,
+			Speed:   8,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		return bytes.NewReader(out), "image/avif", nil
+	}
+
 	ep := vips.NewDefaultWEBPExportParams()
 
-	b, _, err := img.Export(ep)
+	out, _, err := img.Export(ep)
 	if err != nil {
 		return nil, "", err
 	}
 
-	return bytes.NewReader(b), "image/webp", nil
+	return bytes.NewReader(out), "image/webp", nil
+}
+
+// Classes lists the names of all the configured classes
+func (s Server) Classes() []string {
+	classes := make([]string, 0, len(s.options.classMap))
+
+	for name := range s.options.classMap {
+		classes = append(classes, name)
+	}
+
+	sort.Strings(classes)
+
+	return classes
 }
 
 func (s Server) ServeImage(ctx context.Context, getter MediaGetter, req *http.Request, w http.ResponseWriter, fname string) error {
@@ -140,7 +196,7 @@ func (s Server) ServeImage(ctx context.Context, getter MediaGetter, req *http.Re
 		return nil
 	}
 
-	out, ct, err := getter.GetImage(ctx, fname, class)
+	out, ct, err := getter.GetImage(ctx, fname, class, NegotiateFormat(req))
 
 	if err != nil {
 		return err
@@ -156,6 +212,8 @@ func (s Server) ServeImage(ctx context.Context, getter MediaGetter, req *http.Re
 	}
 
 	w.Header().Set("Content-Type", ct)
+	// the same url gives different formats depending on the client
+	w.Header().Set("Vary", "Accept")
 
 	for name, headers := range s.options.addHeaders {
 		for _, h := range headers {
@@ -10,10 +10,11 @@ import (
 	"golang.org/x/sync/semaphore"
 )
 
-// requestKey uniquely identifies a request by filename and class
+// requestKey uniquely identifies a request by filename, class and format
 type requestKey struct {
 	filename string
 	class    string
+	format   Format
 }
 
 // inFlightRequest represents a request that is currently being processed
@@ -44,8 +45,8 @@ func NewWrapper(server MediaServer, maxConcurrent int64) *ServerWrapper {
 }
 
 // GetImage implements the same interface as Server.GetImage but with deduplication and concurrency limiting
-func (w *ServerWrapper) GetImage(ctx context.Context, fname string, class string) (io.Reader, string, error) {
-	key := requestKey{filename: fname, class: class}
+func (w *ServerWrapper) GetImage(ctx context.Context, fname string, class string, format Format) (io.Reader, string, error) {
+	key := requestKey{filename: fname, class: class, format: format}
 
 	// Check if there's an in-flight request under mutex lock
 	w.mu.Lock()
@@ -84,7 +85,7 @@ func (w *ServerWrapper) GetImage(ctx context.Context, fname string, class string
 	defer w.sem.Release(1)
 
 	// Process the request
-	reader, mime, err := w.MediaServer.GetImage(ctx, fname, class)
+	reader, mime, err := w.MediaServer.GetImage(ctx, fname, class, format)
 	if err != nil {
 		req.err = err
 		return nil, "", err
@@ -19,7 +19,7 @@ type mockMediaServer struct {
 	shouldError   bool
 }
 
-func (m *mockMediaServer) GetImage(ctx context.Context, fname string, class string) (io.Reader, string, error) {
+func (m *mockMediaServer) GetImage(ctx context.Context, fname string, class string, format Format) (io.Reader, string, error) {
 	m.mu.Lock()
 	m.callCount++
 	m.mu.Unlock()
@@ -57,7 +57,7 @@ func TestServerWrapper_GetImage(t *testing.T) {
 		var wg sync.WaitGroup
 		for range 5 {
 			wg.Go(func() {
-				reader, mime, err := wrapper.GetImage(context.Background(), "test.jpg", "thumbnail")
+				reader, mime, err := wrapper.GetImage(context.Background(), "test.jpg", "thumbnail", FormatWebp)
 				if err != nil {
 					t.Errorf("unexpected error: %v", err)
 					return
@@ -92,7 +92,7 @@ func TestServerWrapper_GetImage(t *testing.T) {
 			wg.Add(1)
 			go func(i int) {
 				defer wg.Done()
-				_, _, err := wrapper.GetImage(context.Background(), "test.jpg", "class"+string(rune(i)))
+				_, _, err := wrapper.GetImage(context.Background(), "test.jpg", "class"+string(rune(i)), FormatWebp)
 				if err != nil {
 					t.Errorf("unexpected error: %v", err)
 				}
@@ -115,7 +115,7 @@ func TestServerWrapper_GetImage(t *testing.T) {
 		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
 		defer cancel()
 
-		_, _, err := wrapper.GetImage(ctx, "test.jpg", "thumbnail")
+		_, _, err := wrapper.GetImage(ctx, "test.jpg", "thumbnail", FormatWebp)
 		if !errors.Is(err, context.DeadlineExceeded) {
 			t.Errorf("expected deadline exceeded error, got %v", err)
 		}
@@ -125,7 +125,7 @@ func TestServerWrapper_GetImage(t *testing.T) {
 		mock := &mockMediaServer{shouldError: true}
 		wrapper := NewWrapper(mock, 1)
 
-		_, _, err := wrapper.GetImage(context.Background(), "test.jpg", "thumbnail")
+		_, _, err := wrapper.GetImage(context.Background(), "test.jpg", "thumbnail", FormatWebp)
 		if err == nil {
 			t.Error("expected error, got nil")
 		}
@@ -137,7 +137,7 @@ func TestServerWrapper_GetImage(t *testing.T) {
 
 		// Make several requests and verify that in-flight map is cleaned up
 		for range 10 {
-			_, _, err := wrapper.GetImage(context.Background(), "test.jpg", "thumbnail")
+			_, _, err := wrapper.GetImage(context.Background(), "test.jpg", "thumbnail", FormatWebp)
 			if err != nil {
 				t.Errorf("unexpected error: %v", err)
 			}
@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"io"
 	"io/fs"
-	"net/http"
 	"os"
 	"path"
 	"path/filepath"
@@ -98,12 +97,22 @@ func (ls *localServer) DownloadFile(ctx context.Context, fname string) (io.ReadC
 		return nil, 0, "", err
 	}
 
-	ftype := http.DetectContentType(b)
+	ftype := media.DetectContentType(b)
 	reader := bytes.NewReader(b)
 
 	return io.NopCloser(reader), int64(len(b)), ftype, nil
 }
 
+func (ls *localServer) OpenFile(ctx context.Context, fname string) (io.ReadSeekCloser, error) {
+	f, err := os.Open(path.Join(ls.path, fname))
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, media.ErrNotFound
+	}
+
+	return f, err
+}
+
 func (ls *localServer) ObjectExists(ctx context.Context, fname string) (bool, error) {
 	filePath := path.Join(ls.path, fname)
 	_, err := os.Stat(filePath)
@@ -115,3 +124,18 @@ func (ls *localServer) ObjectExists(ctx context.Context, fname string) (bool, er
 	}
 	return false, err
 }
+
+func (ls *localServer) Delete(ctx context.Context, fname string) error {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+
+	filePath := path.Join(ls.path, fname)
+
+	err := os.Remove(filePath)
+
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
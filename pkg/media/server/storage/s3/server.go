@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 
@@ -81,6 +82,104 @@ func (s3s *s3Server) DownloadFile(ctx context.Context, fname string) (io.ReadClo
 	return result.Body, *result.ContentLength, *result.ContentType, nil
 }
 
+func (s3s *s3Server) OpenFile(ctx context.Context, fname string) (io.ReadSeekCloser, error) {
+	head, err := s3s.s3.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(s3s.bucket),
+		Key:    aws.String(fname),
+	})
+
+	if err != nil {
+		var notFound *types.NotFound
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &notFound) || errors.As(err, &noSuchKey) {
+			return nil, mediaerrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &objectReader{
+		ctx:   ctx,
+		s3s:   s3s,
+		fname: fname,
+		size:  aws.ToInt64(head.ContentLength),
+	}, nil
+}
+
+// objectReader reads the object with ranged requests starting from the
+// current offset, seeking only moves the offset and never downloads anything
+type objectReader struct {
+	ctx    context.Context
+	s3s    *s3Server
+	fname  string
+	size   int64
+	offset int64
+	body   io.ReadCloser
+}
+
+func (r *objectReader) Read(p []byte) (int, error) {
+	if r.offset >= r.size {
+		return 0, io.EOF
+	}
+
+	if r.body == nil {
+		result, err := r.s3s.s3.GetObject(r.ctx, &s3.GetObjectInput{
+			Bucket: aws.String(r.s3s.bucket),
+			Key:    aws.String(r.fname),
+			Range:  aws.String(fmt.Sprintf("bytes=%d-", r.offset)),
+		})
+
+		if err != nil {
+			return 0, err
+		}
+
+		r.body = result.Body
+	}
+
+	n, err := r.body.Read(p)
+	r.offset += int64(n)
+
+	return n, err
+}
+
+func (r *objectReader) Seek(offset int64, whence int) (int64, error) {
+	pos := offset
+
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		pos += r.offset
+	case io.SeekEnd:
+		pos += r.size
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+
+	if pos < 0 {
+		return 0, fmt.Errorf("negative position %d", pos)
+	}
+
+	if pos != r.offset {
+		if err := r.Close(); err != nil {
+			return 0, err
+		}
+
+		r.offset = pos
+	}
+
+	return pos, nil
+}
+
+func (r *objectReader) Close() error {
+	if r.body == nil {
+		return nil
+	}
+
+	err := r.body.Close()
+	r.body = nil
+
+	return err
+}
+
 func (s3s *s3Server) ObjectExists(ctx context.Context, fname string) (bool, error) {
 	_, err := s3s.s3.HeadObject(ctx, &s3.HeadObjectInput{
 		Bucket: aws.String(s3s.bucket),
@@ -95,3 +194,13 @@ func (s3s *s3Server) ObjectExists(ctx context.Context, fname string) (bool, erro
 	}
 	return true, nil
 }
+
+// Delete relies on s3 semantics, deleting a missing key is not an error
+func (s3s *s3Server) Delete(ctx context.Context, fname string) error {
+	_, err := s3s.s3.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s3s.bucket),
+		Key:    aws.String(fname),
+	})
+
+	return err
+}
@@ -9,14 +9,19 @@ import (
 type MediaStorage interface {
 	UploadFile(ctx context.Context, fname string, b []byte, contentType string) error
 	DownloadFile(ctx context.Context, fname string) (io.ReadCloser, int64, string, error)
+	// OpenFile gives access to the parts of the file without downloading
+	// all of it, which is what serving the range requests needs
+	OpenFile(ctx context.Context, fname string) (io.ReadSeekCloser, error)
 	ObjectExists(ctx context.Context, fname string) (bool, error)
+	// Delete removes the file, missing files are not an error
+	Delete(ctx context.Context, fname string) error
 }
 
 type MediaServer interface {
-	GetImage(ctx context.Context, fname string, class string) (io.Reader, string, error)
+	GetImage(ctx context.Context, fname string, class string, format Format) (io.Reader, string, error)
 	ServeImage(ctx context.Context, getter MediaGetter, req *http.Request, w http.ResponseWriter, fname string) error
 }
 
 type MediaGetter interface {
-	GetImage(ctx context.Context, fname string, class string) (io.Reader, string, error)
+	GetImage(ctx context.Context, fname string, class string, format Format) (io.Reader, string, error)
 }
@@ -0,0 +1,398 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/can3p/gogo/util/transact"
+	"github.com/can3p/pcom/pkg/media/server"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const transcodeEvery = 30 * time.Second
+
+// MaxClipDuration is where renditions get cut, anything longer is not a clip anyway
+const MaxClipDuration = 10 * time.Minute
+
+// a single ffmpeg run should never block the queue for too long
+const transcodeTimeout = 15 * time.Minute
+
+// the claim outlives both ffmpeg runs along with the transfers of the files,
+// anything older belongs to an instance that has died halfway
+const transcodeClaimTTL = time.Hour
+
+// keeps the error stored in the db readable
+const maxRenditionErrorLength = 1000
+
+// ffmpeg failures are final, there is no point to retry them
+type transcodeError struct {
+	err error
+}
+
+func (e *transcodeError) Error() string {
+	return e.err.Error()
+}
+
+type Transcoder struct {
+	db      *sqlx.DB
+	storage server.MediaStorage
+	ffmpeg  string
+}
+
+// NewTranscoder returns the transcoder, ffmpeg is the path to the binary
+func NewTranscoder(db *sqlx.DB, storage server.MediaStorage, ffmpeg string) *Transcoder {
+	return &Transcoder{
+		db:      db,
+		storage: storage,
+		ffmpeg:  ffmpeg,
+	}
+}
+
+func (t *Transcoder) Run(ctx context.Context) {
+	if _, err := exec.LookPath(t.ffmpeg); err != nil {
+		slog.Warn("ffmpeg is not available, video and audio uploads will not be processed", "ffmpeg", t.ffmpeg, "err", err.Error())
+		return
+	}
+
+	ticker := time.NewTicker(transcodeEvery)
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := t.processPending(ctx); err != nil {
+				slog.Warn("Failed to processPending", "err", err.Error())
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (t *Transcoder) processPending(ctx context.Context) (err error) {
+	// a single broken file should never crash the scheduler
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = fmt.Errorf("processPending panicked: %v - %s", panicErr, string(debug.Stack()))
+		}
+	}()
+
+	pending, err := core.MediaUploads(
+		claimableUploads(time.Now()),
+		qm.OrderBy(core.MediaUploadColumns.CreatedAt),
+	).All(ctx, t.db)
+
+	if err != nil {
+		return err
+	}
+
+	// the upload is claimed and released in short transactions, nothing
+	// is locked while the file is downloaded and ffmpeg runs
+	for _, p := range pending {
+		upload, err := claimUpload(ctx, t.db, p.ID, time.Now())
+
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		} else if err != nil {
+			slog.Warn("Failed to claim the clip", "fname", p.UploadedFname, "err", err.Error())
+			continue
+		}
+
+		if err := t.process(ctx, upload); err != nil {
+			slog.Warn("Failed to process the clip", "fname", p.UploadedFname, "err", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// claimableUploads matches the uploads waiting for the rendition along with the ones
+// claimed by the instances that have died halfway, the claim is stale by then
+func claimableUploads(now time.Time) qm.QueryMod {
+	return qm.Expr(
+		core.MediaUploadWhere.RenditionStatus.EQ(core.NullMediaRenditionStatusFrom(core.MediaRenditionStatusPending)),
+		qm.Or2(qm.Expr(
+			core.MediaUploadWhere.RenditionStatus.EQ(core.NullMediaRenditionStatusFrom(core.MediaRenditionStatusProcessing)),
+			core.MediaUploadWhere.ClaimedAt.LT(null.TimeFrom(now.Add(-transcodeClaimTTL))),
+		)),
+	)
+}
+
+// claimUpload marks the upload as being processed, sql.ErrNoRows means
+// that somebody else has got it first
+func claimUpload(ctx context.Context, db *sqlx.DB, id string, now time.Time) (*core.MediaUpload, error) {
+	var upload *core.MediaUpload
+
+	err := transact.Transact(db, func(tx *sql.Tx) error {
+		var err error
+
+		upload, err = core.MediaUploads(
+			core.MediaUploadWhere.ID.EQ(id),
+			claimableUploads(now),
+			qm.For("UPDATE SKIP LOCKED"),
+		).One(ctx, tx)
+
+		if err != nil {
+			return err
+		}
+
+		// postgres keeps microseconds, the claim has to match when the work is done
+		upload.RenditionStatus = core.NullMediaRenditionStatusFrom(core.MediaRenditionStatusProcessing)
+		upload.ClaimedAt = null.TimeFrom(now.Truncate(time.Microsecond))
+
+		_, err = upload.Update(ctx, tx, boil.Whitelist(
+			core.MediaUploadColumns.RenditionStatus,
+			core.MediaUploadColumns.ClaimedAt,
+			core.MediaUploadColumns.UpdatedAt,
+		))
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return upload, nil
+}
+
+func (t *Transcoder) process(ctx context.Context, upload *core.MediaUpload) error {
+	err := t.transcode(ctx, upload)
+
+	var tErr *transcodeError
+
+	switch {
+	case errors.As(err, &tErr):
+		msg := tErr.Error()
+
+		if len(msg) > maxRenditionErrorLength {
+			msg = msg[len(msg)-maxRenditionErrorLength:]
+		}
+
+		upload.RenditionStatus = core.NullMediaRenditionStatusFrom(core.MediaRenditionStatusFailed)
+		upload.RenditionError = null.StringFrom(msg)
+
+		slog.Warn("Failed to transcode the clip", "fname", upload.UploadedFname, "err", msg)
+	case err != nil:
+		// storage hiccups, the clip will be picked up again
+		upload.RenditionStatus = core.NullMediaRenditionStatusFrom(core.MediaRenditionStatusPending)
+
+		if releaseErr := finishUpload(ctx, t.db, upload); releaseErr != nil {
+			return releaseErr
+		}
+
+		return err
+	default:
+		upload.RenditionStatus = core.NullMediaRenditionStatusFrom(core.MediaRenditionStatusReady)
+	}
+
+	if err := finishUpload(ctx, t.db, upload); err != nil {
+		return err
+	}
+
+	metaCache.Remove(upload.UploadedFname)
+
+	return nil
+}
+
+// finishUpload stores the outcome and releases the claim. Nothing is stored
+// in case the claim has gone stale and the upload has been claimed again
+func finishUpload(ctx context.Context, exec boil.ContextExecutor, upload *core.MediaUpload) error {
+	updated, err := core.MediaUploads(
+		core.MediaUploadWhere.ID.EQ(upload.ID),
+		core.MediaUploadWhere.RenditionStatus.EQ(core.NullMediaRenditionStatusFrom(core.MediaRenditionStatusProcessing)),
+		core.MediaUploadWhere.ClaimedAt.EQ(upload.ClaimedAt),
+	).UpdateAll(ctx, exec, core.M{
+		core.MediaUploadColumns.RenditionStatus: upload.RenditionStatus,
+		core.MediaUploadColumns.RenditionFname:  upload.RenditionFname,
+		core.MediaUploadColumns.PosterFname:     upload.PosterFname,
+		core.MediaUploadColumns.RenditionError:  upload.RenditionError,
+		core.MediaUploadColumns.SizeBytes:       upload.SizeBytes,
+		core.MediaUploadColumns.ClaimedAt:       nil,
+		core.MediaUploadColumns.UpdatedAt:       time.Now(),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if updated == 0 {
+		slog.Warn("The claim of the clip has gone stale before it was processed", "fname", upload.UploadedFname)
+	}
+
+	return nil
+}
+
+// transcode stores the web friendly rendition of the clip along with the
+// poster frame for videos, the names of the files are filled in the upload
+// and their sizes are added to the size of the upload
+func (t *Transcoder) transcode(ctx context.Context, upload *core.MediaUpload) error {
+	dir, err := os.MkdirTemp("", "transcode")
+
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			slog.Warn("Failed to remove the transcoding dir", "dir", dir, "err", err.Error())
+		}
+	}()
+
+	input := filepath.Join(dir, upload.UploadedFname)
+
+	if err := t.download(ctx, upload.UploadedFname, input); err != nil {
+		return err
+	}
+
+	id := strings.TrimSuffix(upload.UploadedFname, filepath.Ext(upload.UploadedFname))
+	duration := strconv.Itoa(int(MaxClipDuration.Seconds()))
+
+	switch upload.Kind {
+	case core.MediaKindVideo:
+		rendition := id + "-web.mp4"
+		poster := id + "-poster.jpg"
+
+		if err := t.ffmpegRun(ctx, "-i", input, "-t", duration,
+			// even dimensions are required by h264
+			"-vf", "scale='min(1280,iw)':-2",
+			"-c:v", "libx264", "-preset", "veryfast", "-crf", "26", "-pix_fmt", "yuv420p",
+			"-c:a", "aac", "-b:a", "128k",
+			"-movflags", "+faststart",
+			filepath.Join(dir, rendition),
+		); err != nil {
+			return err
+		}
+
+		// thumbnail filter picks a representative frame instead of a black one
+		if err := t.ffmpegRun(ctx, "-i", input,
+			"-vf", "thumbnail,scale='min(1280,iw)':-2",
+			"-frames:v", "1",
+			filepath.Join(dir, poster),
+		); err != nil {
+			return err
+		}
+
+		renditionSize, err := t.upload(ctx, dir, rendition, "video/mp4")
+
+		if err != nil {
+			return err
+		}
+
+		posterSize, err := t.upload(ctx, dir, poster, "image/jpeg")
+
+		if err != nil {
+			return err
+		}
+
+		upload.RenditionFname = null.StringFrom(rendition)
+		upload.PosterFname = null.StringFrom(poster)
+		upload.SizeBytes += renditionSize + posterSize
+	case core.MediaKindAudio:
+		rendition := id + "-web.m4a"
+
+		if err := t.ffmpegRun(ctx, "-i", input, "-t", duration,
+			"-vn",
+			"-c:a", "aac", "-b:a", "128k",
+			"-movflags", "+faststart",
+			filepath.Join(dir, rendition),
+		); err != nil {
+			return err
+		}
+
+		renditionSize, err := t.upload(ctx, dir, rendition, "audio/mp4")
+
+		if err != nil {
+			return err
+		}
+
+		upload.RenditionFname = null.StringFrom(rendition)
+		upload.SizeBytes += renditionSize
+	default:
+		return &transcodeError{err: errors.Errorf("cannot transcode %s", upload.Kind)}
+	}
+
+	return nil
+}
+
+func (t *Transcoder) ffmpegRun(ctx context.Context, args ...string) error {
+	ctx, cancel := context.WithTimeout(ctx, transcodeTimeout)
+	defer cancel()
+
+	var stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, t.ffmpeg, append([]string{"-y", "-hide_banner", "-loglevel", "error"}, args...)...)
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return &transcodeError{err: errors.Wrapf(err, "ffmpeg failed: %s", strings.TrimSpace(stderr.String()))}
+	}
+
+	return nil
+}
+
+func (t *Transcoder) download(ctx context.Context, fname string, dest string) error {
+	dl, _, _, err := t.storage.DownloadFile(ctx, fname)
+
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := dl.Close(); err != nil {
+			slog.Warn("Error closing download", "err", err.Error())
+		}
+	}()
+
+	f, err := os.Create(dest)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(f, dl); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// upload returns the size of the file, renditions count towards the quota of the user
+func (t *Transcoder) upload(ctx context.Context, dir string, fname string, contentType string) (int64, error) {
+	b, err := os.ReadFile(filepath.Join(dir, fname))
+
+	if err != nil {
+		return 0, err
+	}
+
+	size := int64(len(b))
+
+	// the previous attempt might have failed halfway, files are never overwritten
+	exists, err := t.storage.ObjectExists(ctx, fname)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if exists {
+		return size, nil
+	}
+
+	return size, t.storage.UploadFile(ctx, fname, b, contentType)
+}
@@ -0,0 +1,113 @@
+package media
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/can3p/pcom/pkg/feedops/testutil"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/testcontainers/postgres"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestClaimUpload(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+
+	user, err := testutil.CreateUser(ctx, testDB.DB, "test@example.com")
+	require.NoError(t, err)
+
+	createUpload := func() *core.MediaUpload {
+		upload := &core.MediaUpload{
+			ID:              uuid.NewString(),
+			UserID:          null.StringFrom(user.ID),
+			UploadedFname:   uuid.NewString() + ".mov",
+			ContentType:     "video/quicktime",
+			Kind:            core.MediaKindVideo,
+			RenditionStatus: core.NullMediaRenditionStatusFrom(core.MediaRenditionStatusPending),
+		}
+		require.NoError(t, upload.Insert(ctx, testDB.DB, boil.Infer()))
+
+		return upload
+	}
+
+	t.Run("single claim", func(t *testing.T) {
+		upload := createUpload()
+
+		claimed, err := claimUpload(ctx, testDB.DB, upload.ID, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, core.MediaRenditionStatusProcessing, claimed.RenditionStatus.Val)
+
+		_, err = claimUpload(ctx, testDB.DB, upload.ID, time.Now())
+		assert.ErrorIs(t, err, sql.ErrNoRows, "the upload is being processed already")
+
+		claimed.RenditionStatus = core.NullMediaRenditionStatusFrom(core.MediaRenditionStatusReady)
+		claimed.RenditionFname = null.StringFrom("web.mp4")
+		claimed.SizeBytes = 1024
+		require.NoError(t, finishUpload(ctx, testDB.DB, claimed))
+
+		require.NoError(t, upload.Reload(ctx, testDB.DB))
+		assert.Equal(t, core.MediaRenditionStatusReady, upload.RenditionStatus.Val)
+		assert.Equal(t, "web.mp4", upload.RenditionFname.String)
+		assert.Equal(t, int64(1024), upload.SizeBytes, "the rendition counts towards the quota")
+		assert.False(t, upload.ClaimedAt.Valid)
+
+		_, err = claimUpload(ctx, testDB.DB, upload.ID, time.Now())
+		assert.ErrorIs(t, err, sql.ErrNoRows, "the upload is done")
+	})
+
+	t.Run("stale claim", func(t *testing.T) {
+		upload := createUpload()
+
+		stale, err := claimUpload(ctx, testDB.DB, upload.ID, time.Now().Add(-2*transcodeClaimTTL))
+		require.NoError(t, err)
+
+		claimed, err := claimUpload(ctx, testDB.DB, upload.ID, time.Now())
+		require.NoError(t, err)
+
+		// the instance that has lost the claim cannot store the result anymore
+		stale.RenditionStatus = core.NullMediaRenditionStatusFrom(core.MediaRenditionStatusFailed)
+		require.NoError(t, finishUpload(ctx, testDB.DB, stale))
+
+		require.NoError(t, upload.Reload(ctx, testDB.DB))
+		assert.Equal(t, core.MediaRenditionStatusProcessing, upload.RenditionStatus.Val)
+
+		// the storage has failed, the upload waits for the next round
+		claimed.RenditionStatus = core.NullMediaRenditionStatusFrom(core.MediaRenditionStatusPending)
+		require.NoError(t, finishUpload(ctx, testDB.DB, claimed))
+
+		_, err = claimUpload(ctx, testDB.DB, upload.ID, time.Now())
+		assert.NoError(t, err)
+	})
+}
+
+func TestTranscoderUpload(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "web.mp4"), []byte("rendition"), 0o600))
+
+	storage := &memoryStorage{files: map[string][]byte{}}
+	transcoder := NewTranscoder(nil, storage, "ffmpeg")
+
+	size, err := transcoder.upload(ctx, dir, "web.mp4", "video/mp4")
+	require.NoError(t, err)
+	assert.Equal(t, int64(len("rendition")), size)
+	assert.Equal(t, []byte("rendition"), storage.files["web.mp4"])
+
+	// the file stored by the previous attempt is still counted
+	size, err = transcoder.upload(ctx, dir, "web.mp4", "video/mp4")
+	require.NoError(t, err)
+	assert.Equal(t, int64(len("rendition")), size)
+}
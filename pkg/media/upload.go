@@ -3,24 +3,50 @@ package media
 import (
 	"context"
 	"io"
-	"net/http"
+	"log/slog"
 
 	"github.com/can3p/pcom/pkg/media/server"
 	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/dustin/go-humanize"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"github.com/samber/lo"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 var (
 	ErrNotFound            = errors.Errorf("Resource not found")
 	ErrUnsupportedMimeType = errors.New("unsupported mime type")
+	ErrClipTooLarge        = errors.New("clip is too large")
 )
 
+// MaxClipSize is the limit for video and audio uploads, only short clips are expected
+const MaxClipSize = 100 << 20
+
 var supportedImageTypes = map[string]string{
 	"image/png":  ".png",
 	"image/jpeg": ".jpg",
 	"image/webp": ".webp",
+	"image/gif":  ".gif",
+	"image/avif": ".avif",
+	"image/heic": ".heic",
+}
+
+type clipType struct {
+	kind core.MediaKind
+	ext  string
+}
+
+var supportedClipTypes = map[string]clipType{
+	"video/mp4":       {core.MediaKindVideo, ".mp4"},
+	"video/webm":      {core.MediaKindVideo, ".webm"},
+	"video/quicktime": {core.MediaKindVideo, ".mov"},
+	"audio/mpeg":      {core.MediaKindAudio, ".mp3"},
+	"audio/mp4":       {core.MediaKindAudio, ".m4a"},
+	"audio/wave":      {core.MediaKindAudio, ".wav"},
+	// go does not tell ogg audio from ogg video, audio is far more common
+	"application/ogg": {core.MediaKindAudio, ".ogg"},
 }
 
 func ValidateImageType(contentType string) (string, error) {
@@ -31,20 +57,32 @@ func ValidateImageType(contentType string) (string, error) {
 	return ext, nil
 }
 
-func HandleUpload(ctx context.Context, exec boil.ContextExecutor, media server.MediaStorage, userID *string, rssFeedID *string, reader io.Reader) (string, error) {
-	if (userID == nil && rssFeedID == nil) || (userID != nil && rssFeedID != nil) {
-		return "", errors.Errorf("exactly one of userID or rssFeedID must be provided")
+// HandleUpload stores images right away, while video and audio clips are
+// stored as is and are picked up by the transcoder later. The upload belongs
+// either to the user, to the feed or to the url in case of link previews
+func HandleUpload(ctx context.Context, exec boil.ContextExecutor, media server.MediaStorage, userID *string, rssFeedID *string, urlID *string, reader io.Reader) (string, error) {
+	if len(lo.Compact([]*string{userID, rssFeedID, urlID})) != 1 {
+		return "", errors.Errorf("exactly one of userID, rssFeedID or urlID must be provided")
 	}
 
 	bytes, err := io.ReadAll(reader)
 
 	if err != nil {
-		panic(err)
+		return "", errors.Wrap(err, "failed to read the upload")
 	}
 
-	ftype := http.DetectContentType(bytes)
+	ftype := DetectContentType(bytes)
+
+	var mediaUpload *core.MediaUpload
+	var ext string
+
+	// feeds and link previews only get their images rehosted
+	if _, ok := supportedClipTypes[ftype]; ok && userID != nil {
+		mediaUpload, ext, err = prepareClip(bytes, ftype)
+	} else {
+		mediaUpload, ext, bytes, err = prepareImage(ctx, exec, userID, bytes, ftype)
+	}
 
-	ext, err := ValidateImageType(ftype)
 	if err != nil {
 		return "", err
 	}
@@ -57,13 +95,30 @@ func HandleUpload(ctx context.Context, exec boil.ContextExecutor, media server.M
 
 	fname := id.String() + ext
 
-	mediaUpload := &core.MediaUpload{
-		ID:            id.String(),
-		UploadedFname: fname,
-		ContentType:   ftype,
-	}
+	mediaUpload.ID = id.String()
+	mediaUpload.UploadedFname = fname
+	mediaUpload.SizeBytes = int64(len(bytes))
 
 	if userID != nil {
+		// concurrent uploads of the user wait for each other here,
+		// otherwise every one of them would fit into the quota
+		if _, err := core.Users(
+			core.UserWhere.ID.EQ(*userID),
+			qm.For("UPDATE"),
+		).One(ctx, exec); err != nil {
+			return "", err
+		}
+
+		quota, err := GetUserQuota(ctx, exec, *userID)
+
+		if err != nil {
+			return "", err
+		}
+
+		if !quota.Allows(mediaUpload.SizeBytes) {
+			return "", ErrQuotaExceeded
+		}
+
 		mediaUpload.UserID.SetValid(*userID)
 	}
 
@@ -71,6 +126,10 @@ func HandleUpload(ctx context.Context, exec boil.ContextExecutor, media server.M
 		mediaUpload.RSSFeedID.SetValid(*rssFeedID)
 	}
 
+	if urlID != nil {
+		mediaUpload.URLID.SetValid(*urlID)
+	}
+
 	// we do actions inside and outside db in one go
 	// operation should be defened with transaction, but file storage
 	// part can still get corrupted
@@ -78,9 +137,74 @@ func HandleUpload(ctx context.Context, exec boil.ContextExecutor, media server.M
 		return "", err
 	}
 
-	if err := media.UploadFile(ctx, fname, bytes, ftype); err != nil {
+	if err := media.UploadFile(ctx, fname, bytes, mediaUpload.ContentType); err != nil {
 		return "", err
 	}
 
 	return fname, nil
 }
+
+// prepareImage returns the upload along with the file extension.
+// The image is scrubbed and can change the format as a result
+func prepareImage(ctx context.Context, exec boil.ContextExecutor, userID *string, b []byte, ftype string) (*core.MediaUpload, string, []byte, error) {
+	if _, err := ValidateImageType(ftype); err != nil {
+		return nil, "", nil, err
+	}
+
+	var keepCameraMetadata bool
+
+	if userID != nil {
+		user, err := core.FindUser(ctx, exec, *userID)
+
+		if err != nil {
+			return nil, "", nil, err
+		}
+
+		keepCameraMetadata = user.KeepCameraMetadata
+	}
+
+	b, ftype, err := ScrubMetadata(b, ftype, keepCameraMetadata)
+
+	if err != nil {
+		return nil, "", nil, errors.Wrapf(err, "failed to scrub image metadata")
+	}
+
+	// the format might change during scrubbing
+	ext, err := ValidateImageType(ftype)
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	mediaUpload := &core.MediaUpload{
+		ContentType: ftype,
+		Kind:        core.MediaKindImage,
+	}
+
+	// dimensions are only needed for nicer rendering, the upload
+	// is still fine without them
+	if info, err := AnalyzeImage(b); err != nil {
+		slog.Warn("Failed to analyze the image", "err", err.Error())
+	} else {
+		mediaUpload.Width.SetValid(info.Width)
+		mediaUpload.Height.SetValid(info.Height)
+		mediaUpload.Blurhash.SetValid(info.Blurhash)
+	}
+
+	return mediaUpload, ext, b, nil
+}
+
+// prepareClip returns the upload along with the file extension.
+// Clips are never touched during the upload, transcoder makes a web friendly copy
+func prepareClip(b []byte, ftype string) (*core.MediaUpload, string, error) {
+	clip := supportedClipTypes[ftype]
+
+	if len(b) > MaxClipSize {
+		return nil, "", errors.Wrapf(ErrClipTooLarge, "%s", humanize.IBytes(uint64(len(b))))
+	}
+
+	return &core.MediaUpload{
+		ContentType:     ftype,
+		Kind:            clip.kind,
+		RenditionStatus: core.NullMediaRenditionStatusFrom(core.MediaRenditionStatusPending),
+	}, clip.ext, nil
+}
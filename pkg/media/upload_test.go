@@ -0,0 +1,96 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/can3p/pcom/pkg/feedops/testutil"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/testcontainers/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type memoryStorage struct {
+	files map[string][]byte
+}
+
+func (s *memoryStorage) UploadFile(ctx context.Context, fname string, b []byte, contentType string) error {
+	s.files[fname] = b
+	return nil
+}
+
+func (s *memoryStorage) DownloadFile(ctx context.Context, fname string) (io.ReadCloser, int64, string, error) {
+	b := s.files[fname]
+	return io.NopCloser(bytes.NewReader(b)), int64(len(b)), "", nil
+}
+
+func (s *memoryStorage) OpenFile(ctx context.Context, fname string) (io.ReadSeekCloser, error) {
+	return nopSeekCloser{bytes.NewReader(s.files[fname])}, nil
+}
+
+type nopSeekCloser struct {
+	io.ReadSeeker
+}
+
+func (nopSeekCloser) Close() error { return nil }
+
+func (s *memoryStorage) ObjectExists(ctx context.Context, fname string) (bool, error) {
+	_, ok := s.files[fname]
+	return ok, nil
+}
+
+func (s *memoryStorage) Delete(ctx context.Context, fname string) error {
+	delete(s.files, fname)
+	return nil
+}
+
+func TestHandleUploadQuota(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+	exec := testDB.DB
+
+	user, err := testutil.CreateUser(ctx, exec, "test@example.com")
+	require.NoError(t, err)
+
+	storage := &memoryStorage{files: map[string][]byte{}}
+
+	// clips are stored as is, which makes the size predictable
+	clip := ftypBox("isom", "iso2", "mp41")
+
+	setQuota := func(limit int) {
+		user.MediaQuotaBytes = null.Int64From(int64(limit))
+		_, err := user.Update(ctx, exec, boil.Infer())
+		require.NoError(t, err)
+	}
+
+	setQuota(2*len(clip) - 1)
+
+	fname, err := HandleUpload(ctx, exec, storage, &user.ID, nil, nil, bytes.NewReader(clip))
+	require.NoError(t, err)
+	assert.Contains(t, storage.files, fname)
+
+	_, err = HandleUpload(ctx, exec, storage, &user.ID, nil, nil, bytes.NewReader(clip))
+	assert.ErrorIs(t, err, ErrQuotaExceeded)
+	assert.Len(t, storage.files, 1, "rejected file is not stored")
+
+	count, err := core.MediaUploads(core.MediaUploadWhere.UserID.EQ(null.StringFrom(user.ID))).Count(ctx, exec)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	quota, err := GetUserQuota(ctx, exec, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(clip)), quota.UsedBytes)
+
+	setQuota(2 * len(clip))
+
+	_, err = HandleUpload(ctx, exec, storage, &user.ID, nil, nil, bytes.NewReader(clip))
+	assert.NoError(t, err, "the quota is inclusive")
+}
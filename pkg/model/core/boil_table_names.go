@@ -4,9 +4,17 @@
 package core
 
 var TableNames = struct {
+	AdminAuditEntries               string
+	AuthAttempts                    string
+	EmailChangeRequests             string
+	LinkPreviews                    string
+	LoginLinks                      string
+	LoginLockouts                   string
+	MediaReferences                 string
 	MediaUploads                    string
 	NormalizedUrls                  string
 	OutgoingEmails                  string
+	PasswordResetRequests           string
 	PostComments                    string
 	PostPrompts                     string
 	PostShares                      string
@@ -19,17 +27,33 @@ var TableNames = struct {
 	UserConnectionMediationRequests string
 	UserConnectionMediators         string
 	UserConnections                 string
+	UserFeedFolders                 string
 	UserFeedItems                   string
+	UserFeedRules                   string
 	UserFeedSubscriptions           string
+	UserIdentities                  string
 	UserInvitations                 string
+	UserPasskeys                    string
+	UserRecoveryCodes               string
+	UserRememberedDevices           string
+	UserSessions                    string
 	UserSignupRequests              string
 	UserStyles                      string
 	Users                           string
+	WebsubPushes                    string
 	WhitelistedConnections          string
 }{
+	AdminAuditEntries:               "admin_audit_entries",
+	AuthAttempts:                    "auth_attempts",
+	EmailChangeRequests:             "email_change_requests",
+	LinkPreviews:                    "link_previews",
+	LoginLinks:                      "login_links",
+	LoginLockouts:                   "login_lockouts",
+	MediaReferences:                 "media_references",
 	MediaUploads:                    "media_uploads",
 	NormalizedUrls:                  "normalized_urls",
 	OutgoingEmails:                  "outgoing_emails",
+	PasswordResetRequests:           "password_reset_requests",
 	PostComments:                    "post_comments",
 	PostPrompts:                     "post_prompts",
 	PostShares:                      "post_shares",
@@ -42,11 +66,19 @@ var TableNames = struct {
 	UserConnectionMediationRequests: "user_connection_mediation_requests",
 	UserConnectionMediators:         "user_connection_mediators",
 	UserConnections:                 "user_connections",
+	UserFeedFolders:                 "user_feed_folders",
 	UserFeedItems:                   "user_feed_items",
+	UserFeedRules:                   "user_feed_rules",
 	UserFeedSubscriptions:           "user_feed_subscriptions",
+	UserIdentities:                  "user_identities",
 	UserInvitations:                 "user_invitations",
+	UserPasskeys:                    "user_passkeys",
+	UserRecoveryCodes:               "user_recovery_codes",
+	UserRememberedDevices:           "user_remembered_devices",
+	UserSessions:                    "user_sessions",
 	UserSignupRequests:              "user_signup_requests",
 	UserStyles:                      "user_styles",
 	Users:                           "users",
+	WebsubPushes:                    "websub_pushes",
 	WhitelistedConnections:          "whitelisted_connections",
 }
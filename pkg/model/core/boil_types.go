@@ -56,6 +56,250 @@ func makeCacheKey(cols boil.Columns, nzDefaults []string) string {
 	return str
 }
 
+type LinkPreviewStatus string
+
+// Enum values for LinkPreviewStatus
+const (
+	LinkPreviewStatusPending LinkPreviewStatus = "pending"
+	LinkPreviewStatusReady   LinkPreviewStatus = "ready"
+	LinkPreviewStatusFailed  LinkPreviewStatus = "failed"
+)
+
+func AllLinkPreviewStatus() []LinkPreviewStatus {
+	return []LinkPreviewStatus{
+		LinkPreviewStatusPending,
+		LinkPreviewStatusReady,
+		LinkPreviewStatusFailed,
+	}
+}
+
+func (e LinkPreviewStatus) IsValid() error {
+	switch e {
+	case LinkPreviewStatusPending, LinkPreviewStatusReady, LinkPreviewStatusFailed:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e LinkPreviewStatus) String() string {
+	return string(e)
+}
+
+func (e LinkPreviewStatus) Ordinal() int {
+	switch e {
+	case LinkPreviewStatusPending:
+		return 0
+	case LinkPreviewStatusReady:
+		return 1
+	case LinkPreviewStatusFailed:
+		return 2
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type MediaKind string
+
+// Enum values for MediaKind
+const (
+	MediaKindImage MediaKind = "image"
+	MediaKindVideo MediaKind = "video"
+	MediaKindAudio MediaKind = "audio"
+)
+
+func AllMediaKind() []MediaKind {
+	return []MediaKind{
+		MediaKindImage,
+		MediaKindVideo,
+		MediaKindAudio,
+	}
+}
+
+func (e MediaKind) IsValid() error {
+	switch e {
+	case MediaKindImage, MediaKindVideo, MediaKindAudio:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e MediaKind) String() string {
+	return string(e)
+}
+
+func (e MediaKind) Ordinal() int {
+	switch e {
+	case MediaKindImage:
+		return 0
+	case MediaKindVideo:
+		return 1
+	case MediaKindAudio:
+		return 2
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type MediaRenditionStatus string
+
+// Enum values for MediaRenditionStatus
+const (
+	MediaRenditionStatusPending    MediaRenditionStatus = "pending"
+	MediaRenditionStatusReady      MediaRenditionStatus = "ready"
+	MediaRenditionStatusFailed     MediaRenditionStatus = "failed"
+	MediaRenditionStatusProcessing MediaRenditionStatus = "processing"
+)
+
+func AllMediaRenditionStatus() []MediaRenditionStatus {
+	return []MediaRenditionStatus{
+		MediaRenditionStatusPending,
+		MediaRenditionStatusReady,
+		MediaRenditionStatusFailed,
+		MediaRenditionStatusProcessing,
+	}
+}
+
+func (e MediaRenditionStatus) IsValid() error {
+	switch e {
+	case MediaRenditionStatusPending, MediaRenditionStatusReady, MediaRenditionStatusFailed, MediaRenditionStatusProcessing:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e MediaRenditionStatus) String() string {
+	return string(e)
+}
+
+func (e MediaRenditionStatus) Ordinal() int {
+	switch e {
+	case MediaRenditionStatusPending:
+		return 0
+	case MediaRenditionStatusReady:
+		return 1
+	case MediaRenditionStatusFailed:
+		return 2
+	case MediaRenditionStatusProcessing:
+		return 3
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+// NullMediaRenditionStatus is a nullable MediaRenditionStatus enum type. It supports SQL and JSON serialization.
+type NullMediaRenditionStatus struct {
+	Val   MediaRenditionStatus
+	Valid bool
+}
+
+// NullMediaRenditionStatusFrom creates a new MediaRenditionStatus that will never be blank.
+func NullMediaRenditionStatusFrom(v MediaRenditionStatus) NullMediaRenditionStatus {
+	return NewNullMediaRenditionStatus(v, true)
+}
+
+// NullMediaRenditionStatusFromPtr creates a new NullMediaRenditionStatus that be null if s is nil.
+func NullMediaRenditionStatusFromPtr(v *MediaRenditionStatus) NullMediaRenditionStatus {
+	if v == nil {
+		return NewNullMediaRenditionStatus("", false)
+	}
+	return NewNullMediaRenditionStatus(*v, true)
+}
+
+// NewNullMediaRenditionStatus creates a new NullMediaRenditionStatus
+func NewNullMediaRenditionStatus(v MediaRenditionStatus, valid bool) NullMediaRenditionStatus {
+	return NullMediaRenditionStatus{
+		Val:   v,
+		Valid: valid,
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *NullMediaRenditionStatus) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, null.NullBytes) {
+		e.Val = ""
+		e.Valid = false
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &e.Val); err != nil {
+		return err
+	}
+
+	e.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e NullMediaRenditionStatus) MarshalJSON() ([]byte, error) {
+	if !e.Valid {
+		return null.NullBytes, nil
+	}
+	return json.Marshal(e.Val)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (e NullMediaRenditionStatus) MarshalText() ([]byte, error) {
+	if !e.Valid {
+		return []byte{}, nil
+	}
+	return []byte(e.Val), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (e *NullMediaRenditionStatus) UnmarshalText(text []byte) error {
+	if text == nil || len(text) == 0 {
+		e.Valid = false
+		return nil
+	}
+
+	e.Val = MediaRenditionStatus(text)
+	e.Valid = true
+	return nil
+}
+
+// SetValid changes this NullMediaRenditionStatus value and also sets it to be non-null.
+func (e *NullMediaRenditionStatus) SetValid(v MediaRenditionStatus) {
+	e.Val = v
+	e.Valid = true
+}
+
+// Ptr returns a pointer to this NullMediaRenditionStatus value, or a nil pointer if this NullMediaRenditionStatus is null.
+func (e NullMediaRenditionStatus) Ptr() *MediaRenditionStatus {
+	if !e.Valid {
+		return nil
+	}
+	return &e.Val
+}
+
+// IsZero returns true for null types.
+func (e NullMediaRenditionStatus) IsZero() bool {
+	return !e.Valid
+}
+
+// Scan implements the Scanner interface.
+func (e *NullMediaRenditionStatus) Scan(value interface{}) error {
+	if value == nil {
+		e.Val, e.Valid = "", false
+		return nil
+	}
+	e.Valid = true
+	return convert.ConvertAssign((*string)(&e.Val), value)
+}
+
+// Value implements the driver Valuer interface.
+func (e NullMediaRenditionStatus) Value() (driver.Value, error) {
+	if !e.Valid {
+		return nil, nil
+	}
+	return string(e.Val), nil
+}
+
 type OutgoingEmailStatus string
 
 // Enum values for OutgoingEmailStatus
@@ -480,6 +724,134 @@ func (e ConnectionMediationDecision) Ordinal() int {
 	}
 }
 
+type FeedRuleField string
+
+// Enum values for FeedRuleField
+const (
+	FeedRuleFieldTitle       FeedRuleField = "title"
+	FeedRuleFieldDescription FeedRuleField = "description"
+	FeedRuleFieldHost        FeedRuleField = "host"
+)
+
+func AllFeedRuleField() []FeedRuleField {
+	return []FeedRuleField{
+		FeedRuleFieldTitle,
+		FeedRuleFieldDescription,
+		FeedRuleFieldHost,
+	}
+}
+
+func (e FeedRuleField) IsValid() error {
+	switch e {
+	case FeedRuleFieldTitle, FeedRuleFieldDescription, FeedRuleFieldHost:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e FeedRuleField) String() string {
+	return string(e)
+}
+
+func (e FeedRuleField) Ordinal() int {
+	switch e {
+	case FeedRuleFieldTitle:
+		return 0
+	case FeedRuleFieldDescription:
+		return 1
+	case FeedRuleFieldHost:
+		return 2
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type FeedRuleMatchType string
+
+// Enum values for FeedRuleMatchType
+const (
+	FeedRuleMatchTypeKeyword FeedRuleMatchType = "keyword"
+	FeedRuleMatchTypeRegex   FeedRuleMatchType = "regex"
+)
+
+func AllFeedRuleMatchType() []FeedRuleMatchType {
+	return []FeedRuleMatchType{
+		FeedRuleMatchTypeKeyword,
+		FeedRuleMatchTypeRegex,
+	}
+}
+
+func (e FeedRuleMatchType) IsValid() error {
+	switch e {
+	case FeedRuleMatchTypeKeyword, FeedRuleMatchTypeRegex:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e FeedRuleMatchType) String() string {
+	return string(e)
+}
+
+func (e FeedRuleMatchType) Ordinal() int {
+	switch e {
+	case FeedRuleMatchTypeKeyword:
+		return 0
+	case FeedRuleMatchTypeRegex:
+		return 1
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type FeedRuleAction string
+
+// Enum values for FeedRuleAction
+const (
+	FeedRuleActionDismiss   FeedRuleAction = "dismiss"
+	FeedRuleActionHighlight FeedRuleAction = "highlight"
+	FeedRuleActionDraftPost FeedRuleAction = "draft_post"
+)
+
+func AllFeedRuleAction() []FeedRuleAction {
+	return []FeedRuleAction{
+		FeedRuleActionDismiss,
+		FeedRuleActionHighlight,
+		FeedRuleActionDraftPost,
+	}
+}
+
+func (e FeedRuleAction) IsValid() error {
+	switch e {
+	case FeedRuleActionDismiss, FeedRuleActionHighlight, FeedRuleActionDraftPost:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e FeedRuleAction) String() string {
+	return string(e)
+}
+
+func (e FeedRuleAction) Ordinal() int {
+	switch e {
+	case FeedRuleActionDismiss:
+		return 0
+	case FeedRuleActionHighlight:
+		return 1
+	case FeedRuleActionDraftPost:
+		return 2
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
 type ProfileVisibility string
 
 // Enum values for ProfileVisibility
@@ -523,3 +895,47 @@ func (e ProfileVisibility) Ordinal() int {
 		panic(errors.New("enum is not valid"))
 	}
 }
+
+type AltTextPolicy string
+
+// Enum values for AltTextPolicy
+const (
+	AltTextPolicyOff   AltTextPolicy = "off"
+	AltTextPolicyWarn  AltTextPolicy = "warn"
+	AltTextPolicyBlock AltTextPolicy = "block"
+)
+
+func AllAltTextPolicy() []AltTextPolicy {
+	return []AltTextPolicy{
+		AltTextPolicyOff,
+		AltTextPolicyWarn,
+		AltTextPolicyBlock,
+	}
+}
+
+func (e AltTextPolicy) IsValid() error {
+	switch e {
+	case AltTextPolicyOff, AltTextPolicyWarn, AltTextPolicyBlock:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e AltTextPolicy) String() string {
+	return string(e)
+}
+
+func (e AltTextPolicy) Ordinal() int {
+	switch e {
+	case AltTextPolicyOff:
+		return 0
+	case AltTextPolicyWarn:
+		return 1
+	case AltTextPolicyBlock:
+		return 2
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
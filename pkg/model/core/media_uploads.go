@@ -24,76 +24,137 @@ import (
 
 // MediaUpload is an object representing the database table.
 type MediaUpload struct {
-	ID            string      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	UserID        null.String `boil:"user_id" json:"user_id,omitempty" toml:"user_id" yaml:"user_id,omitempty"`
-	UploadedFname string      `boil:"uploaded_fname" json:"uploaded_fname" toml:"uploaded_fname" yaml:"uploaded_fname"`
-	ContentType   string      `boil:"content_type" json:"content_type" toml:"content_type" yaml:"content_type"`
-	CreatedAt     time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt     time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	RSSFeedID     null.String `boil:"rss_feed_id" json:"rss_feed_id,omitempty" toml:"rss_feed_id" yaml:"rss_feed_id,omitempty"`
+	ID              string                   `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID          null.String              `boil:"user_id" json:"user_id,omitempty" toml:"user_id" yaml:"user_id,omitempty"`
+	UploadedFname   string                   `boil:"uploaded_fname" json:"uploaded_fname" toml:"uploaded_fname" yaml:"uploaded_fname"`
+	ContentType     string                   `boil:"content_type" json:"content_type" toml:"content_type" yaml:"content_type"`
+	CreatedAt       time.Time                `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       time.Time                `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	RSSFeedID       null.String              `boil:"rss_feed_id" json:"rss_feed_id,omitempty" toml:"rss_feed_id" yaml:"rss_feed_id,omitempty"`
+	SizeBytes       int64                    `boil:"size_bytes" json:"size_bytes" toml:"size_bytes" yaml:"size_bytes"`
+	Width           null.Int                 `boil:"width" json:"width,omitempty" toml:"width" yaml:"width,omitempty"`
+	Height          null.Int                 `boil:"height" json:"height,omitempty" toml:"height" yaml:"height,omitempty"`
+	Blurhash        null.String              `boil:"blurhash" json:"blurhash,omitempty" toml:"blurhash" yaml:"blurhash,omitempty"`
+	AltText         null.String              `boil:"alt_text" json:"alt_text,omitempty" toml:"alt_text" yaml:"alt_text,omitempty"`
+	Caption         null.String              `boil:"caption" json:"caption,omitempty" toml:"caption" yaml:"caption,omitempty"`
+	Kind            MediaKind                `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	RenditionStatus NullMediaRenditionStatus `boil:"rendition_status" json:"rendition_status,omitempty" toml:"rendition_status" yaml:"rendition_status,omitempty"`
+	RenditionFname  null.String              `boil:"rendition_fname" json:"rendition_fname,omitempty" toml:"rendition_fname" yaml:"rendition_fname,omitempty"`
+	PosterFname     null.String              `boil:"poster_fname" json:"poster_fname,omitempty" toml:"poster_fname" yaml:"poster_fname,omitempty"`
+	RenditionError  null.String              `boil:"rendition_error" json:"rendition_error,omitempty" toml:"rendition_error" yaml:"rendition_error,omitempty"`
+	URLID           null.String              `boil:"url_id" json:"url_id,omitempty" toml:"url_id" yaml:"url_id,omitempty"`
+	ClaimedAt       null.Time                `boil:"claimed_at" json:"claimed_at,omitempty" toml:"claimed_at" yaml:"claimed_at,omitempty"`
 
 	R *mediaUploadR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L mediaUploadL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var MediaUploadColumns = struct {
-	ID            string
-	UserID        string
-	UploadedFname string
-	ContentType   string
-	CreatedAt     string
-	UpdatedAt     string
-	RSSFeedID     string
+	ID              string
+	UserID          string
+	UploadedFname   string
+	ContentType     string
+	CreatedAt       string
+	UpdatedAt       string
+	RSSFeedID       string
+	SizeBytes       string
+	Width           string
+	Height          string
+	Blurhash        string
+	AltText         string
+	Caption         string
+	Kind            string
+	RenditionStatus string
+	RenditionFname  string
+	PosterFname     string
+	RenditionError  string
+	URLID           string
+	ClaimedAt       string
 }{
-	ID:            "id",
-	UserID:        "user_id",
-	UploadedFname: "uploaded_fname",
-	ContentType:   "content_type",
-	CreatedAt:     "created_at",
-	UpdatedAt:     "updated_at",
-	RSSFeedID:     "rss_feed_id",
+	ID:              "id",
+	UserID:          "user_id",
+	UploadedFname:   "uploaded_fname",
+	ContentType:     "content_type",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+	RSSFeedID:       "rss_feed_id",
+	SizeBytes:       "size_bytes",
+	Width:           "width",
+	Height:          "height",
+	Blurhash:        "blurhash",
+	AltText:         "alt_text",
+	Caption:         "caption",
+	Kind:            "kind",
+	RenditionStatus: "rendition_status",
+	RenditionFname:  "rendition_fname",
+	PosterFname:     "poster_fname",
+	RenditionError:  "rendition_error",
+	URLID:           "url_id",
+	ClaimedAt:       "claimed_at",
 }
 
 var MediaUploadTableColumns = struct {
-	ID            string
-	UserID        string
-	UploadedFname string
-	ContentType   string
-	CreatedAt     string
-	UpdatedAt     string
-	RSSFeedID     string
+	ID              string
+	UserID          string
+	UploadedFname   string
+	ContentType     string
+	CreatedAt       string
+	UpdatedAt       string
+	RSSFeedID       string
+	SizeBytes       string
+	Width           string
+	Height          string
+	Blurhash        string
+	AltText         string
+	Caption         string
+	Kind            string
+	RenditionStatus string
+	RenditionFname  string
+	PosterFname     string
+	RenditionError  string
+	URLID           string
+	ClaimedAt       string
 }{
-	ID:            "media_uploads.id",
-	UserID:        "media_uploads.user_id",
-	UploadedFname: "media_uploads.uploaded_fname",
-	ContentType:   "media_uploads.content_type",
-	CreatedAt:     "media_uploads.created_at",
-	UpdatedAt:     "media_uploads.updated_at",
-	RSSFeedID:     "media_uploads.rss_feed_id",
+	ID:              "media_uploads.id",
+	UserID:          "media_uploads.user_id",
+	UploadedFname:   "media_uploads.uploaded_fname",
+	ContentType:     "media_uploads.content_type",
+	CreatedAt:       "media_uploads.created_at",
+	UpdatedAt:       "media_uploads.updated_at",
+	RSSFeedID:       "media_uploads.rss_feed_id",
+	SizeBytes:       "media_uploads.size_bytes",
+	Width:           "media_uploads.width",
+	Height:          "media_uploads.height",
+	Blurhash:        "media_uploads.blurhash",
+	AltText:         "media_uploads.alt_text",
+	Caption:         "media_uploads.caption",
+	Kind:            "media_uploads.kind",
+	RenditionStatus: "media_uploads.rendition_status",
+	RenditionFname:  "media_uploads.rendition_fname",
+	PosterFname:     "media_uploads.poster_fname",
+	RenditionError:  "media_uploads.rendition_error",
+	URLID:           "media_uploads.url_id",
+	ClaimedAt:       "media_uploads.claimed_at",
 }
 
 // Generated where
 
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod   { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) ILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" ILIKE ?", x) }
-func (w whereHelperstring) NILIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT ILIKE ?", x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
+type whereHelperint64 struct{ field string }
+
+func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
 		values = append(values, value)
 	}
 	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
 }
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
+func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
 		values = append(values, value)
@@ -101,46 +162,72 @@ func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
 	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
 }
 
-type whereHelpernull_String struct{ field string }
+type whereHelpernull_Int struct{ field string }
 
-func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
 	return qmhelper.WhereNullEQ(w.field, false, x)
 }
-func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
 	return qmhelper.WhereNullEQ(w.field, true, x)
 }
-func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LT, x)
 }
-func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LTE, x)
 }
-func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GT, x)
 }
-func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
-func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" LIKE ?", x)
+func (w whereHelpernull_Int) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelperMediaKind struct{ field string }
+
+func (w whereHelperMediaKind) EQ(x MediaKind) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperMediaKind) NEQ(x MediaKind) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperMediaKind) LT(x MediaKind) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
 }
-func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT LIKE ?", x)
+func (w whereHelperMediaKind) LTE(x MediaKind) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
 }
-func (w whereHelpernull_String) ILIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" ILIKE ?", x)
+func (w whereHelperMediaKind) GT(x MediaKind) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
 }
-func (w whereHelpernull_String) NILIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT ILIKE ?", x)
+func (w whereHelperMediaKind) GTE(x MediaKind) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
-func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
+func (w whereHelperMediaKind) IN(slice []MediaKind) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
 		values = append(values, value)
 	}
 	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
 }
-func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
+func (w whereHelperMediaKind) NIN(slice []MediaKind) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
 		values = append(values, value)
@@ -148,61 +235,108 @@ func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
 	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
 }
 
-func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
-type whereHelpertime_Time struct{ field string }
+type whereHelperNullMediaRenditionStatus struct{ field string }
 
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
+func (w whereHelperNullMediaRenditionStatus) EQ(x NullMediaRenditionStatus) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
 }
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+func (w whereHelperNullMediaRenditionStatus) NEQ(x NullMediaRenditionStatus) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
 }
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+func (w whereHelperNullMediaRenditionStatus) LT(x NullMediaRenditionStatus) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LT, x)
 }
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+func (w whereHelperNullMediaRenditionStatus) LTE(x NullMediaRenditionStatus) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LTE, x)
 }
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+func (w whereHelperNullMediaRenditionStatus) GT(x NullMediaRenditionStatus) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GT, x)
 }
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+func (w whereHelperNullMediaRenditionStatus) GTE(x NullMediaRenditionStatus) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
+func (w whereHelperNullMediaRenditionStatus) IN(slice []NullMediaRenditionStatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperNullMediaRenditionStatus) NIN(slice []NullMediaRenditionStatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelperNullMediaRenditionStatus) IsNull() qm.QueryMod {
+	return qmhelper.WhereIsNull(w.field)
+}
+func (w whereHelperNullMediaRenditionStatus) IsNotNull() qm.QueryMod {
+	return qmhelper.WhereIsNotNull(w.field)
+}
 
 var MediaUploadWhere = struct {
-	ID            whereHelperstring
-	UserID        whereHelpernull_String
-	UploadedFname whereHelperstring
-	ContentType   whereHelperstring
-	CreatedAt     whereHelpertime_Time
-	UpdatedAt     whereHelpertime_Time
-	RSSFeedID     whereHelpernull_String
+	ID              whereHelperstring
+	UserID          whereHelpernull_String
+	UploadedFname   whereHelperstring
+	ContentType     whereHelperstring
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpertime_Time
+	RSSFeedID       whereHelpernull_String
+	SizeBytes       whereHelperint64
+	Width           whereHelpernull_Int
+	Height          whereHelpernull_Int
+	Blurhash        whereHelpernull_String
+	AltText         whereHelpernull_String
+	Caption         whereHelpernull_String
+	Kind            whereHelperMediaKind
+	RenditionStatus whereHelperNullMediaRenditionStatus
+	RenditionFname  whereHelpernull_String
+	PosterFname     whereHelpernull_String
+	RenditionError  whereHelpernull_String
+	URLID           whereHelpernull_String
+	ClaimedAt       whereHelpernull_Time
 }{
-	ID:            whereHelperstring{field: "\"media_uploads\".\"id\""},
-	UserID:        whereHelpernull_String{field: "\"media_uploads\".\"user_id\""},
-	UploadedFname: whereHelperstring{field: "\"media_uploads\".\"uploaded_fname\""},
-	ContentType:   whereHelperstring{field: "\"media_uploads\".\"content_type\""},
-	CreatedAt:     whereHelpertime_Time{field: "\"media_uploads\".\"created_at\""},
-	UpdatedAt:     whereHelpertime_Time{field: "\"media_uploads\".\"updated_at\""},
-	RSSFeedID:     whereHelpernull_String{field: "\"media_uploads\".\"rss_feed_id\""},
+	ID:              whereHelperstring{field: "\"media_uploads\".\"id\""},
+	UserID:          whereHelpernull_String{field: "\"media_uploads\".\"user_id\""},
+	UploadedFname:   whereHelperstring{field: "\"media_uploads\".\"uploaded_fname\""},
+	ContentType:     whereHelperstring{field: "\"media_uploads\".\"content_type\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"media_uploads\".\"created_at\""},
+	UpdatedAt:       whereHelpertime_Time{field: "\"media_uploads\".\"updated_at\""},
+	RSSFeedID:       whereHelpernull_String{field: "\"media_uploads\".\"rss_feed_id\""},
+	SizeBytes:       whereHelperint64{field: "\"media_uploads\".\"size_bytes\""},
+	Width:           whereHelpernull_Int{field: "\"media_uploads\".\"width\""},
+	Height:          whereHelpernull_Int{field: "\"media_uploads\".\"height\""},
+	Blurhash:        whereHelpernull_String{field: "\"media_uploads\".\"blurhash\""},
+	AltText:         whereHelpernull_String{field: "\"media_uploads\".\"alt_text\""},
+	Caption:         whereHelpernull_String{field: "\"media_uploads\".\"caption\""},
+	Kind:            whereHelperMediaKind{field: "\"media_uploads\".\"kind\""},
+	RenditionStatus: whereHelperNullMediaRenditionStatus{field: "\"media_uploads\".\"rendition_status\""},
+	RenditionFname:  whereHelpernull_String{field: "\"media_uploads\".\"rendition_fname\""},
+	PosterFname:     whereHelpernull_String{field: "\"media_uploads\".\"poster_fname\""},
+	RenditionError:  whereHelpernull_String{field: "\"media_uploads\".\"rendition_error\""},
+	URLID:           whereHelpernull_String{field: "\"media_uploads\".\"url_id\""},
+	ClaimedAt:       whereHelpernull_Time{field: "\"media_uploads\".\"claimed_at\""},
 }
 
 // MediaUploadRels is where relationship names are stored.
 var MediaUploadRels = struct {
 	RSSFeed string
+	URL     string
 	User    string
 }{
 	RSSFeed: "RSSFeed",
+	URL:     "URL",
 	User:    "User",
 }
 
 // mediaUploadR is where relationships are stored.
 type mediaUploadR struct {
-	RSSFeed *RSSFeed `boil:"RSSFeed" json:"RSSFeed" toml:"RSSFeed" yaml:"RSSFeed"`
-	User    *User    `boil:"User" json:"User" toml:"User" yaml:"User"`
+	RSSFeed *RSSFeed       `boil:"RSSFeed" json:"RSSFeed" toml:"RSSFeed" yaml:"RSSFeed"`
+	URL     *NormalizedURL `boil:"URL" json:"URL" toml:"URL" yaml:"URL"`
+	User    *User          `boil:"User" json:"User" toml:"User" yaml:"User"`
 }
 
 // NewStruct creates a new relationship struct
@@ -217,6 +351,13 @@ func (r *mediaUploadR) GetRSSFeed() *RSSFeed {
 	return r.RSSFeed
 }
 
+func (r *mediaUploadR) GetURL() *NormalizedURL {
+	if r == nil {
+		return nil
+	}
+	return r.URL
+}
+
 func (r *mediaUploadR) GetUser() *User {
 	if r == nil {
 		return nil
@@ -228,9 +369,9 @@ func (r *mediaUploadR) GetUser() *User {
 type mediaUploadL struct{}
 
 var (
-	mediaUploadAllColumns            = []string{"id", "user_id", "uploaded_fname", "content_type", "created_at", "updated_at", "rss_feed_id"}
+	mediaUploadAllColumns            = []string{"id", "user_id", "uploaded_fname", "content_type", "created_at", "updated_at", "rss_feed_id", "size_bytes", "width", "height", "blurhash", "alt_text", "caption", "kind", "rendition_status", "rendition_fname", "poster_fname", "rendition_error", "url_id", "claimed_at"}
 	mediaUploadColumnsWithoutDefault = []string{"id", "uploaded_fname", "content_type"}
-	mediaUploadColumnsWithDefault    = []string{"user_id", "created_at", "updated_at", "rss_feed_id"}
+	mediaUploadColumnsWithDefault    = []string{"user_id", "created_at", "updated_at", "rss_feed_id", "size_bytes", "width", "height", "blurhash", "alt_text", "caption", "kind", "rendition_status", "rendition_fname", "poster_fname", "rendition_error", "url_id", "claimed_at"}
 	mediaUploadPrimaryKeyColumns     = []string{"id"}
 	mediaUploadGeneratedColumns      = []string{}
 )
@@ -377,6 +518,17 @@ func (o *MediaUpload) RSSFeed(mods ...qm.QueryMod) rssFeedQuery {
 	return RSSFeeds(queryMods...)
 }
 
+// URL pointed to by the foreign key.
+func (o *MediaUpload) URL(mods ...qm.QueryMod) normalizedURLQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.URLID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return NormalizedUrls(queryMods...)
+}
+
 // User pointed to by the foreign key.
 func (o *MediaUpload) User(mods ...qm.QueryMod) userQuery {
 	queryMods := []qm.QueryMod{
@@ -504,6 +656,122 @@ func (mediaUploadL) LoadRSSFeed(ctx context.Context, e boil.ContextExecutor, sin
 	return nil
 }
 
+// LoadURL allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (mediaUploadL) LoadURL(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMediaUpload interface{}, mods queries.Applicator) error {
+	var slice []*MediaUpload
+	var object *MediaUpload
+
+	if singular {
+		var ok bool
+		object, ok = maybeMediaUpload.(*MediaUpload)
+		if !ok {
+			object = new(MediaUpload)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMediaUpload)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMediaUpload))
+			}
+		}
+	} else {
+		s, ok := maybeMediaUpload.(*[]*MediaUpload)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMediaUpload)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMediaUpload))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &mediaUploadR{}
+		}
+		if !queries.IsNil(object.URLID) {
+			args[object.URLID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &mediaUploadR{}
+			}
+
+			if !queries.IsNil(obj.URLID) {
+				args[obj.URLID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`normalized_urls`),
+		qm.WhereIn(`normalized_urls.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load NormalizedURL")
+	}
+
+	var resultSlice []*NormalizedURL
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice NormalizedURL")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for normalized_urls")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for normalized_urls")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.URL = foreign
+		if foreign.R == nil {
+			foreign.R = &normalizedURLR{}
+		}
+		foreign.R.URLMediaUploads = append(foreign.R.URLMediaUploads, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.URLID, foreign.ID) {
+				local.R.URL = foreign
+				if foreign.R == nil {
+					foreign.R = &normalizedURLR{}
+				}
+				foreign.R.URLMediaUploads = append(foreign.R.URLMediaUploads, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (mediaUploadL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMediaUpload interface{}, mods queries.Applicator) error {
@@ -720,6 +988,106 @@ func (o *MediaUpload) RemoveRSSFeed(ctx context.Context, exec boil.ContextExecut
 	return nil
 }
 
+// SetURLP of the mediaUpload to the related item.
+// Sets o.R.URL to related.
+// Adds o to related.R.URLMediaUploads.
+// Panics on error.
+func (o *MediaUpload) SetURLP(ctx context.Context, exec boil.ContextExecutor, insert bool, related *NormalizedURL) {
+	if err := o.SetURL(ctx, exec, insert, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetURL of the mediaUpload to the related item.
+// Sets o.R.URL to related.
+// Adds o to related.R.URLMediaUploads.
+func (o *MediaUpload) SetURL(ctx context.Context, exec boil.ContextExecutor, insert bool, related *NormalizedURL) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"media_uploads\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"url_id"}),
+		strmangle.WhereClause("\"", "\"", 2, mediaUploadPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.URLID, related.ID)
+	if o.R == nil {
+		o.R = &mediaUploadR{
+			URL: related,
+		}
+	} else {
+		o.R.URL = related
+	}
+
+	if related.R == nil {
+		related.R = &normalizedURLR{
+			URLMediaUploads: MediaUploadSlice{o},
+		}
+	} else {
+		related.R.URLMediaUploads = append(related.R.URLMediaUploads, o)
+	}
+
+	return nil
+}
+
+// RemoveURLP relationship.
+// Sets o.R.URL to nil.
+// Removes o from all passed in related items' relationships struct.
+// Panics on error.
+func (o *MediaUpload) RemoveURLP(ctx context.Context, exec boil.ContextExecutor, related *NormalizedURL) {
+	if err := o.RemoveURL(ctx, exec, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// RemoveURL relationship.
+// Sets o.R.URL to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *MediaUpload) RemoveURL(ctx context.Context, exec boil.ContextExecutor, related *NormalizedURL) error {
+	var err error
+
+	queries.SetScanner(&o.URLID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("url_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.URL = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.URLMediaUploads {
+		if queries.Equal(o.URLID, ri.URLID) {
+			continue
+		}
+
+		ln := len(related.R.URLMediaUploads)
+		if ln > 1 && i < ln-1 {
+			related.R.URLMediaUploads[i] = related.R.URLMediaUploads[ln-1]
+		}
+		related.R.URLMediaUploads = related.R.URLMediaUploads[:ln-1]
+		break
+	}
+	return nil
+}
+
 // SetUserP of the mediaUpload to the related item.
 // Sets o.R.User to related.
 // Adds o to related.R.MediaUploads.
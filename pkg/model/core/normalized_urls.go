@@ -72,10 +72,14 @@ var NormalizedURLWhere = struct {
 
 // NormalizedURLRels is where relationship names are stored.
 var NormalizedURLRels = struct {
+	URLLinkPreview   string
+	URLMediaUploads  string
 	URLPosts         string
 	URLRSSItems      string
 	URLUserFeedItems string
 }{
+	URLLinkPreview:   "URLLinkPreview",
+	URLMediaUploads:  "URLMediaUploads",
 	URLPosts:         "URLPosts",
 	URLRSSItems:      "URLRSSItems",
 	URLUserFeedItems: "URLUserFeedItems",
@@ -83,6 +87,8 @@ var NormalizedURLRels = struct {
 
 // normalizedURLR is where relationships are stored.
 type normalizedURLR struct {
+	URLLinkPreview   *LinkPreview      `boil:"URLLinkPreview" json:"URLLinkPreview" toml:"URLLinkPreview" yaml:"URLLinkPreview"`
+	URLMediaUploads  MediaUploadSlice  `boil:"URLMediaUploads" json:"URLMediaUploads" toml:"URLMediaUploads" yaml:"URLMediaUploads"`
 	URLPosts         PostSlice         `boil:"URLPosts" json:"URLPosts" toml:"URLPosts" yaml:"URLPosts"`
 	URLRSSItems      RSSItemSlice      `boil:"URLRSSItems" json:"URLRSSItems" toml:"URLRSSItems" yaml:"URLRSSItems"`
 	URLUserFeedItems UserFeedItemSlice `boil:"URLUserFeedItems" json:"URLUserFeedItems" toml:"URLUserFeedItems" yaml:"URLUserFeedItems"`
@@ -93,6 +99,20 @@ func (*normalizedURLR) NewStruct() *normalizedURLR {
 	return &normalizedURLR{}
 }
 
+func (r *normalizedURLR) GetURLLinkPreview() *LinkPreview {
+	if r == nil {
+		return nil
+	}
+	return r.URLLinkPreview
+}
+
+func (r *normalizedURLR) GetURLMediaUploads() MediaUploadSlice {
+	if r == nil {
+		return nil
+	}
+	return r.URLMediaUploads
+}
+
 func (r *normalizedURLR) GetURLPosts() PostSlice {
 	if r == nil {
 		return nil
@@ -256,6 +276,31 @@ func (q normalizedURLQuery) Exists(ctx context.Context, exec boil.ContextExecuto
 	return count > 0, nil
 }
 
+// URLLinkPreview pointed to by the foreign key.
+func (o *NormalizedURL) URLLinkPreview(mods ...qm.QueryMod) linkPreviewQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"url_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return LinkPreviews(queryMods...)
+}
+
+// URLMediaUploads retrieves all the media_upload's MediaUploads with an executor via url_id column.
+func (o *NormalizedURL) URLMediaUploads(mods ...qm.QueryMod) mediaUploadQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"media_uploads\".\"url_id\"=?", o.ID),
+	)
+
+	return MediaUploads(queryMods...)
+}
+
 // URLPosts retrieves all the post's Posts with an executor via url_id column.
 func (o *NormalizedURL) URLPosts(mods ...qm.QueryMod) postQuery {
 	var queryMods []qm.QueryMod
@@ -298,6 +343,221 @@ func (o *NormalizedURL) URLUserFeedItems(mods ...qm.QueryMod) userFeedItemQuery
 	return UserFeedItems(queryMods...)
 }
 
+// LoadURLLinkPreview allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (normalizedURLL) LoadURLLinkPreview(ctx context.Context, e boil.ContextExecutor, singular bool, maybeNormalizedURL interface{}, mods queries.Applicator) error {
+	var slice []*NormalizedURL
+	var object *NormalizedURL
+
+	if singular {
+		var ok bool
+		object, ok = maybeNormalizedURL.(*NormalizedURL)
+		if !ok {
+			object = new(NormalizedURL)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeNormalizedURL)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeNormalizedURL))
+			}
+		}
+	} else {
+		s, ok := maybeNormalizedURL.(*[]*NormalizedURL)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeNormalizedURL)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeNormalizedURL))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &normalizedURLR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &normalizedURLR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`link_previews`),
+		qm.WhereIn(`link_previews.url_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load LinkPreview")
+	}
+
+	var resultSlice []*LinkPreview
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice LinkPreview")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for link_previews")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for link_previews")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.URLLinkPreview = foreign
+		if foreign.R == nil {
+			foreign.R = &linkPreviewR{}
+		}
+		foreign.R.URL = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.URLID {
+				local.R.URLLinkPreview = foreign
+				if foreign.R == nil {
+					foreign.R = &linkPreviewR{}
+				}
+				foreign.R.URL = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadURLMediaUploads allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (normalizedURLL) LoadURLMediaUploads(ctx context.Context, e boil.ContextExecutor, singular bool, maybeNormalizedURL interface{}, mods queries.Applicator) error {
+	var slice []*NormalizedURL
+	var object *NormalizedURL
+
+	if singular {
+		var ok bool
+		object, ok = maybeNormalizedURL.(*NormalizedURL)
+		if !ok {
+			object = new(NormalizedURL)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeNormalizedURL)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeNormalizedURL))
+			}
+		}
+	} else {
+		s, ok := maybeNormalizedURL.(*[]*NormalizedURL)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeNormalizedURL)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeNormalizedURL))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &normalizedURLR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &normalizedURLR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`media_uploads`),
+		qm.WhereIn(`media_uploads.url_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load media_uploads")
+	}
+
+	var resultSlice []*MediaUpload
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice media_uploads")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on media_uploads")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for media_uploads")
+	}
+
+	if singular {
+		object.R.URLMediaUploads = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &mediaUploadR{}
+			}
+			foreign.R.URL = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.URLID) {
+				local.R.URLMediaUploads = append(local.R.URLMediaUploads, foreign)
+				if foreign.R == nil {
+					foreign.R = &mediaUploadR{}
+				}
+				foreign.R.URL = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadURLPosts allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (normalizedURLL) LoadURLPosts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeNormalizedURL interface{}, mods queries.Applicator) error {
@@ -616,6 +876,227 @@ func (normalizedURLL) LoadURLUserFeedItems(ctx context.Context, e boil.ContextEx
 	return nil
 }
 
+// SetURLLinkPreviewP of the normalizedURL to the related item.
+// Sets o.R.URLLinkPreview to related.
+// Adds o to related.R.URL.
+// Panics on error.
+func (o *NormalizedURL) SetURLLinkPreviewP(ctx context.Context, exec boil.ContextExecutor, insert bool, related *LinkPreview) {
+	if err := o.SetURLLinkPreview(ctx, exec, insert, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetURLLinkPreview of the normalizedURL to the related item.
+// Sets o.R.URLLinkPreview to related.
+// Adds o to related.R.URL.
+func (o *NormalizedURL) SetURLLinkPreview(ctx context.Context, exec boil.ContextExecutor, insert bool, related *LinkPreview) error {
+	var err error
+
+	if insert {
+		related.URLID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"link_previews\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"url_id"}),
+			strmangle.WhereClause("\"", "\"", 2, linkPreviewPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.URLID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &normalizedURLR{
+			URLLinkPreview: related,
+		}
+	} else {
+		o.R.URLLinkPreview = related
+	}
+
+	if related.R == nil {
+		related.R = &linkPreviewR{
+			URL: o,
+		}
+	} else {
+		related.R.URL = o
+	}
+	return nil
+}
+
+// AddURLMediaUploadsP adds the given related objects to the existing relationships
+// of the normalized_url, optionally inserting them as new records.
+// Appends related to o.R.URLMediaUploads.
+// Sets related.R.URL appropriately.
+// Panics on error.
+func (o *NormalizedURL) AddURLMediaUploadsP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MediaUpload) {
+	if err := o.AddURLMediaUploads(ctx, exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddURLMediaUploads adds the given related objects to the existing relationships
+// of the normalized_url, optionally inserting them as new records.
+// Appends related to o.R.URLMediaUploads.
+// Sets related.R.URL appropriately.
+func (o *NormalizedURL) AddURLMediaUploads(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MediaUpload) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.URLID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"media_uploads\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"url_id"}),
+				strmangle.WhereClause("\"", "\"", 2, mediaUploadPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.URLID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &normalizedURLR{
+			URLMediaUploads: related,
+		}
+	} else {
+		o.R.URLMediaUploads = append(o.R.URLMediaUploads, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &mediaUploadR{
+				URL: o,
+			}
+		} else {
+			rel.R.URL = o
+		}
+	}
+	return nil
+}
+
+// SetURLMediaUploadsP removes all previously related items of the
+// normalized_url replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.URL's URLMediaUploads accordingly.
+// Replaces o.R.URLMediaUploads with related.
+// Sets related.R.URL's URLMediaUploads accordingly.
+// Panics on error.
+func (o *NormalizedURL) SetURLMediaUploadsP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MediaUpload) {
+	if err := o.SetURLMediaUploads(ctx, exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetURLMediaUploads removes all previously related items of the
+// normalized_url replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.URL's URLMediaUploads accordingly.
+// Replaces o.R.URLMediaUploads with related.
+// Sets related.R.URL's URLMediaUploads accordingly.
+func (o *NormalizedURL) SetURLMediaUploads(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MediaUpload) error {
+	query := "update \"media_uploads\" set \"url_id\" = null where \"url_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.URLMediaUploads {
+			queries.SetScanner(&rel.URLID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.URL = nil
+		}
+		o.R.URLMediaUploads = nil
+	}
+
+	return o.AddURLMediaUploads(ctx, exec, insert, related...)
+}
+
+// RemoveURLMediaUploadsP relationships from objects passed in.
+// Removes related items from R.URLMediaUploads (uses pointer comparison, removal does not keep order)
+// Sets related.R.URL.
+// Panics on error.
+func (o *NormalizedURL) RemoveURLMediaUploadsP(ctx context.Context, exec boil.ContextExecutor, related ...*MediaUpload) {
+	if err := o.RemoveURLMediaUploads(ctx, exec, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// RemoveURLMediaUploads relationships from objects passed in.
+// Removes related items from R.URLMediaUploads (uses pointer comparison, removal does not keep order)
+// Sets related.R.URL.
+func (o *NormalizedURL) RemoveURLMediaUploads(ctx context.Context, exec boil.ContextExecutor, related ...*MediaUpload) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.URLID, nil)
+		if rel.R != nil {
+			rel.R.URL = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("url_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.URLMediaUploads {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.URLMediaUploads)
+			if ln > 1 && i < ln-1 {
+				o.R.URLMediaUploads[i] = o.R.URLMediaUploads[ln-1]
+			}
+			o.R.URLMediaUploads = o.R.URLMediaUploads[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // AddURLPostsP adds the given related objects to the existing relationships
 // of the normalized_url, optionally inserting them as new records.
 // Appends related to o.R.URLPosts.
@@ -25,13 +25,14 @@ import (
 // PostComment is an object representing the database table.
 type PostComment struct {
 	ID              string      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	UserID          string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	UserID          null.String `boil:"user_id" json:"user_id,omitempty" toml:"user_id" yaml:"user_id,omitempty"`
 	PostID          string      `boil:"post_id" json:"post_id" toml:"post_id" yaml:"post_id"`
 	ParentCommentID null.String `boil:"parent_comment_id" json:"parent_comment_id,omitempty" toml:"parent_comment_id" yaml:"parent_comment_id,omitempty"`
 	Body            string      `boil:"body" json:"body" toml:"body" yaml:"body"`
 	CreatedAt       time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt       time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 	TopCommentID    string      `boil:"top_comment_id" json:"top_comment_id" toml:"top_comment_id" yaml:"top_comment_id"`
+	DeletedAt       null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
 
 	R *postCommentR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L postCommentL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -46,6 +47,7 @@ var PostCommentColumns = struct {
 	CreatedAt       string
 	UpdatedAt       string
 	TopCommentID    string
+	DeletedAt       string
 }{
 	ID:              "id",
 	UserID:          "user_id",
@@ -55,6 +57,7 @@ var PostCommentColumns = struct {
 	CreatedAt:       "created_at",
 	UpdatedAt:       "updated_at",
 	TopCommentID:    "top_comment_id",
+	DeletedAt:       "deleted_at",
 }
 
 var PostCommentTableColumns = struct {
@@ -66,6 +69,7 @@ var PostCommentTableColumns = struct {
 	CreatedAt       string
 	UpdatedAt       string
 	TopCommentID    string
+	DeletedAt       string
 }{
 	ID:              "post_comments.id",
 	UserID:          "post_comments.user_id",
@@ -75,28 +79,31 @@ var PostCommentTableColumns = struct {
 	CreatedAt:       "post_comments.created_at",
 	UpdatedAt:       "post_comments.updated_at",
 	TopCommentID:    "post_comments.top_comment_id",
+	DeletedAt:       "post_comments.deleted_at",
 }
 
 // Generated where
 
 var PostCommentWhere = struct {
 	ID              whereHelperstring
-	UserID          whereHelperstring
+	UserID          whereHelpernull_String
 	PostID          whereHelperstring
 	ParentCommentID whereHelpernull_String
 	Body            whereHelperstring
 	CreatedAt       whereHelpertime_Time
 	UpdatedAt       whereHelpertime_Time
 	TopCommentID    whereHelperstring
+	DeletedAt       whereHelpernull_Time
 }{
 	ID:              whereHelperstring{field: "\"post_comments\".\"id\""},
-	UserID:          whereHelperstring{field: "\"post_comments\".\"user_id\""},
+	UserID:          whereHelpernull_String{field: "\"post_comments\".\"user_id\""},
 	PostID:          whereHelperstring{field: "\"post_comments\".\"post_id\""},
 	ParentCommentID: whereHelpernull_String{field: "\"post_comments\".\"parent_comment_id\""},
 	Body:            whereHelperstring{field: "\"post_comments\".\"body\""},
 	CreatedAt:       whereHelpertime_Time{field: "\"post_comments\".\"created_at\""},
 	UpdatedAt:       whereHelpertime_Time{field: "\"post_comments\".\"updated_at\""},
 	TopCommentID:    whereHelperstring{field: "\"post_comments\".\"top_comment_id\""},
+	DeletedAt:       whereHelpernull_Time{field: "\"post_comments\".\"deleted_at\""},
 }
 
 // PostCommentRels is where relationship names are stored.
@@ -105,6 +112,7 @@ var PostCommentRels = struct {
 	Post                      string
 	TopComment                string
 	User                      string
+	CommentMediaReferences    string
 	ParentCommentPostComments string
 	TopCommentPostComments    string
 }{
@@ -112,18 +120,20 @@ var PostCommentRels = struct {
 	Post:                      "Post",
 	TopComment:                "TopComment",
 	User:                      "User",
+	CommentMediaReferences:    "CommentMediaReferences",
 	ParentCommentPostComments: "ParentCommentPostComments",
 	TopCommentPostComments:    "TopCommentPostComments",
 }
 
 // postCommentR is where relationships are stored.
 type postCommentR struct {
-	ParentComment             *PostComment     `boil:"ParentComment" json:"ParentComment" toml:"ParentComment" yaml:"ParentComment"`
-	Post                      *Post            `boil:"Post" json:"Post" toml:"Post" yaml:"Post"`
-	TopComment                *PostComment     `boil:"TopComment" json:"TopComment" toml:"TopComment" yaml:"TopComment"`
-	User                      *User            `boil:"User" json:"User" toml:"User" yaml:"User"`
-	ParentCommentPostComments PostCommentSlice `boil:"ParentCommentPostComments" json:"ParentCommentPostComments" toml:"ParentCommentPostComments" yaml:"ParentCommentPostComments"`
-	TopCommentPostComments    PostCommentSlice `boil:"TopCommentPostComments" json:"TopCommentPostComments" toml:"TopCommentPostComments" yaml:"TopCommentPostComments"`
+	ParentComment             *PostComment        `boil:"ParentComment" json:"ParentComment" toml:"ParentComment" yaml:"ParentComment"`
+	Post                      *Post               `boil:"Post" json:"Post" toml:"Post" yaml:"Post"`
+	TopComment                *PostComment        `boil:"TopComment" json:"TopComment" toml:"TopComment" yaml:"TopComment"`
+	User                      *User               `boil:"User" json:"User" toml:"User" yaml:"User"`
+	CommentMediaReferences    MediaReferenceSlice `boil:"CommentMediaReferences" json:"CommentMediaReferences" toml:"CommentMediaReferences" yaml:"CommentMediaReferences"`
+	ParentCommentPostComments PostCommentSlice    `boil:"ParentCommentPostComments" json:"ParentCommentPostComments" toml:"ParentCommentPostComments" yaml:"ParentCommentPostComments"`
+	TopCommentPostComments    PostCommentSlice    `boil:"TopCommentPostComments" json:"TopCommentPostComments" toml:"TopCommentPostComments" yaml:"TopCommentPostComments"`
 }
 
 // NewStruct creates a new relationship struct
@@ -159,6 +169,13 @@ func (r *postCommentR) GetUser() *User {
 	return r.User
 }
 
+func (r *postCommentR) GetCommentMediaReferences() MediaReferenceSlice {
+	if r == nil {
+		return nil
+	}
+	return r.CommentMediaReferences
+}
+
 func (r *postCommentR) GetParentCommentPostComments() PostCommentSlice {
 	if r == nil {
 		return nil
@@ -177,9 +194,9 @@ func (r *postCommentR) GetTopCommentPostComments() PostCommentSlice {
 type postCommentL struct{}
 
 var (
-	postCommentAllColumns            = []string{"id", "user_id", "post_id", "parent_comment_id", "body", "created_at", "updated_at", "top_comment_id"}
-	postCommentColumnsWithoutDefault = []string{"id", "user_id", "post_id", "body", "created_at", "updated_at", "top_comment_id"}
-	postCommentColumnsWithDefault    = []string{"parent_comment_id"}
+	postCommentAllColumns            = []string{"id", "user_id", "post_id", "parent_comment_id", "body", "created_at", "updated_at", "top_comment_id", "deleted_at"}
+	postCommentColumnsWithoutDefault = []string{"id", "post_id", "body", "created_at", "updated_at", "top_comment_id"}
+	postCommentColumnsWithDefault    = []string{"user_id", "parent_comment_id", "deleted_at"}
 	postCommentPrimaryKeyColumns     = []string{"id"}
 	postCommentGeneratedColumns      = []string{}
 )
@@ -359,6 +376,20 @@ func (o *PostComment) User(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// CommentMediaReferences retrieves all the media_reference's MediaReferences with an executor via comment_id column.
+func (o *PostComment) CommentMediaReferences(mods ...qm.QueryMod) mediaReferenceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"media_references\".\"comment_id\"=?", o.ID),
+	)
+
+	return MediaReferences(queryMods...)
+}
+
 // ParentCommentPostComments retrieves all the post_comment's PostComments with an executor via parent_comment_id column.
 func (o *PostComment) ParentCommentPostComments(mods ...qm.QueryMod) postCommentQuery {
 	var queryMods []qm.QueryMod
@@ -760,7 +791,9 @@ func (postCommentL) LoadUser(ctx context.Context, e boil.ContextExecutor, singul
 		if object.R == nil {
 			object.R = &postCommentR{}
 		}
-		args[object.UserID] = struct{}{}
+		if !queries.IsNil(object.UserID) {
+			args[object.UserID] = struct{}{}
+		}
 
 	} else {
 		for _, obj := range slice {
@@ -768,7 +801,9 @@ func (postCommentL) LoadUser(ctx context.Context, e boil.ContextExecutor, singul
 				obj.R = &postCommentR{}
 			}
 
-			args[obj.UserID] = struct{}{}
+			if !queries.IsNil(obj.UserID) {
+				args[obj.UserID] = struct{}{}
+			}
 
 		}
 	}
@@ -825,7 +860,7 @@ func (postCommentL) LoadUser(ctx context.Context, e boil.ContextExecutor, singul
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			if local.UserID == foreign.ID {
+			if queries.Equal(local.UserID, foreign.ID) {
 				local.R.User = foreign
 				if foreign.R == nil {
 					foreign.R = &userR{}
@@ -839,6 +874,112 @@ func (postCommentL) LoadUser(ctx context.Context, e boil.ContextExecutor, singul
 	return nil
 }
 
+// LoadCommentMediaReferences allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postCommentL) LoadCommentMediaReferences(ctx context.Context, e boil.ContextExecutor, singular bool, maybePostComment interface{}, mods queries.Applicator) error {
+	var slice []*PostComment
+	var object *PostComment
+
+	if singular {
+		var ok bool
+		object, ok = maybePostComment.(*PostComment)
+		if !ok {
+			object = new(PostComment)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePostComment)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePostComment))
+			}
+		}
+	} else {
+		s, ok := maybePostComment.(*[]*PostComment)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePostComment)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePostComment))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &postCommentR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postCommentR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`media_references`),
+		qm.WhereIn(`media_references.comment_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load media_references")
+	}
+
+	var resultSlice []*MediaReference
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice media_references")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on media_references")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for media_references")
+	}
+
+	if singular {
+		object.R.CommentMediaReferences = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &mediaReferenceR{}
+			}
+			foreign.R.Comment = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CommentID) {
+				local.R.CommentMediaReferences = append(local.R.CommentMediaReferences, foreign)
+				if foreign.R == nil {
+					foreign.R = &mediaReferenceR{}
+				}
+				foreign.R.Comment = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadParentCommentPostComments allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (postCommentL) LoadParentCommentPostComments(ctx context.Context, e boil.ContextExecutor, singular bool, maybePostComment interface{}, mods queries.Applicator) error {
@@ -1302,7 +1443,7 @@ func (o *PostComment) SetUser(ctx context.Context, exec boil.ContextExecutor, in
 		return errors.Wrap(err, "failed to update local table")
 	}
 
-	o.UserID = related.ID
+	queries.Assign(&o.UserID, related.ID)
 	if o.R == nil {
 		o.R = &postCommentR{
 			User: related,
@@ -1322,6 +1463,210 @@ func (o *PostComment) SetUser(ctx context.Context, exec boil.ContextExecutor, in
 	return nil
 }
 
+// RemoveUserP relationship.
+// Sets o.R.User to nil.
+// Removes o from all passed in related items' relationships struct.
+// Panics on error.
+func (o *PostComment) RemoveUserP(ctx context.Context, exec boil.ContextExecutor, related *User) {
+	if err := o.RemoveUser(ctx, exec, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// RemoveUser relationship.
+// Sets o.R.User to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *PostComment) RemoveUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.UserID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.User = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.PostComments {
+		if queries.Equal(o.UserID, ri.UserID) {
+			continue
+		}
+
+		ln := len(related.R.PostComments)
+		if ln > 1 && i < ln-1 {
+			related.R.PostComments[i] = related.R.PostComments[ln-1]
+		}
+		related.R.PostComments = related.R.PostComments[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddCommentMediaReferencesP adds the given related objects to the existing relationships
+// of the post_comment, optionally inserting them as new records.
+// Appends related to o.R.CommentMediaReferences.
+// Sets related.R.Comment appropriately.
+// Panics on error.
+func (o *PostComment) AddCommentMediaReferencesP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MediaReference) {
+	if err := o.AddCommentMediaReferences(ctx, exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddCommentMediaReferences adds the given related objects to the existing relationships
+// of the post_comment, optionally inserting them as new records.
+// Appends related to o.R.CommentMediaReferences.
+// Sets related.R.Comment appropriately.
+func (o *PostComment) AddCommentMediaReferences(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MediaReference) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CommentID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"media_references\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"comment_id"}),
+				strmangle.WhereClause("\"", "\"", 2, mediaReferencePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CommentID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &postCommentR{
+			CommentMediaReferences: related,
+		}
+	} else {
+		o.R.CommentMediaReferences = append(o.R.CommentMediaReferences, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &mediaReferenceR{
+				Comment: o,
+			}
+		} else {
+			rel.R.Comment = o
+		}
+	}
+	return nil
+}
+
+// SetCommentMediaReferencesP removes all previously related items of the
+// post_comment replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Comment's CommentMediaReferences accordingly.
+// Replaces o.R.CommentMediaReferences with related.
+// Sets related.R.Comment's CommentMediaReferences accordingly.
+// Panics on error.
+func (o *PostComment) SetCommentMediaReferencesP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MediaReference) {
+	if err := o.SetCommentMediaReferences(ctx, exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetCommentMediaReferences removes all previously related items of the
+// post_comment replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Comment's CommentMediaReferences accordingly.
+// Replaces o.R.CommentMediaReferences with related.
+// Sets related.R.Comment's CommentMediaReferences accordingly.
+func (o *PostComment) SetCommentMediaReferences(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MediaReference) error {
+	query := "update \"media_references\" set \"comment_id\" = null where \"comment_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CommentMediaReferences {
+			queries.SetScanner(&rel.CommentID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Comment = nil
+		}
+		o.R.CommentMediaReferences = nil
+	}
+
+	return o.AddCommentMediaReferences(ctx, exec, insert, related...)
+}
+
+// RemoveCommentMediaReferencesP relationships from objects passed in.
+// Removes related items from R.CommentMediaReferences (uses pointer comparison, removal does not keep order)
+// Sets related.R.Comment.
+// Panics on error.
+func (o *PostComment) RemoveCommentMediaReferencesP(ctx context.Context, exec boil.ContextExecutor, related ...*MediaReference) {
+	if err := o.RemoveCommentMediaReferences(ctx, exec, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// RemoveCommentMediaReferences relationships from objects passed in.
+// Removes related items from R.CommentMediaReferences (uses pointer comparison, removal does not keep order)
+// Sets related.R.Comment.
+func (o *PostComment) RemoveCommentMediaReferences(ctx context.Context, exec boil.ContextExecutor, related ...*MediaReference) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CommentID, nil)
+		if rel.R != nil {
+			rel.R.Comment = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("comment_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CommentMediaReferences {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CommentMediaReferences)
+			if ln > 1 && i < ln-1 {
+				o.R.CommentMediaReferences[i] = o.R.CommentMediaReferences[ln-1]
+			}
+			o.R.CommentMediaReferences = o.R.CommentMediaReferences[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // AddParentCommentPostCommentsP adds the given related objects to the existing relationships
 // of the post_comment, optionally inserting them as new records.
 // Appends related to o.R.ParentCommentPostComments.
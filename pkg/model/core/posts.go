@@ -150,32 +150,35 @@ var PostWhere = struct {
 
 // PostRels is where relationship names are stored.
 var PostRels = struct {
-	RSSItem      string
-	URL          string
-	User         string
-	PostPrompt   string
-	PostShare    string
-	PostStat     string
-	PostComments string
+	RSSItem         string
+	URL             string
+	User            string
+	PostPrompt      string
+	PostShare       string
+	PostStat        string
+	MediaReferences string
+	PostComments    string
 }{
-	RSSItem:      "RSSItem",
-	URL:          "URL",
-	User:         "User",
-	PostPrompt:   "PostPrompt",
-	PostShare:    "PostShare",
-	PostStat:     "PostStat",
-	PostComments: "PostComments",
+	RSSItem:         "RSSItem",
+	URL:             "URL",
+	User:            "User",
+	PostPrompt:      "PostPrompt",
+	PostShare:       "PostShare",
+	PostStat:        "PostStat",
+	MediaReferences: "MediaReferences",
+	PostComments:    "PostComments",
 }
 
 // postR is where relationships are stored.
 type postR struct {
-	RSSItem      *RSSItem         `boil:"RSSItem" json:"RSSItem" toml:"RSSItem" yaml:"RSSItem"`
-	URL          *NormalizedURL   `boil:"URL" json:"URL" toml:"URL" yaml:"URL"`
-	User         *User            `boil:"User" json:"User" toml:"User" yaml:"User"`
-	PostPrompt   *PostPrompt      `boil:"PostPrompt" json:"PostPrompt" toml:"PostPrompt" yaml:"PostPrompt"`
-	PostShare    *PostShare       `boil:"PostShare" json:"PostShare" toml:"PostShare" yaml:"PostShare"`
-	PostStat     *PostStat        `boil:"PostStat" json:"PostStat" toml:"PostStat" yaml:"PostStat"`
-	PostComments PostCommentSlice `boil:"PostComments" json:"PostComments" toml:"PostComments" yaml:"PostComments"`
+	RSSItem         *RSSItem            `boil:"RSSItem" json:"RSSItem" toml:"RSSItem" yaml:"RSSItem"`
+	URL             *NormalizedURL      `boil:"URL" json:"URL" toml:"URL" yaml:"URL"`
+	User            *User               `boil:"User" json:"User" toml:"User" yaml:"User"`
+	PostPrompt      *PostPrompt         `boil:"PostPrompt" json:"PostPrompt" toml:"PostPrompt" yaml:"PostPrompt"`
+	PostShare       *PostShare          `boil:"PostShare" json:"PostShare" toml:"PostShare" yaml:"PostShare"`
+	PostStat        *PostStat           `boil:"PostStat" json:"PostStat" toml:"PostStat" yaml:"PostStat"`
+	MediaReferences MediaReferenceSlice `boil:"MediaReferences" json:"MediaReferences" toml:"MediaReferences" yaml:"MediaReferences"`
+	PostComments    PostCommentSlice    `boil:"PostComments" json:"PostComments" toml:"PostComments" yaml:"PostComments"`
 }
 
 // NewStruct creates a new relationship struct
@@ -225,6 +228,13 @@ func (r *postR) GetPostStat() *PostStat {
 	return r.PostStat
 }
 
+func (r *postR) GetMediaReferences() MediaReferenceSlice {
+	if r == nil {
+		return nil
+	}
+	return r.MediaReferences
+}
+
 func (r *postR) GetPostComments() PostCommentSlice {
 	if r == nil {
 		return nil
@@ -440,6 +450,20 @@ func (o *Post) PostStat(mods ...qm.QueryMod) postStatQuery {
 	return PostStats(queryMods...)
 }
 
+// MediaReferences retrieves all the media_reference's MediaReferences with an executor.
+func (o *Post) MediaReferences(mods ...qm.QueryMod) mediaReferenceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"media_references\".\"post_id\"=?", o.ID),
+	)
+
+	return MediaReferences(queryMods...)
+}
+
 // PostComments retrieves all the post_comment's PostComments with an executor.
 func (o *Post) PostComments(mods ...qm.QueryMod) postCommentQuery {
 	var queryMods []qm.QueryMod
@@ -1125,6 +1149,112 @@ func (postL) LoadPostStat(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
+// LoadMediaReferences allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadMediaReferences(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		var ok bool
+		object, ok = maybePost.(*Post)
+		if !ok {
+			object = new(Post)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePost))
+			}
+		}
+	} else {
+		s, ok := maybePost.(*[]*Post)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePost))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`media_references`),
+		qm.WhereIn(`media_references.post_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load media_references")
+	}
+
+	var resultSlice []*MediaReference
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice media_references")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on media_references")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for media_references")
+	}
+
+	if singular {
+		object.R.MediaReferences = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &mediaReferenceR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PostID {
+				local.R.MediaReferences = append(local.R.MediaReferences, foreign)
+				if foreign.R == nil {
+					foreign.R = &mediaReferenceR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadPostComments allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (postL) LoadPostComments(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
@@ -1702,6 +1832,70 @@ func (o *Post) SetPostStat(ctx context.Context, exec boil.ContextExecutor, inser
 	return nil
 }
 
+// AddMediaReferencesP adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.MediaReferences.
+// Sets related.R.Post appropriately.
+// Panics on error.
+func (o *Post) AddMediaReferencesP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MediaReference) {
+	if err := o.AddMediaReferences(ctx, exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddMediaReferences adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.MediaReferences.
+// Sets related.R.Post appropriately.
+func (o *Post) AddMediaReferences(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MediaReference) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PostID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"media_references\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+				strmangle.WhereClause("\"", "\"", 2, mediaReferencePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PostID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &postR{
+			MediaReferences: related,
+		}
+	} else {
+		o.R.MediaReferences = append(o.R.MediaReferences, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &mediaReferenceR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
+	}
+	return nil
+}
+
 // AddPostCommentsP adds the given related objects to the existing relationships
 // of the post, optionally inserting them as new records.
 // Appends related to o.R.PostComments.
@@ -39,6 +39,12 @@ type RSSFeed struct {
 	UpdatedAt               time.Time                `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 	LastFetchError          null.String              `boil:"last_fetch_error" json:"last_fetch_error,omitempty" toml:"last_fetch_error" yaml:"last_fetch_error,omitempty"`
 	DisableReason           NullRSSFeedDisableReason `boil:"disable_reason" json:"disable_reason,omitempty" toml:"disable_reason" yaml:"disable_reason,omitempty"`
+	WebsubHubURL            null.String              `boil:"websub_hub_url" json:"websub_hub_url,omitempty" toml:"websub_hub_url" yaml:"websub_hub_url,omitempty"`
+	WebsubTopicURL          null.String              `boil:"websub_topic_url" json:"websub_topic_url,omitempty" toml:"websub_topic_url" yaml:"websub_topic_url,omitempty"`
+	WebsubSecret            null.String              `boil:"websub_secret" json:"websub_secret,omitempty" toml:"websub_secret" yaml:"websub_secret,omitempty"`
+	WebsubRequestedAt       null.Time                `boil:"websub_requested_at" json:"websub_requested_at,omitempty" toml:"websub_requested_at" yaml:"websub_requested_at,omitempty"`
+	WebsubLeaseExpiresAt    null.Time                `boil:"websub_lease_expires_at" json:"websub_lease_expires_at,omitempty" toml:"websub_lease_expires_at" yaml:"websub_lease_expires_at,omitempty"`
+	WebsubLastPushAt        null.Time                `boil:"websub_last_push_at" json:"websub_last_push_at,omitempty" toml:"websub_last_push_at" yaml:"websub_last_push_at,omitempty"`
 
 	R *rssFeedR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L rssFeedL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -60,6 +66,12 @@ var RSSFeedColumns = struct {
 	UpdatedAt               string
 	LastFetchError          string
 	DisableReason           string
+	WebsubHubURL            string
+	WebsubTopicURL          string
+	WebsubSecret            string
+	WebsubRequestedAt       string
+	WebsubLeaseExpiresAt    string
+	WebsubLastPushAt        string
 }{
 	ID:                      "id",
 	URL:                     "url",
@@ -76,6 +88,12 @@ var RSSFeedColumns = struct {
 	UpdatedAt:               "updated_at",
 	LastFetchError:          "last_fetch_error",
 	DisableReason:           "disable_reason",
+	WebsubHubURL:            "websub_hub_url",
+	WebsubTopicURL:          "websub_topic_url",
+	WebsubSecret:            "websub_secret",
+	WebsubRequestedAt:       "websub_requested_at",
+	WebsubLeaseExpiresAt:    "websub_lease_expires_at",
+	WebsubLastPushAt:        "websub_last_push_at",
 }
 
 var RSSFeedTableColumns = struct {
@@ -94,6 +112,12 @@ var RSSFeedTableColumns = struct {
 	UpdatedAt               string
 	LastFetchError          string
 	DisableReason           string
+	WebsubHubURL            string
+	WebsubTopicURL          string
+	WebsubSecret            string
+	WebsubRequestedAt       string
+	WebsubLeaseExpiresAt    string
+	WebsubLastPushAt        string
 }{
 	ID:                      "rss_feeds.id",
 	URL:                     "rss_feeds.url",
@@ -110,6 +134,12 @@ var RSSFeedTableColumns = struct {
 	UpdatedAt:               "rss_feeds.updated_at",
 	LastFetchError:          "rss_feeds.last_fetch_error",
 	DisableReason:           "rss_feeds.disable_reason",
+	WebsubHubURL:            "rss_feeds.websub_hub_url",
+	WebsubTopicURL:          "rss_feeds.websub_topic_url",
+	WebsubSecret:            "rss_feeds.websub_secret",
+	WebsubRequestedAt:       "rss_feeds.websub_requested_at",
+	WebsubLeaseExpiresAt:    "rss_feeds.websub_lease_expires_at",
+	WebsubLastPushAt:        "rss_feeds.websub_last_push_at",
 }
 
 // Generated where
@@ -201,6 +231,12 @@ var RSSFeedWhere = struct {
 	UpdatedAt               whereHelpertime_Time
 	LastFetchError          whereHelpernull_String
 	DisableReason           whereHelperNullRSSFeedDisableReason
+	WebsubHubURL            whereHelpernull_String
+	WebsubTopicURL          whereHelpernull_String
+	WebsubSecret            whereHelpernull_String
+	WebsubRequestedAt       whereHelpernull_Time
+	WebsubLeaseExpiresAt    whereHelpernull_Time
+	WebsubLastPushAt        whereHelpernull_Time
 }{
 	ID:                      whereHelperstring{field: "\"rss_feeds\".\"id\""},
 	URL:                     whereHelperstring{field: "\"rss_feeds\".\"url\""},
@@ -217,6 +253,12 @@ var RSSFeedWhere = struct {
 	UpdatedAt:               whereHelpertime_Time{field: "\"rss_feeds\".\"updated_at\""},
 	LastFetchError:          whereHelpernull_String{field: "\"rss_feeds\".\"last_fetch_error\""},
 	DisableReason:           whereHelperNullRSSFeedDisableReason{field: "\"rss_feeds\".\"disable_reason\""},
+	WebsubHubURL:            whereHelpernull_String{field: "\"rss_feeds\".\"websub_hub_url\""},
+	WebsubTopicURL:          whereHelpernull_String{field: "\"rss_feeds\".\"websub_topic_url\""},
+	WebsubSecret:            whereHelpernull_String{field: "\"rss_feeds\".\"websub_secret\""},
+	WebsubRequestedAt:       whereHelpernull_Time{field: "\"rss_feeds\".\"websub_requested_at\""},
+	WebsubLeaseExpiresAt:    whereHelpernull_Time{field: "\"rss_feeds\".\"websub_lease_expires_at\""},
+	WebsubLastPushAt:        whereHelpernull_Time{field: "\"rss_feeds\".\"websub_last_push_at\""},
 }
 
 // RSSFeedRels is where relationship names are stored.
@@ -224,10 +266,12 @@ var RSSFeedRels = struct {
 	MediaUploads              string
 	FeedRSSItems              string
 	FeedUserFeedSubscriptions string
+	FeedWebsubPushes          string
 }{
 	MediaUploads:              "MediaUploads",
 	FeedRSSItems:              "FeedRSSItems",
 	FeedUserFeedSubscriptions: "FeedUserFeedSubscriptions",
+	FeedWebsubPushes:          "FeedWebsubPushes",
 }
 
 // rssFeedR is where relationships are stored.
@@ -235,6 +279,7 @@ type rssFeedR struct {
 	MediaUploads              MediaUploadSlice          `boil:"MediaUploads" json:"MediaUploads" toml:"MediaUploads" yaml:"MediaUploads"`
 	FeedRSSItems              RSSItemSlice              `boil:"FeedRSSItems" json:"FeedRSSItems" toml:"FeedRSSItems" yaml:"FeedRSSItems"`
 	FeedUserFeedSubscriptions UserFeedSubscriptionSlice `boil:"FeedUserFeedSubscriptions" json:"FeedUserFeedSubscriptions" toml:"FeedUserFeedSubscriptions" yaml:"FeedUserFeedSubscriptions"`
+	FeedWebsubPushes          WebsubPushSlice           `boil:"FeedWebsubPushes" json:"FeedWebsubPushes" toml:"FeedWebsubPushes" yaml:"FeedWebsubPushes"`
 }
 
 // NewStruct creates a new relationship struct
@@ -263,13 +308,20 @@ func (r *rssFeedR) GetFeedUserFeedSubscriptions() UserFeedSubscriptionSlice {
 	return r.FeedUserFeedSubscriptions
 }
 
+func (r *rssFeedR) GetFeedWebsubPushes() WebsubPushSlice {
+	if r == nil {
+		return nil
+	}
+	return r.FeedWebsubPushes
+}
+
 // rssFeedL is where Load methods for each relationship are stored.
 type rssFeedL struct{}
 
 var (
-	rssFeedAllColumns            = []string{"id", "url", "title", "description", "last_fetched_at", "avg_items_per_day", "last_items_count", "update_frequency_minutes", "next_fetch_at", "last_manual_refresh_at", "consecutive_empty_fetches", "created_at", "updated_at", "last_fetch_error", "disable_reason"}
+	rssFeedAllColumns            = []string{"id", "url", "title", "description", "last_fetched_at", "avg_items_per_day", "last_items_count", "update_frequency_minutes", "next_fetch_at", "last_manual_refresh_at", "consecutive_empty_fetches", "created_at", "updated_at", "last_fetch_error", "disable_reason", "websub_hub_url", "websub_topic_url", "websub_secret", "websub_requested_at", "websub_lease_expires_at", "websub_last_push_at"}
 	rssFeedColumnsWithoutDefault = []string{"id", "url", "avg_items_per_day", "update_frequency_minutes", "consecutive_empty_fetches", "created_at", "updated_at"}
-	rssFeedColumnsWithDefault    = []string{"title", "description", "last_fetched_at", "last_items_count", "next_fetch_at", "last_manual_refresh_at", "last_fetch_error", "disable_reason"}
+	rssFeedColumnsWithDefault    = []string{"title", "description", "last_fetched_at", "last_items_count", "next_fetch_at", "last_manual_refresh_at", "last_fetch_error", "disable_reason", "websub_hub_url", "websub_topic_url", "websub_secret", "websub_requested_at", "websub_lease_expires_at", "websub_last_push_at"}
 	rssFeedPrimaryKeyColumns     = []string{"id"}
 	rssFeedGeneratedColumns      = []string{}
 )
@@ -447,6 +499,20 @@ func (o *RSSFeed) FeedUserFeedSubscriptions(mods ...qm.QueryMod) userFeedSubscri
 	return UserFeedSubscriptions(queryMods...)
 }
 
+// FeedWebsubPushes retrieves all the websub_push's WebsubPushes with an executor via feed_id column.
+func (o *RSSFeed) FeedWebsubPushes(mods ...qm.QueryMod) websubPushQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"websub_pushes\".\"feed_id\"=?", o.ID),
+	)
+
+	return WebsubPushes(queryMods...)
+}
+
 // LoadMediaUploads allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (rssFeedL) LoadMediaUploads(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRSSFeed interface{}, mods queries.Applicator) error {
@@ -765,6 +831,112 @@ func (rssFeedL) LoadFeedUserFeedSubscriptions(ctx context.Context, e boil.Contex
 	return nil
 }
 
+// LoadFeedWebsubPushes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (rssFeedL) LoadFeedWebsubPushes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRSSFeed interface{}, mods queries.Applicator) error {
+	var slice []*RSSFeed
+	var object *RSSFeed
+
+	if singular {
+		var ok bool
+		object, ok = maybeRSSFeed.(*RSSFeed)
+		if !ok {
+			object = new(RSSFeed)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRSSFeed)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRSSFeed))
+			}
+		}
+	} else {
+		s, ok := maybeRSSFeed.(*[]*RSSFeed)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRSSFeed)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRSSFeed))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &rssFeedR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &rssFeedR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`websub_pushes`),
+		qm.WhereIn(`websub_pushes.feed_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load websub_pushes")
+	}
+
+	var resultSlice []*WebsubPush
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice websub_pushes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on websub_pushes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for websub_pushes")
+	}
+
+	if singular {
+		object.R.FeedWebsubPushes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &websubPushR{}
+			}
+			foreign.R.Feed = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FeedID {
+				local.R.FeedWebsubPushes = append(local.R.FeedWebsubPushes, foreign)
+				if foreign.R == nil {
+					foreign.R = &websubPushR{}
+				}
+				foreign.R.Feed = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // AddMediaUploadsP adds the given related objects to the existing relationships
 // of the rss_feed, optionally inserting them as new records.
 // Appends related to o.R.MediaUploads.
@@ -1054,6 +1226,70 @@ func (o *RSSFeed) AddFeedUserFeedSubscriptions(ctx context.Context, exec boil.Co
 	return nil
 }
 
+// AddFeedWebsubPushesP adds the given related objects to the existing relationships
+// of the rss_feed, optionally inserting them as new records.
+// Appends related to o.R.FeedWebsubPushes.
+// Sets related.R.Feed appropriately.
+// Panics on error.
+func (o *RSSFeed) AddFeedWebsubPushesP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebsubPush) {
+	if err := o.AddFeedWebsubPushes(ctx, exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddFeedWebsubPushes adds the given related objects to the existing relationships
+// of the rss_feed, optionally inserting them as new records.
+// Appends related to o.R.FeedWebsubPushes.
+// Sets related.R.Feed appropriately.
+func (o *RSSFeed) AddFeedWebsubPushes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebsubPush) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FeedID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"websub_pushes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"feed_id"}),
+				strmangle.WhereClause("\"", "\"", 2, websubPushPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FeedID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &rssFeedR{
+			FeedWebsubPushes: related,
+		}
+	} else {
+		o.R.FeedWebsubPushes = append(o.R.FeedWebsubPushes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &websubPushR{
+				Feed: o,
+			}
+		} else {
+			rel.R.Feed = o
+		}
+	}
+	return nil
+}
+
 // RSSFeeds retrieves all the records using an executor.
 func RSSFeeds(mods ...qm.QueryMod) rssFeedQuery {
 	mods = append(mods, qm.From("\"rss_feeds\""))
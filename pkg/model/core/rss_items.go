@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -23,16 +24,18 @@ import (
 
 // RSSItem is an object representing the database table.
 type RSSItem struct {
-	ID                   string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	FeedID               string    `boil:"feed_id" json:"feed_id" toml:"feed_id" yaml:"feed_id"`
-	URLID                string    `boil:"url_id" json:"url_id" toml:"url_id" yaml:"url_id"`
-	GUID                 string    `boil:"guid" json:"guid" toml:"guid" yaml:"guid"`
-	Title                string    `boil:"title" json:"title" toml:"title" yaml:"title"`
-	Description          string    `boil:"description" json:"description" toml:"description" yaml:"description"`
-	SanitizedDescription string    `boil:"sanitized_description" json:"sanitized_description" toml:"sanitized_description" yaml:"sanitized_description"`
-	PublishedAt          time.Time `boil:"published_at" json:"published_at" toml:"published_at" yaml:"published_at"`
-	CreatedAt            time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt            time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID                   string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	FeedID               string      `boil:"feed_id" json:"feed_id" toml:"feed_id" yaml:"feed_id"`
+	URLID                string      `boil:"url_id" json:"url_id" toml:"url_id" yaml:"url_id"`
+	GUID                 string      `boil:"guid" json:"guid" toml:"guid" yaml:"guid"`
+	Title                string      `boil:"title" json:"title" toml:"title" yaml:"title"`
+	Description          string      `boil:"description" json:"description" toml:"description" yaml:"description"`
+	SanitizedDescription string      `boil:"sanitized_description" json:"sanitized_description" toml:"sanitized_description" yaml:"sanitized_description"`
+	PublishedAt          time.Time   `boil:"published_at" json:"published_at" toml:"published_at" yaml:"published_at"`
+	CreatedAt            time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt            time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	FullContent          null.String `boil:"full_content" json:"full_content,omitempty" toml:"full_content" yaml:"full_content,omitempty"`
+	FullContentError     null.String `boil:"full_content_error" json:"full_content_error,omitempty" toml:"full_content_error" yaml:"full_content_error,omitempty"`
 
 	R *rssItemR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L rssItemL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -49,6 +52,8 @@ var RSSItemColumns = struct {
 	PublishedAt          string
 	CreatedAt            string
 	UpdatedAt            string
+	FullContent          string
+	FullContentError     string
 }{
 	ID:                   "id",
 	FeedID:               "feed_id",
@@ -60,6 +65,8 @@ var RSSItemColumns = struct {
 	PublishedAt:          "published_at",
 	CreatedAt:            "created_at",
 	UpdatedAt:            "updated_at",
+	FullContent:          "full_content",
+	FullContentError:     "full_content_error",
 }
 
 var RSSItemTableColumns = struct {
@@ -73,6 +80,8 @@ var RSSItemTableColumns = struct {
 	PublishedAt          string
 	CreatedAt            string
 	UpdatedAt            string
+	FullContent          string
+	FullContentError     string
 }{
 	ID:                   "rss_items.id",
 	FeedID:               "rss_items.feed_id",
@@ -84,6 +93,8 @@ var RSSItemTableColumns = struct {
 	PublishedAt:          "rss_items.published_at",
 	CreatedAt:            "rss_items.created_at",
 	UpdatedAt:            "rss_items.updated_at",
+	FullContent:          "rss_items.full_content",
+	FullContentError:     "rss_items.full_content_error",
 }
 
 // Generated where
@@ -99,6 +110,8 @@ var RSSItemWhere = struct {
 	PublishedAt          whereHelpertime_Time
 	CreatedAt            whereHelpertime_Time
 	UpdatedAt            whereHelpertime_Time
+	FullContent          whereHelpernull_String
+	FullContentError     whereHelpernull_String
 }{
 	ID:                   whereHelperstring{field: "\"rss_items\".\"id\""},
 	FeedID:               whereHelperstring{field: "\"rss_items\".\"feed_id\""},
@@ -110,6 +123,8 @@ var RSSItemWhere = struct {
 	PublishedAt:          whereHelpertime_Time{field: "\"rss_items\".\"published_at\""},
 	CreatedAt:            whereHelpertime_Time{field: "\"rss_items\".\"created_at\""},
 	UpdatedAt:            whereHelpertime_Time{field: "\"rss_items\".\"updated_at\""},
+	FullContent:          whereHelpernull_String{field: "\"rss_items\".\"full_content\""},
+	FullContentError:     whereHelpernull_String{field: "\"rss_items\".\"full_content_error\""},
 }
 
 // RSSItemRels is where relationship names are stored.
@@ -170,9 +185,9 @@ func (r *rssItemR) GetUserFeedItems() UserFeedItemSlice {
 type rssItemL struct{}
 
 var (
-	rssItemAllColumns            = []string{"id", "feed_id", "url_id", "guid", "title", "description", "sanitized_description", "published_at", "created_at", "updated_at"}
+	rssItemAllColumns            = []string{"id", "feed_id", "url_id", "guid", "title", "description", "sanitized_description", "published_at", "created_at", "updated_at", "full_content", "full_content_error"}
 	rssItemColumnsWithoutDefault = []string{"id", "feed_id", "url_id", "guid", "title", "description", "sanitized_description", "published_at", "created_at", "updated_at"}
-	rssItemColumnsWithDefault    = []string{}
+	rssItemColumnsWithDefault    = []string{"full_content", "full_content_error"}
 	rssItemPrimaryKeyColumns     = []string{"id"}
 	rssItemGeneratedColumns      = []string{}
 )
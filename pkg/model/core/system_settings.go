@@ -23,46 +23,58 @@ import (
 
 // SystemSetting is an object representing the database table.
 type SystemSetting struct {
-	ID               string `boil:"id" json:"id" toml:"id" yaml:"id"`
-	RegistrationOpen bool   `boil:"registration_open" json:"registration_open" toml:"registration_open" yaml:"registration_open"`
+	ID                     string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	RegistrationOpen       bool   `boil:"registration_open" json:"registration_open" toml:"registration_open" yaml:"registration_open"`
+	DefaultMediaQuotaBytes int64  `boil:"default_media_quota_bytes" json:"default_media_quota_bytes" toml:"default_media_quota_bytes" yaml:"default_media_quota_bytes"`
+	TwoFactorRequired      bool   `boil:"two_factor_required" json:"two_factor_required" toml:"two_factor_required" yaml:"two_factor_required"`
+	TwoFactorRememberDays  int    `boil:"two_factor_remember_days" json:"two_factor_remember_days" toml:"two_factor_remember_days" yaml:"two_factor_remember_days"`
 
 	R *systemSettingR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L systemSettingL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var SystemSettingColumns = struct {
-	ID               string
-	RegistrationOpen string
+	ID                     string
+	RegistrationOpen       string
+	DefaultMediaQuotaBytes string
+	TwoFactorRequired      string
+	TwoFactorRememberDays  string
 }{
-	ID:               "id",
-	RegistrationOpen: "registration_open",
+	ID:                     "id",
+	RegistrationOpen:       "registration_open",
+	DefaultMediaQuotaBytes: "default_media_quota_bytes",
+	TwoFactorRequired:      "two_factor_required",
+	TwoFactorRememberDays:  "two_factor_remember_days",
 }
 
 var SystemSettingTableColumns = struct {
-	ID               string
-	RegistrationOpen string
+	ID                     string
+	RegistrationOpen       string
+	DefaultMediaQuotaBytes string
+	TwoFactorRequired      string
+	TwoFactorRememberDays  string
 }{
-	ID:               "system_settings.id",
-	RegistrationOpen: "system_settings.registration_open",
+	ID:                     "system_settings.id",
+	RegistrationOpen:       "system_settings.registration_open",
+	DefaultMediaQuotaBytes: "system_settings.default_media_quota_bytes",
+	TwoFactorRequired:      "system_settings.two_factor_required",
+	TwoFactorRememberDays:  "system_settings.two_factor_remember_days",
 }
 
 // Generated where
 
-type whereHelperbool struct{ field string }
-
-func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-
 var SystemSettingWhere = struct {
-	ID               whereHelperstring
-	RegistrationOpen whereHelperbool
+	ID                     whereHelperstring
+	RegistrationOpen       whereHelperbool
+	DefaultMediaQuotaBytes whereHelperint64
+	TwoFactorRequired      whereHelperbool
+	TwoFactorRememberDays  whereHelperint
 }{
-	ID:               whereHelperstring{field: "\"system_settings\".\"id\""},
-	RegistrationOpen: whereHelperbool{field: "\"system_settings\".\"registration_open\""},
+	ID:                     whereHelperstring{field: "\"system_settings\".\"id\""},
+	RegistrationOpen:       whereHelperbool{field: "\"system_settings\".\"registration_open\""},
+	DefaultMediaQuotaBytes: whereHelperint64{field: "\"system_settings\".\"default_media_quota_bytes\""},
+	TwoFactorRequired:      whereHelperbool{field: "\"system_settings\".\"two_factor_required\""},
+	TwoFactorRememberDays:  whereHelperint{field: "\"system_settings\".\"two_factor_remember_days\""},
 }
 
 // SystemSettingRels is where relationship names are stored.
@@ -82,9 +94,9 @@ func (*systemSettingR) NewStruct() *systemSettingR {
 type systemSettingL struct{}
 
 var (
-	systemSettingAllColumns            = []string{"id", "registration_open"}
+	systemSettingAllColumns            = []string{"id", "registration_open", "default_media_quota_bytes", "two_factor_required", "two_factor_remember_days"}
 	systemSettingColumnsWithoutDefault = []string{"id", "registration_open"}
-	systemSettingColumnsWithDefault    = []string{}
+	systemSettingColumnsWithDefault    = []string{"default_media_quota_bytes", "two_factor_required", "two_factor_remember_days"}
 	systemSettingPrimaryKeyColumns     = []string{"id"}
 	systemSettingGeneratedColumns      = []string{}
 )
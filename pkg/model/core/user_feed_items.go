@@ -23,72 +23,79 @@ import (
 
 // UserFeedItem is an object representing the database table.
 type UserFeedItem struct {
-	ID          string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	UserID      string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	RSSItemID   string    `boil:"rss_item_id" json:"rss_item_id" toml:"rss_item_id" yaml:"rss_item_id"`
-	URLID       string    `boil:"url_id" json:"url_id" toml:"url_id" yaml:"url_id"`
-	IsDismissed bool      `boil:"is_dismissed" json:"is_dismissed" toml:"is_dismissed" yaml:"is_dismissed"`
-	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt   time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID            string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID        string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	RSSItemID     string    `boil:"rss_item_id" json:"rss_item_id" toml:"rss_item_id" yaml:"rss_item_id"`
+	URLID         string    `boil:"url_id" json:"url_id" toml:"url_id" yaml:"url_id"`
+	IsDismissed   bool      `boil:"is_dismissed" json:"is_dismissed" toml:"is_dismissed" yaml:"is_dismissed"`
+	CreatedAt     time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt     time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	IsHighlighted bool      `boil:"is_highlighted" json:"is_highlighted" toml:"is_highlighted" yaml:"is_highlighted"`
 
 	R *userFeedItemR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userFeedItemL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var UserFeedItemColumns = struct {
-	ID          string
-	UserID      string
-	RSSItemID   string
-	URLID       string
-	IsDismissed string
-	CreatedAt   string
-	UpdatedAt   string
+	ID            string
+	UserID        string
+	RSSItemID     string
+	URLID         string
+	IsDismissed   string
+	CreatedAt     string
+	UpdatedAt     string
+	IsHighlighted string
 }{
-	ID:          "id",
-	UserID:      "user_id",
-	RSSItemID:   "rss_item_id",
-	URLID:       "url_id",
-	IsDismissed: "is_dismissed",
-	CreatedAt:   "created_at",
-	UpdatedAt:   "updated_at",
+	ID:            "id",
+	UserID:        "user_id",
+	RSSItemID:     "rss_item_id",
+	URLID:         "url_id",
+	IsDismissed:   "is_dismissed",
+	CreatedAt:     "created_at",
+	UpdatedAt:     "updated_at",
+	IsHighlighted: "is_highlighted",
 }
 
 var UserFeedItemTableColumns = struct {
-	ID          string
-	UserID      string
-	RSSItemID   string
-	URLID       string
-	IsDismissed string
-	CreatedAt   string
-	UpdatedAt   string
+	ID            string
+	UserID        string
+	RSSItemID     string
+	URLID         string
+	IsDismissed   string
+	CreatedAt     string
+	UpdatedAt     string
+	IsHighlighted string
 }{
-	ID:          "user_feed_items.id",
-	UserID:      "user_feed_items.user_id",
-	RSSItemID:   "user_feed_items.rss_item_id",
-	URLID:       "user_feed_items.url_id",
-	IsDismissed: "user_feed_items.is_dismissed",
-	CreatedAt:   "user_feed_items.created_at",
-	UpdatedAt:   "user_feed_items.updated_at",
+	ID:            "user_feed_items.id",
+	UserID:        "user_feed_items.user_id",
+	RSSItemID:     "user_feed_items.rss_item_id",
+	URLID:         "user_feed_items.url_id",
+	IsDismissed:   "user_feed_items.is_dismissed",
+	CreatedAt:     "user_feed_items.created_at",
+	UpdatedAt:     "user_feed_items.updated_at",
+	IsHighlighted: "user_feed_items.is_highlighted",
 }
 
 // Generated where
 
 var UserFeedItemWhere = struct {
-	ID          whereHelperstring
-	UserID      whereHelperstring
-	RSSItemID   whereHelperstring
-	URLID       whereHelperstring
-	IsDismissed whereHelperbool
-	CreatedAt   whereHelpertime_Time
-	UpdatedAt   whereHelpertime_Time
+	ID            whereHelperstring
+	UserID        whereHelperstring
+	RSSItemID     whereHelperstring
+	URLID         whereHelperstring
+	IsDismissed   whereHelperbool
+	CreatedAt     whereHelpertime_Time
+	UpdatedAt     whereHelpertime_Time
+	IsHighlighted whereHelperbool
 }{
-	ID:          whereHelperstring{field: "\"user_feed_items\".\"id\""},
-	UserID:      whereHelperstring{field: "\"user_feed_items\".\"user_id\""},
-	RSSItemID:   whereHelperstring{field: "\"user_feed_items\".\"rss_item_id\""},
-	URLID:       whereHelperstring{field: "\"user_feed_items\".\"url_id\""},
-	IsDismissed: whereHelperbool{field: "\"user_feed_items\".\"is_dismissed\""},
-	CreatedAt:   whereHelpertime_Time{field: "\"user_feed_items\".\"created_at\""},
-	UpdatedAt:   whereHelpertime_Time{field: "\"user_feed_items\".\"updated_at\""},
+	ID:            whereHelperstring{field: "\"user_feed_items\".\"id\""},
+	UserID:        whereHelperstring{field: "\"user_feed_items\".\"user_id\""},
+	RSSItemID:     whereHelperstring{field: "\"user_feed_items\".\"rss_item_id\""},
+	URLID:         whereHelperstring{field: "\"user_feed_items\".\"url_id\""},
+	IsDismissed:   whereHelperbool{field: "\"user_feed_items\".\"is_dismissed\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"user_feed_items\".\"created_at\""},
+	UpdatedAt:     whereHelpertime_Time{field: "\"user_feed_items\".\"updated_at\""},
+	IsHighlighted: whereHelperbool{field: "\"user_feed_items\".\"is_highlighted\""},
 }
 
 // UserFeedItemRels is where relationship names are stored.
@@ -139,9 +146,9 @@ func (r *userFeedItemR) GetUser() *User {
 type userFeedItemL struct{}
 
 var (
-	userFeedItemAllColumns            = []string{"id", "user_id", "rss_item_id", "url_id", "is_dismissed", "created_at", "updated_at"}
+	userFeedItemAllColumns            = []string{"id", "user_id", "rss_item_id", "url_id", "is_dismissed", "created_at", "updated_at", "is_highlighted"}
 	userFeedItemColumnsWithoutDefault = []string{"id", "user_id", "rss_item_id", "url_id", "is_dismissed", "created_at", "updated_at"}
-	userFeedItemColumnsWithDefault    = []string{}
+	userFeedItemColumnsWithDefault    = []string{"is_highlighted"}
 	userFeedItemPrimaryKeyColumns     = []string{"id"}
 	userFeedItemGeneratedColumns      = []string{}
 )
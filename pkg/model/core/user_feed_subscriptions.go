@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -23,73 +24,93 @@ import (
 
 // UserFeedSubscription is an object representing the database table.
 type UserFeedSubscription struct {
-	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	UserID    string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	FeedID    string    `boil:"feed_id" json:"feed_id" toml:"feed_id" yaml:"feed_id"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID               string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID           string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	FeedID           string      `boil:"feed_id" json:"feed_id" toml:"feed_id" yaml:"feed_id"`
+	CreatedAt        time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt        time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	FetchFullArticle bool        `boil:"fetch_full_article" json:"fetch_full_article" toml:"fetch_full_article" yaml:"fetch_full_article"`
+	FolderID         null.String `boil:"folder_id" json:"folder_id,omitempty" toml:"folder_id" yaml:"folder_id,omitempty"`
 
 	R *userFeedSubscriptionR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userFeedSubscriptionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var UserFeedSubscriptionColumns = struct {
-	ID        string
-	UserID    string
-	FeedID    string
-	CreatedAt string
-	UpdatedAt string
+	ID               string
+	UserID           string
+	FeedID           string
+	CreatedAt        string
+	UpdatedAt        string
+	FetchFullArticle string
+	FolderID         string
 }{
-	ID:        "id",
-	UserID:    "user_id",
-	FeedID:    "feed_id",
-	CreatedAt: "created_at",
-	UpdatedAt: "updated_at",
+	ID:               "id",
+	UserID:           "user_id",
+	FeedID:           "feed_id",
+	CreatedAt:        "created_at",
+	UpdatedAt:        "updated_at",
+	FetchFullArticle: "fetch_full_article",
+	FolderID:         "folder_id",
 }
 
 var UserFeedSubscriptionTableColumns = struct {
-	ID        string
-	UserID    string
-	FeedID    string
-	CreatedAt string
-	UpdatedAt string
+	ID               string
+	UserID           string
+	FeedID           string
+	CreatedAt        string
+	UpdatedAt        string
+	FetchFullArticle string
+	FolderID         string
 }{
-	ID:        "user_feed_subscriptions.id",
-	UserID:    "user_feed_subscriptions.user_id",
-	FeedID:    "user_feed_subscriptions.feed_id",
-	CreatedAt: "user_feed_subscriptions.created_at",
-	UpdatedAt: "user_feed_subscriptions.updated_at",
+	ID:               "user_feed_subscriptions.id",
+	UserID:           "user_feed_subscriptions.user_id",
+	FeedID:           "user_feed_subscriptions.feed_id",
+	CreatedAt:        "user_feed_subscriptions.created_at",
+	UpdatedAt:        "user_feed_subscriptions.updated_at",
+	FetchFullArticle: "user_feed_subscriptions.fetch_full_article",
+	FolderID:         "user_feed_subscriptions.folder_id",
 }
 
 // Generated where
 
 var UserFeedSubscriptionWhere = struct {
-	ID        whereHelperstring
-	UserID    whereHelperstring
-	FeedID    whereHelperstring
-	CreatedAt whereHelpertime_Time
-	UpdatedAt whereHelpertime_Time
+	ID               whereHelperstring
+	UserID           whereHelperstring
+	FeedID           whereHelperstring
+	CreatedAt        whereHelpertime_Time
+	UpdatedAt        whereHelpertime_Time
+	FetchFullArticle whereHelperbool
+	FolderID         whereHelpernull_String
 }{
-	ID:        whereHelperstring{field: "\"user_feed_subscriptions\".\"id\""},
-	UserID:    whereHelperstring{field: "\"user_feed_subscriptions\".\"user_id\""},
-	FeedID:    whereHelperstring{field: "\"user_feed_subscriptions\".\"feed_id\""},
-	CreatedAt: whereHelpertime_Time{field: "\"user_feed_subscriptions\".\"created_at\""},
-	UpdatedAt: whereHelpertime_Time{field: "\"user_feed_subscriptions\".\"updated_at\""},
+	ID:               whereHelperstring{field: "\"user_feed_subscriptions\".\"id\""},
+	UserID:           whereHelperstring{field: "\"user_feed_subscriptions\".\"user_id\""},
+	FeedID:           whereHelperstring{field: "\"user_feed_subscriptions\".\"feed_id\""},
+	CreatedAt:        whereHelpertime_Time{field: "\"user_feed_subscriptions\".\"created_at\""},
+	UpdatedAt:        whereHelpertime_Time{field: "\"user_feed_subscriptions\".\"updated_at\""},
+	FetchFullArticle: whereHelperbool{field: "\"user_feed_subscriptions\".\"fetch_full_article\""},
+	FolderID:         whereHelpernull_String{field: "\"user_feed_subscriptions\".\"folder_id\""},
 }
 
 // UserFeedSubscriptionRels is where relationship names are stored.
 var UserFeedSubscriptionRels = struct {
-	Feed string
-	User string
+	Feed                      string
+	Folder                    string
+	User                      string
+	SubscriptionUserFeedRules string
 }{
-	Feed: "Feed",
-	User: "User",
+	Feed:                      "Feed",
+	Folder:                    "Folder",
+	User:                      "User",
+	SubscriptionUserFeedRules: "SubscriptionUserFeedRules",
 }
 
 // userFeedSubscriptionR is where relationships are stored.
 type userFeedSubscriptionR struct {
-	Feed *RSSFeed `boil:"Feed" json:"Feed" toml:"Feed" yaml:"Feed"`
-	User *User    `boil:"User" json:"User" toml:"User" yaml:"User"`
+	Feed                      *RSSFeed          `boil:"Feed" json:"Feed" toml:"Feed" yaml:"Feed"`
+	Folder                    *UserFeedFolder   `boil:"Folder" json:"Folder" toml:"Folder" yaml:"Folder"`
+	User                      *User             `boil:"User" json:"User" toml:"User" yaml:"User"`
+	SubscriptionUserFeedRules UserFeedRuleSlice `boil:"SubscriptionUserFeedRules" json:"SubscriptionUserFeedRules" toml:"SubscriptionUserFeedRules" yaml:"SubscriptionUserFeedRules"`
 }
 
 // NewStruct creates a new relationship struct
@@ -104,6 +125,13 @@ func (r *userFeedSubscriptionR) GetFeed() *RSSFeed {
 	return r.Feed
 }
 
+func (r *userFeedSubscriptionR) GetFolder() *UserFeedFolder {
+	if r == nil {
+		return nil
+	}
+	return r.Folder
+}
+
 func (r *userFeedSubscriptionR) GetUser() *User {
 	if r == nil {
 		return nil
@@ -111,13 +139,20 @@ func (r *userFeedSubscriptionR) GetUser() *User {
 	return r.User
 }
 
+func (r *userFeedSubscriptionR) GetSubscriptionUserFeedRules() UserFeedRuleSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SubscriptionUserFeedRules
+}
+
 // userFeedSubscriptionL is where Load methods for each relationship are stored.
 type userFeedSubscriptionL struct{}
 
 var (
-	userFeedSubscriptionAllColumns            = []string{"id", "user_id", "feed_id", "created_at", "updated_at"}
+	userFeedSubscriptionAllColumns            = []string{"id", "user_id", "feed_id", "created_at", "updated_at", "fetch_full_article", "folder_id"}
 	userFeedSubscriptionColumnsWithoutDefault = []string{"id", "user_id", "feed_id", "created_at", "updated_at"}
-	userFeedSubscriptionColumnsWithDefault    = []string{}
+	userFeedSubscriptionColumnsWithDefault    = []string{"fetch_full_article", "folder_id"}
 	userFeedSubscriptionPrimaryKeyColumns     = []string{"id"}
 	userFeedSubscriptionGeneratedColumns      = []string{}
 )
@@ -264,6 +299,17 @@ func (o *UserFeedSubscription) Feed(mods ...qm.QueryMod) rssFeedQuery {
 	return RSSFeeds(queryMods...)
 }
 
+// Folder pointed to by the foreign key.
+func (o *UserFeedSubscription) Folder(mods ...qm.QueryMod) userFeedFolderQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FolderID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return UserFeedFolders(queryMods...)
+}
+
 // User pointed to by the foreign key.
 func (o *UserFeedSubscription) User(mods ...qm.QueryMod) userQuery {
 	queryMods := []qm.QueryMod{
@@ -275,6 +321,20 @@ func (o *UserFeedSubscription) User(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// SubscriptionUserFeedRules retrieves all the user_feed_rule's UserFeedRules with an executor via subscription_id column.
+func (o *UserFeedSubscription) SubscriptionUserFeedRules(mods ...qm.QueryMod) userFeedRuleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_feed_rules\".\"subscription_id\"=?", o.ID),
+	)
+
+	return UserFeedRules(queryMods...)
+}
+
 // LoadFeed allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (userFeedSubscriptionL) LoadFeed(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserFeedSubscription interface{}, mods queries.Applicator) error {
@@ -387,6 +447,122 @@ func (userFeedSubscriptionL) LoadFeed(ctx context.Context, e boil.ContextExecuto
 	return nil
 }
 
+// LoadFolder allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userFeedSubscriptionL) LoadFolder(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserFeedSubscription interface{}, mods queries.Applicator) error {
+	var slice []*UserFeedSubscription
+	var object *UserFeedSubscription
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserFeedSubscription.(*UserFeedSubscription)
+		if !ok {
+			object = new(UserFeedSubscription)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserFeedSubscription)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserFeedSubscription))
+			}
+		}
+	} else {
+		s, ok := maybeUserFeedSubscription.(*[]*UserFeedSubscription)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserFeedSubscription)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserFeedSubscription))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userFeedSubscriptionR{}
+		}
+		if !queries.IsNil(object.FolderID) {
+			args[object.FolderID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userFeedSubscriptionR{}
+			}
+
+			if !queries.IsNil(obj.FolderID) {
+				args[obj.FolderID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_feed_folders`),
+		qm.WhereIn(`user_feed_folders.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load UserFeedFolder")
+	}
+
+	var resultSlice []*UserFeedFolder
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice UserFeedFolder")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user_feed_folders")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_feed_folders")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Folder = foreign
+		if foreign.R == nil {
+			foreign.R = &userFeedFolderR{}
+		}
+		foreign.R.FolderUserFeedSubscriptions = append(foreign.R.FolderUserFeedSubscriptions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.FolderID, foreign.ID) {
+				local.R.Folder = foreign
+				if foreign.R == nil {
+					foreign.R = &userFeedFolderR{}
+				}
+				foreign.R.FolderUserFeedSubscriptions = append(foreign.R.FolderUserFeedSubscriptions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (userFeedSubscriptionL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserFeedSubscription interface{}, mods queries.Applicator) error {
@@ -499,6 +675,112 @@ func (userFeedSubscriptionL) LoadUser(ctx context.Context, e boil.ContextExecuto
 	return nil
 }
 
+// LoadSubscriptionUserFeedRules allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userFeedSubscriptionL) LoadSubscriptionUserFeedRules(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserFeedSubscription interface{}, mods queries.Applicator) error {
+	var slice []*UserFeedSubscription
+	var object *UserFeedSubscription
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserFeedSubscription.(*UserFeedSubscription)
+		if !ok {
+			object = new(UserFeedSubscription)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserFeedSubscription)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserFeedSubscription))
+			}
+		}
+	} else {
+		s, ok := maybeUserFeedSubscription.(*[]*UserFeedSubscription)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserFeedSubscription)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserFeedSubscription))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userFeedSubscriptionR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userFeedSubscriptionR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_feed_rules`),
+		qm.WhereIn(`user_feed_rules.subscription_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_feed_rules")
+	}
+
+	var resultSlice []*UserFeedRule
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_feed_rules")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_feed_rules")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_feed_rules")
+	}
+
+	if singular {
+		object.R.SubscriptionUserFeedRules = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userFeedRuleR{}
+			}
+			foreign.R.Subscription = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.SubscriptionID) {
+				local.R.SubscriptionUserFeedRules = append(local.R.SubscriptionUserFeedRules, foreign)
+				if foreign.R == nil {
+					foreign.R = &userFeedRuleR{}
+				}
+				foreign.R.Subscription = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetFeedP of the userFeedSubscription to the related item.
 // Sets o.R.Feed to related.
 // Adds o to related.R.FeedUserFeedSubscriptions.
@@ -556,6 +838,106 @@ func (o *UserFeedSubscription) SetFeed(ctx context.Context, exec boil.ContextExe
 	return nil
 }
 
+// SetFolderP of the userFeedSubscription to the related item.
+// Sets o.R.Folder to related.
+// Adds o to related.R.FolderUserFeedSubscriptions.
+// Panics on error.
+func (o *UserFeedSubscription) SetFolderP(ctx context.Context, exec boil.ContextExecutor, insert bool, related *UserFeedFolder) {
+	if err := o.SetFolder(ctx, exec, insert, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetFolder of the userFeedSubscription to the related item.
+// Sets o.R.Folder to related.
+// Adds o to related.R.FolderUserFeedSubscriptions.
+func (o *UserFeedSubscription) SetFolder(ctx context.Context, exec boil.ContextExecutor, insert bool, related *UserFeedFolder) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"user_feed_subscriptions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"folder_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userFeedSubscriptionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.FolderID, related.ID)
+	if o.R == nil {
+		o.R = &userFeedSubscriptionR{
+			Folder: related,
+		}
+	} else {
+		o.R.Folder = related
+	}
+
+	if related.R == nil {
+		related.R = &userFeedFolderR{
+			FolderUserFeedSubscriptions: UserFeedSubscriptionSlice{o},
+		}
+	} else {
+		related.R.FolderUserFeedSubscriptions = append(related.R.FolderUserFeedSubscriptions, o)
+	}
+
+	return nil
+}
+
+// RemoveFolderP relationship.
+// Sets o.R.Folder to nil.
+// Removes o from all passed in related items' relationships struct.
+// Panics on error.
+func (o *UserFeedSubscription) RemoveFolderP(ctx context.Context, exec boil.ContextExecutor, related *UserFeedFolder) {
+	if err := o.RemoveFolder(ctx, exec, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// RemoveFolder relationship.
+// Sets o.R.Folder to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *UserFeedSubscription) RemoveFolder(ctx context.Context, exec boil.ContextExecutor, related *UserFeedFolder) error {
+	var err error
+
+	queries.SetScanner(&o.FolderID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("folder_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Folder = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.FolderUserFeedSubscriptions {
+		if queries.Equal(o.FolderID, ri.FolderID) {
+			continue
+		}
+
+		ln := len(related.R.FolderUserFeedSubscriptions)
+		if ln > 1 && i < ln-1 {
+			related.R.FolderUserFeedSubscriptions[i] = related.R.FolderUserFeedSubscriptions[ln-1]
+		}
+		related.R.FolderUserFeedSubscriptions = related.R.FolderUserFeedSubscriptions[:ln-1]
+		break
+	}
+	return nil
+}
+
 // SetUserP of the userFeedSubscription to the related item.
 // Sets o.R.User to related.
 // Adds o to related.R.UserFeedSubscriptions.
@@ -613,6 +995,167 @@ func (o *UserFeedSubscription) SetUser(ctx context.Context, exec boil.ContextExe
 	return nil
 }
 
+// AddSubscriptionUserFeedRulesP adds the given related objects to the existing relationships
+// of the user_feed_subscription, optionally inserting them as new records.
+// Appends related to o.R.SubscriptionUserFeedRules.
+// Sets related.R.Subscription appropriately.
+// Panics on error.
+func (o *UserFeedSubscription) AddSubscriptionUserFeedRulesP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserFeedRule) {
+	if err := o.AddSubscriptionUserFeedRules(ctx, exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddSubscriptionUserFeedRules adds the given related objects to the existing relationships
+// of the user_feed_subscription, optionally inserting them as new records.
+// Appends related to o.R.SubscriptionUserFeedRules.
+// Sets related.R.Subscription appropriately.
+func (o *UserFeedSubscription) AddSubscriptionUserFeedRules(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserFeedRule) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.SubscriptionID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_feed_rules\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"subscription_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userFeedRulePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.SubscriptionID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userFeedSubscriptionR{
+			SubscriptionUserFeedRules: related,
+		}
+	} else {
+		o.R.SubscriptionUserFeedRules = append(o.R.SubscriptionUserFeedRules, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userFeedRuleR{
+				Subscription: o,
+			}
+		} else {
+			rel.R.Subscription = o
+		}
+	}
+	return nil
+}
+
+// SetSubscriptionUserFeedRulesP removes all previously related items of the
+// user_feed_subscription replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Subscription's SubscriptionUserFeedRules accordingly.
+// Replaces o.R.SubscriptionUserFeedRules with related.
+// Sets related.R.Subscription's SubscriptionUserFeedRules accordingly.
+// Panics on error.
+func (o *UserFeedSubscription) SetSubscriptionUserFeedRulesP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserFeedRule) {
+	if err := o.SetSubscriptionUserFeedRules(ctx, exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetSubscriptionUserFeedRules removes all previously related items of the
+// user_feed_subscription replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Subscription's SubscriptionUserFeedRules accordingly.
+// Replaces o.R.SubscriptionUserFeedRules with related.
+// Sets related.R.Subscription's SubscriptionUserFeedRules accordingly.
+func (o *UserFeedSubscription) SetSubscriptionUserFeedRules(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserFeedRule) error {
+	query := "update \"user_feed_rules\" set \"subscription_id\" = null where \"subscription_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.SubscriptionUserFeedRules {
+			queries.SetScanner(&rel.SubscriptionID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Subscription = nil
+		}
+		o.R.SubscriptionUserFeedRules = nil
+	}
+
+	return o.AddSubscriptionUserFeedRules(ctx, exec, insert, related...)
+}
+
+// RemoveSubscriptionUserFeedRulesP relationships from objects passed in.
+// Removes related items from R.SubscriptionUserFeedRules (uses pointer comparison, removal does not keep order)
+// Sets related.R.Subscription.
+// Panics on error.
+func (o *UserFeedSubscription) RemoveSubscriptionUserFeedRulesP(ctx context.Context, exec boil.ContextExecutor, related ...*UserFeedRule) {
+	if err := o.RemoveSubscriptionUserFeedRules(ctx, exec, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// RemoveSubscriptionUserFeedRules relationships from objects passed in.
+// Removes related items from R.SubscriptionUserFeedRules (uses pointer comparison, removal does not keep order)
+// Sets related.R.Subscription.
+func (o *UserFeedSubscription) RemoveSubscriptionUserFeedRules(ctx context.Context, exec boil.ContextExecutor, related ...*UserFeedRule) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.SubscriptionID, nil)
+		if rel.R != nil {
+			rel.R.Subscription = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("subscription_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.SubscriptionUserFeedRules {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.SubscriptionUserFeedRules)
+			if ln > 1 && i < ln-1 {
+				o.R.SubscriptionUserFeedRules[i] = o.R.SubscriptionUserFeedRules[ln-1]
+			}
+			o.R.SubscriptionUserFeedRules = o.R.SubscriptionUserFeedRules[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // UserFeedSubscriptions retrieves all the records using an executor.
 func UserFeedSubscriptions(mods ...qm.QueryMod) userFeedSubscriptionQuery {
 	mods = append(mods, qm.From("\"user_feed_subscriptions\""))
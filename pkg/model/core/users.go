@@ -24,72 +24,107 @@ import (
 
 // User is an object representing the database table.
 type User struct {
-	ID                string            `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Email             string            `boil:"email" json:"email" toml:"email" yaml:"email"`
-	CreatedAt         null.Time         `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
-	UpdatedAt         null.Time         `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
-	Timezone          string            `boil:"timezone" json:"timezone" toml:"timezone" yaml:"timezone"`
-	EmailConfirmedAt  null.Time         `boil:"email_confirmed_at" json:"email_confirmed_at,omitempty" toml:"email_confirmed_at" yaml:"email_confirmed_at,omitempty"`
-	EmailConfirmSeed  null.String       `boil:"email_confirm_seed" json:"email_confirm_seed,omitempty" toml:"email_confirm_seed" yaml:"email_confirm_seed,omitempty"`
-	SignupAttribution null.String       `boil:"signup_attribution" json:"signup_attribution,omitempty" toml:"signup_attribution" yaml:"signup_attribution,omitempty"`
-	Pwdhash           null.String       `boil:"pwdhash" json:"pwdhash,omitempty" toml:"pwdhash" yaml:"pwdhash,omitempty"`
-	Username          string            `boil:"username" json:"username" toml:"username" yaml:"username"`
-	ProfileVisibility ProfileVisibility `boil:"profile_visibility" json:"profile_visibility" toml:"profile_visibility" yaml:"profile_visibility"`
+	ID                  string            `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Email               string            `boil:"email" json:"email" toml:"email" yaml:"email"`
+	CreatedAt           null.Time         `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt           null.Time         `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	Timezone            string            `boil:"timezone" json:"timezone" toml:"timezone" yaml:"timezone"`
+	EmailConfirmedAt    null.Time         `boil:"email_confirmed_at" json:"email_confirmed_at,omitempty" toml:"email_confirmed_at" yaml:"email_confirmed_at,omitempty"`
+	EmailConfirmSeed    null.String       `boil:"email_confirm_seed" json:"email_confirm_seed,omitempty" toml:"email_confirm_seed" yaml:"email_confirm_seed,omitempty"`
+	SignupAttribution   null.String       `boil:"signup_attribution" json:"signup_attribution,omitempty" toml:"signup_attribution" yaml:"signup_attribution,omitempty"`
+	Pwdhash             null.String       `boil:"pwdhash" json:"pwdhash,omitempty" toml:"pwdhash" yaml:"pwdhash,omitempty"`
+	Username            string            `boil:"username" json:"username" toml:"username" yaml:"username"`
+	ProfileVisibility   ProfileVisibility `boil:"profile_visibility" json:"profile_visibility" toml:"profile_visibility" yaml:"profile_visibility"`
+	MediaQuotaBytes     null.Int64        `boil:"media_quota_bytes" json:"media_quota_bytes,omitempty" toml:"media_quota_bytes" yaml:"media_quota_bytes,omitempty"`
+	KeepCameraMetadata  bool              `boil:"keep_camera_metadata" json:"keep_camera_metadata" toml:"keep_camera_metadata" yaml:"keep_camera_metadata"`
+	AltTextPolicy       AltTextPolicy     `boil:"alt_text_policy" json:"alt_text_policy" toml:"alt_text_policy" yaml:"alt_text_policy"`
+	TotpSecret          null.String       `boil:"totp_secret" json:"totp_secret,omitempty" toml:"totp_secret" yaml:"totp_secret,omitempty"`
+	TotpEnabledAt       null.Time         `boil:"totp_enabled_at" json:"totp_enabled_at,omitempty" toml:"totp_enabled_at" yaml:"totp_enabled_at,omitempty"`
+	TotpLastStep        null.Int64        `boil:"totp_last_step" json:"totp_last_step,omitempty" toml:"totp_last_step" yaml:"totp_last_step,omitempty"`
+	DeletionScheduledAt null.Time         `boil:"deletion_scheduled_at" json:"deletion_scheduled_at,omitempty" toml:"deletion_scheduled_at" yaml:"deletion_scheduled_at,omitempty"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var UserColumns = struct {
-	ID                string
-	Email             string
-	CreatedAt         string
-	UpdatedAt         string
-	Timezone          string
-	EmailConfirmedAt  string
-	EmailConfirmSeed  string
-	SignupAttribution string
-	Pwdhash           string
-	Username          string
-	ProfileVisibility string
+	ID                  string
+	Email               string
+	CreatedAt           string
+	UpdatedAt           string
+	Timezone            string
+	EmailConfirmedAt    string
+	EmailConfirmSeed    string
+	SignupAttribution   string
+	Pwdhash             string
+	Username            string
+	ProfileVisibility   string
+	MediaQuotaBytes     string
+	KeepCameraMetadata  string
+	AltTextPolicy       string
+	TotpSecret          string
+	TotpEnabledAt       string
+	TotpLastStep        string
+	DeletionScheduledAt string
 }{
-	ID:                "id",
-	Email:             "email",
-	CreatedAt:         "created_at",
-	UpdatedAt:         "updated_at",
-	Timezone:          "timezone",
-	EmailConfirmedAt:  "email_confirmed_at",
-	EmailConfirmSeed:  "email_confirm_seed",
-	SignupAttribution: "signup_attribution",
-	Pwdhash:           "pwdhash",
-	Username:          "username",
-	ProfileVisibility: "profile_visibility",
+	ID:                  "id",
+	Email:               "email",
+	CreatedAt:           "created_at",
+	UpdatedAt:           "updated_at",
+	Timezone:            "timezone",
+	EmailConfirmedAt:    "email_confirmed_at",
+	EmailConfirmSeed:    "email_confirm_seed",
+	SignupAttribution:   "signup_attribution",
+	Pwdhash:             "pwdhash",
+	Username:            "username",
+	ProfileVisibility:   "profile_visibility",
+	MediaQuotaBytes:     "media_quota_bytes",
+	KeepCameraMetadata:  "keep_camera_metadata",
+	AltTextPolicy:       "alt_text_policy",
+	TotpSecret:          "totp_secret",
+	TotpEnabledAt:       "totp_enabled_at",
+	TotpLastStep:        "totp_last_step",
+	DeletionScheduledAt: "deletion_scheduled_at",
 }
 
 var UserTableColumns = struct {
-	ID                string
-	Email             string
-	CreatedAt         string
-	UpdatedAt         string
-	Timezone          string
-	EmailConfirmedAt  string
-	EmailConfirmSeed  string
-	SignupAttribution string
-	Pwdhash           string
-	Username          string
-	ProfileVisibility string
+	ID                  string
+	Email               string
+	CreatedAt           string
+	UpdatedAt           string
+	Timezone            string
+	EmailConfirmedAt    string
+	EmailConfirmSeed    string
+	SignupAttribution   string
+	Pwdhash             string
+	Username            string
+	ProfileVisibility   string
+	MediaQuotaBytes     string
+	KeepCameraMetadata  string
+	AltTextPolicy       string
+	TotpSecret          string
+	TotpEnabledAt       string
+	TotpLastStep        string
+	DeletionScheduledAt string
 }{
-	ID:                "users.id",
-	Email:             "users.email",
-	CreatedAt:         "users.created_at",
-	UpdatedAt:         "users.updated_at",
-	Timezone:          "users.timezone",
-	EmailConfirmedAt:  "users.email_confirmed_at",
-	EmailConfirmSeed:  "users.email_confirm_seed",
-	SignupAttribution: "users.signup_attribution",
-	Pwdhash:           "users.pwdhash",
-	Username:          "users.username",
-	ProfileVisibility: "users.profile_visibility",
+	ID:                  "users.id",
+	Email:               "users.email",
+	CreatedAt:           "users.created_at",
+	UpdatedAt:           "users.updated_at",
+	Timezone:            "users.timezone",
+	EmailConfirmedAt:    "users.email_confirmed_at",
+	EmailConfirmSeed:    "users.email_confirm_seed",
+	SignupAttribution:   "users.signup_attribution",
+	Pwdhash:             "users.pwdhash",
+	Username:            "users.username",
+	ProfileVisibility:   "users.profile_visibility",
+	MediaQuotaBytes:     "users.media_quota_bytes",
+	KeepCameraMetadata:  "users.keep_camera_metadata",
+	AltTextPolicy:       "users.alt_text_policy",
+	TotpSecret:          "users.totp_secret",
+	TotpEnabledAt:       "users.totp_enabled_at",
+	TotpLastStep:        "users.totp_last_step",
+	DeletionScheduledAt: "users.deletion_scheduled_at",
 }
 
 // Generated where
@@ -129,37 +164,127 @@ func (w whereHelperProfileVisibility) NIN(slice []ProfileVisibility) qm.QueryMod
 	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
 }
 
+type whereHelpernull_Int64 struct{ field string }
+
+func (w whereHelpernull_Int64) EQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int64) NEQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int64) LT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int64) LTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int64) GT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int64) GTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Int64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Int64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelperAltTextPolicy struct{ field string }
+
+func (w whereHelperAltTextPolicy) EQ(x AltTextPolicy) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperAltTextPolicy) NEQ(x AltTextPolicy) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperAltTextPolicy) LT(x AltTextPolicy) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperAltTextPolicy) LTE(x AltTextPolicy) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperAltTextPolicy) GT(x AltTextPolicy) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperAltTextPolicy) GTE(x AltTextPolicy) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperAltTextPolicy) IN(slice []AltTextPolicy) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperAltTextPolicy) NIN(slice []AltTextPolicy) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
 var UserWhere = struct {
-	ID                whereHelperstring
-	Email             whereHelperstring
-	CreatedAt         whereHelpernull_Time
-	UpdatedAt         whereHelpernull_Time
-	Timezone          whereHelperstring
-	EmailConfirmedAt  whereHelpernull_Time
-	EmailConfirmSeed  whereHelpernull_String
-	SignupAttribution whereHelpernull_String
-	Pwdhash           whereHelpernull_String
-	Username          whereHelperstring
-	ProfileVisibility whereHelperProfileVisibility
+	ID                  whereHelperstring
+	Email               whereHelperstring
+	CreatedAt           whereHelpernull_Time
+	UpdatedAt           whereHelpernull_Time
+	Timezone            whereHelperstring
+	EmailConfirmedAt    whereHelpernull_Time
+	EmailConfirmSeed    whereHelpernull_String
+	SignupAttribution   whereHelpernull_String
+	Pwdhash             whereHelpernull_String
+	Username            whereHelperstring
+	ProfileVisibility   whereHelperProfileVisibility
+	MediaQuotaBytes     whereHelpernull_Int64
+	KeepCameraMetadata  whereHelperbool
+	AltTextPolicy       whereHelperAltTextPolicy
+	TotpSecret          whereHelpernull_String
+	TotpEnabledAt       whereHelpernull_Time
+	TotpLastStep        whereHelpernull_Int64
+	DeletionScheduledAt whereHelpernull_Time
 }{
-	ID:                whereHelperstring{field: "\"users\".\"id\""},
-	Email:             whereHelperstring{field: "\"users\".\"email\""},
-	CreatedAt:         whereHelpernull_Time{field: "\"users\".\"created_at\""},
-	UpdatedAt:         whereHelpernull_Time{field: "\"users\".\"updated_at\""},
-	Timezone:          whereHelperstring{field: "\"users\".\"timezone\""},
-	EmailConfirmedAt:  whereHelpernull_Time{field: "\"users\".\"email_confirmed_at\""},
-	EmailConfirmSeed:  whereHelpernull_String{field: "\"users\".\"email_confirm_seed\""},
-	SignupAttribution: whereHelpernull_String{field: "\"users\".\"signup_attribution\""},
-	Pwdhash:           whereHelpernull_String{field: "\"users\".\"pwdhash\""},
-	Username:          whereHelperstring{field: "\"users\".\"username\""},
-	ProfileVisibility: whereHelperProfileVisibility{field: "\"users\".\"profile_visibility\""},
+	ID:                  whereHelperstring{field: "\"users\".\"id\""},
+	Email:               whereHelperstring{field: "\"users\".\"email\""},
+	CreatedAt:           whereHelpernull_Time{field: "\"users\".\"created_at\""},
+	UpdatedAt:           whereHelpernull_Time{field: "\"users\".\"updated_at\""},
+	Timezone:            whereHelperstring{field: "\"users\".\"timezone\""},
+	EmailConfirmedAt:    whereHelpernull_Time{field: "\"users\".\"email_confirmed_at\""},
+	EmailConfirmSeed:    whereHelpernull_String{field: "\"users\".\"email_confirm_seed\""},
+	SignupAttribution:   whereHelpernull_String{field: "\"users\".\"signup_attribution\""},
+	Pwdhash:             whereHelpernull_String{field: "\"users\".\"pwdhash\""},
+	Username:            whereHelperstring{field: "\"users\".\"username\""},
+	ProfileVisibility:   whereHelperProfileVisibility{field: "\"users\".\"profile_visibility\""},
+	MediaQuotaBytes:     whereHelpernull_Int64{field: "\"users\".\"media_quota_bytes\""},
+	KeepCameraMetadata:  whereHelperbool{field: "\"users\".\"keep_camera_metadata\""},
+	AltTextPolicy:       whereHelperAltTextPolicy{field: "\"users\".\"alt_text_policy\""},
+	TotpSecret:          whereHelpernull_String{field: "\"users\".\"totp_secret\""},
+	TotpEnabledAt:       whereHelpernull_Time{field: "\"users\".\"totp_enabled_at\""},
+	TotpLastStep:        whereHelpernull_Int64{field: "\"users\".\"totp_last_step\""},
+	DeletionScheduledAt: whereHelpernull_Time{field: "\"users\".\"deletion_scheduled_at\""},
 }
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
 	UserAPIKey                                string
 	UserStyle                                 string
+	EmailChangeRequests                       string
+	LoginLinks                                string
 	MediaUploads                              string
+	PasswordResetRequests                     string
 	PostComments                              string
 	AskerPostPrompts                          string
 	RecipientPostPrompts                      string
@@ -169,30 +294,47 @@ var UserRels = struct {
 	UserConnectionMediators                   string
 	User1UserConnections                      string
 	User2UserConnections                      string
+	UserFeedFolders                           string
 	UserFeedItems                             string
+	UserFeedRules                             string
 	UserFeedSubscriptions                     string
+	UserIdentities                            string
 	CreatedUserUserInvitations                string
 	UserInvitations                           string
+	UserPasskeys                              string
+	UserRecoveryCodes                         string
+	UserRememberedDevices                     string
+	UserSessions                              string
 	CreatedUserUserSignupRequests             string
 	AllowsWhoWhitelistedConnections           string
 	WhoWhitelistedConnections                 string
 }{
-	UserAPIKey:           "UserAPIKey",
-	UserStyle:            "UserStyle",
-	MediaUploads:         "MediaUploads",
-	PostComments:         "PostComments",
-	AskerPostPrompts:     "AskerPostPrompts",
-	RecipientPostPrompts: "RecipientPostPrompts",
-	Posts:                "Posts",
+	UserAPIKey:            "UserAPIKey",
+	UserStyle:             "UserStyle",
+	EmailChangeRequests:   "EmailChangeRequests",
+	LoginLinks:            "LoginLinks",
+	MediaUploads:          "MediaUploads",
+	PasswordResetRequests: "PasswordResetRequests",
+	PostComments:          "PostComments",
+	AskerPostPrompts:      "AskerPostPrompts",
+	RecipientPostPrompts:  "RecipientPostPrompts",
+	Posts:                 "Posts",
 	TargetUserUserConnectionMediationRequests: "TargetUserUserConnectionMediationRequests",
 	WhoUserUserConnectionMediationRequests:    "WhoUserUserConnectionMediationRequests",
 	UserConnectionMediators:                   "UserConnectionMediators",
 	User1UserConnections:                      "User1UserConnections",
 	User2UserConnections:                      "User2UserConnections",
+	UserFeedFolders:                           "UserFeedFolders",
 	UserFeedItems:                             "UserFeedItems",
+	UserFeedRules:                             "UserFeedRules",
 	UserFeedSubscriptions:                     "UserFeedSubscriptions",
+	UserIdentities:                            "UserIdentities",
 	CreatedUserUserInvitations:                "CreatedUserUserInvitations",
 	UserInvitations:                           "UserInvitations",
+	UserPasskeys:                              "UserPasskeys",
+	UserRecoveryCodes:                         "UserRecoveryCodes",
+	UserRememberedDevices:                     "UserRememberedDevices",
+	UserSessions:                              "UserSessions",
 	CreatedUserUserSignupRequests:             "CreatedUserUserSignupRequests",
 	AllowsWhoWhitelistedConnections:           "AllowsWhoWhitelistedConnections",
 	WhoWhitelistedConnections:                 "WhoWhitelistedConnections",
@@ -202,7 +344,10 @@ var UserRels = struct {
 type userR struct {
 	UserAPIKey                                *UserAPIKey                         `boil:"UserAPIKey" json:"UserAPIKey" toml:"UserAPIKey" yaml:"UserAPIKey"`
 	UserStyle                                 *UserStyle                          `boil:"UserStyle" json:"UserStyle" toml:"UserStyle" yaml:"UserStyle"`
+	EmailChangeRequests                       EmailChangeRequestSlice             `boil:"EmailChangeRequests" json:"EmailChangeRequests" toml:"EmailChangeRequests" yaml:"EmailChangeRequests"`
+	LoginLinks                                LoginLinkSlice                      `boil:"LoginLinks" json:"LoginLinks" toml:"LoginLinks" yaml:"LoginLinks"`
 	MediaUploads                              MediaUploadSlice                    `boil:"MediaUploads" json:"MediaUploads" toml:"MediaUploads" yaml:"MediaUploads"`
+	PasswordResetRequests                     PasswordResetRequestSlice           `boil:"PasswordResetRequests" json:"PasswordResetRequests" toml:"PasswordResetRequests" yaml:"PasswordResetRequests"`
 	PostComments                              PostCommentSlice                    `boil:"PostComments" json:"PostComments" toml:"PostComments" yaml:"PostComments"`
 	AskerPostPrompts                          PostPromptSlice                     `boil:"AskerPostPrompts" json:"AskerPostPrompts" toml:"AskerPostPrompts" yaml:"AskerPostPrompts"`
 	RecipientPostPrompts                      PostPromptSlice                     `boil:"RecipientPostPrompts" json:"RecipientPostPrompts" toml:"RecipientPostPrompts" yaml:"RecipientPostPrompts"`
@@ -212,10 +357,17 @@ type userR struct {
 	UserConnectionMediators                   UserConnectionMediatorSlice         `boil:"UserConnectionMediators" json:"UserConnectionMediators" toml:"UserConnectionMediators" yaml:"UserConnectionMediators"`
 	User1UserConnections                      UserConnectionSlice                 `boil:"User1UserConnections" json:"User1UserConnections" toml:"User1UserConnections" yaml:"User1UserConnections"`
 	User2UserConnections                      UserConnectionSlice                 `boil:"User2UserConnections" json:"User2UserConnections" toml:"User2UserConnections" yaml:"User2UserConnections"`
+	UserFeedFolders                           UserFeedFolderSlice                 `boil:"UserFeedFolders" json:"UserFeedFolders" toml:"UserFeedFolders" yaml:"UserFeedFolders"`
 	UserFeedItems                             UserFeedItemSlice                   `boil:"UserFeedItems" json:"UserFeedItems" toml:"UserFeedItems" yaml:"UserFeedItems"`
+	UserFeedRules                             UserFeedRuleSlice                   `boil:"UserFeedRules" json:"UserFeedRules" toml:"UserFeedRules" yaml:"UserFeedRules"`
 	UserFeedSubscriptions                     UserFeedSubscriptionSlice           `boil:"UserFeedSubscriptions" json:"UserFeedSubscriptions" toml:"UserFeedSubscriptions" yaml:"UserFeedSubscriptions"`
+	UserIdentities                            UserIdentitySlice                   `boil:"UserIdentities" json:"UserIdentities" toml:"UserIdentities" yaml:"UserIdentities"`
 	CreatedUserUserInvitations                UserInvitationSlice                 `boil:"CreatedUserUserInvitations" json:"CreatedUserUserInvitations" toml:"CreatedUserUserInvitations" yaml:"CreatedUserUserInvitations"`
 	UserInvitations                           UserInvitationSlice                 `boil:"UserInvitations" json:"UserInvitations" toml:"UserInvitations" yaml:"UserInvitations"`
+	UserPasskeys                              UserPasskeySlice                    `boil:"UserPasskeys" json:"UserPasskeys" toml:"UserPasskeys" yaml:"UserPasskeys"`
+	UserRecoveryCodes                         UserRecoveryCodeSlice               `boil:"UserRecoveryCodes" json:"UserRecoveryCodes" toml:"UserRecoveryCodes" yaml:"UserRecoveryCodes"`
+	UserRememberedDevices                     UserRememberedDeviceSlice           `boil:"UserRememberedDevices" json:"UserRememberedDevices" toml:"UserRememberedDevices" yaml:"UserRememberedDevices"`
+	UserSessions                              UserSessionSlice                    `boil:"UserSessions" json:"UserSessions" toml:"UserSessions" yaml:"UserSessions"`
 	CreatedUserUserSignupRequests             UserSignupRequestSlice              `boil:"CreatedUserUserSignupRequests" json:"CreatedUserUserSignupRequests" toml:"CreatedUserUserSignupRequests" yaml:"CreatedUserUserSignupRequests"`
 	AllowsWhoWhitelistedConnections           WhitelistedConnectionSlice          `boil:"AllowsWhoWhitelistedConnections" json:"AllowsWhoWhitelistedConnections" toml:"AllowsWhoWhitelistedConnections" yaml:"AllowsWhoWhitelistedConnections"`
 	WhoWhitelistedConnections                 WhitelistedConnectionSlice          `boil:"WhoWhitelistedConnections" json:"WhoWhitelistedConnections" toml:"WhoWhitelistedConnections" yaml:"WhoWhitelistedConnections"`
@@ -240,6 +392,20 @@ func (r *userR) GetUserStyle() *UserStyle {
 	return r.UserStyle
 }
 
+func (r *userR) GetEmailChangeRequests() EmailChangeRequestSlice {
+	if r == nil {
+		return nil
+	}
+	return r.EmailChangeRequests
+}
+
+func (r *userR) GetLoginLinks() LoginLinkSlice {
+	if r == nil {
+		return nil
+	}
+	return r.LoginLinks
+}
+
 func (r *userR) GetMediaUploads() MediaUploadSlice {
 	if r == nil {
 		return nil
@@ -247,6 +413,13 @@ func (r *userR) GetMediaUploads() MediaUploadSlice {
 	return r.MediaUploads
 }
 
+func (r *userR) GetPasswordResetRequests() PasswordResetRequestSlice {
+	if r == nil {
+		return nil
+	}
+	return r.PasswordResetRequests
+}
+
 func (r *userR) GetPostComments() PostCommentSlice {
 	if r == nil {
 		return nil
@@ -310,6 +483,13 @@ func (r *userR) GetUser2UserConnections() UserConnectionSlice {
 	return r.User2UserConnections
 }
 
+func (r *userR) GetUserFeedFolders() UserFeedFolderSlice {
+	if r == nil {
+		return nil
+	}
+	return r.UserFeedFolders
+}
+
 func (r *userR) GetUserFeedItems() UserFeedItemSlice {
 	if r == nil {
 		return nil
@@ -317,6 +497,13 @@ func (r *userR) GetUserFeedItems() UserFeedItemSlice {
 	return r.UserFeedItems
 }
 
+func (r *userR) GetUserFeedRules() UserFeedRuleSlice {
+	if r == nil {
+		return nil
+	}
+	return r.UserFeedRules
+}
+
 func (r *userR) GetUserFeedSubscriptions() UserFeedSubscriptionSlice {
 	if r == nil {
 		return nil
@@ -324,6 +511,13 @@ func (r *userR) GetUserFeedSubscriptions() UserFeedSubscriptionSlice {
 	return r.UserFeedSubscriptions
 }
 
+func (r *userR) GetUserIdentities() UserIdentitySlice {
+	if r == nil {
+		return nil
+	}
+	return r.UserIdentities
+}
+
 func (r *userR) GetCreatedUserUserInvitations() UserInvitationSlice {
 	if r == nil {
 		return nil
@@ -338,6 +532,34 @@ func (r *userR) GetUserInvitations() UserInvitationSlice {
 	return r.UserInvitations
 }
 
+func (r *userR) GetUserPasskeys() UserPasskeySlice {
+	if r == nil {
+		return nil
+	}
+	return r.UserPasskeys
+}
+
+func (r *userR) GetUserRecoveryCodes() UserRecoveryCodeSlice {
+	if r == nil {
+		return nil
+	}
+	return r.UserRecoveryCodes
+}
+
+func (r *userR) GetUserRememberedDevices() UserRememberedDeviceSlice {
+	if r == nil {
+		return nil
+	}
+	return r.UserRememberedDevices
+}
+
+func (r *userR) GetUserSessions() UserSessionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.UserSessions
+}
+
 func (r *userR) GetCreatedUserUserSignupRequests() UserSignupRequestSlice {
 	if r == nil {
 		return nil
@@ -363,9 +585,9 @@ func (r *userR) GetWhoWhitelistedConnections() WhitelistedConnectionSlice {
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "email", "created_at", "updated_at", "timezone", "email_confirmed_at", "email_confirm_seed", "signup_attribution", "pwdhash", "username", "profile_visibility"}
+	userAllColumns            = []string{"id", "email", "created_at", "updated_at", "timezone", "email_confirmed_at", "email_confirm_seed", "signup_attribution", "pwdhash", "username", "profile_visibility", "media_quota_bytes", "keep_camera_metadata", "alt_text_policy", "totp_secret", "totp_enabled_at", "totp_last_step", "deletion_scheduled_at"}
 	userColumnsWithoutDefault = []string{"id", "email", "timezone", "username"}
-	userColumnsWithDefault    = []string{"created_at", "updated_at", "email_confirmed_at", "email_confirm_seed", "signup_attribution", "pwdhash", "profile_visibility"}
+	userColumnsWithDefault    = []string{"created_at", "updated_at", "email_confirmed_at", "email_confirm_seed", "signup_attribution", "pwdhash", "profile_visibility", "media_quota_bytes", "keep_camera_metadata", "alt_text_policy", "totp_secret", "totp_enabled_at", "totp_last_step", "deletion_scheduled_at"}
 	userPrimaryKeyColumns     = []string{"id"}
 	userGeneratedColumns      = []string{}
 )
@@ -523,6 +745,34 @@ func (o *User) UserStyle(mods ...qm.QueryMod) userStyleQuery {
 	return UserStyles(queryMods...)
 }
 
+// EmailChangeRequests retrieves all the email_change_request's EmailChangeRequests with an executor.
+func (o *User) EmailChangeRequests(mods ...qm.QueryMod) emailChangeRequestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"email_change_requests\".\"user_id\"=?", o.ID),
+	)
+
+	return EmailChangeRequests(queryMods...)
+}
+
+// LoginLinks retrieves all the login_link's LoginLinks with an executor.
+func (o *User) LoginLinks(mods ...qm.QueryMod) loginLinkQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"login_links\".\"user_id\"=?", o.ID),
+	)
+
+	return LoginLinks(queryMods...)
+}
+
 // MediaUploads retrieves all the media_upload's MediaUploads with an executor.
 func (o *User) MediaUploads(mods ...qm.QueryMod) mediaUploadQuery {
 	var queryMods []qm.QueryMod
@@ -537,6 +787,20 @@ func (o *User) MediaUploads(mods ...qm.QueryMod) mediaUploadQuery {
 	return MediaUploads(queryMods...)
 }
 
+// PasswordResetRequests retrieves all the password_reset_request's PasswordResetRequests with an executor.
+func (o *User) PasswordResetRequests(mods ...qm.QueryMod) passwordResetRequestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"password_reset_requests\".\"user_id\"=?", o.ID),
+	)
+
+	return PasswordResetRequests(queryMods...)
+}
+
 // PostComments retrieves all the post_comment's PostComments with an executor.
 func (o *User) PostComments(mods ...qm.QueryMod) postCommentQuery {
 	var queryMods []qm.QueryMod
@@ -663,6 +927,20 @@ func (o *User) User2UserConnections(mods ...qm.QueryMod) userConnectionQuery {
 	return UserConnections(queryMods...)
 }
 
+// UserFeedFolders retrieves all the user_feed_folder's UserFeedFolders with an executor.
+func (o *User) UserFeedFolders(mods ...qm.QueryMod) userFeedFolderQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_feed_folders\".\"user_id\"=?", o.ID),
+	)
+
+	return UserFeedFolders(queryMods...)
+}
+
 // UserFeedItems retrieves all the user_feed_item's UserFeedItems with an executor.
 func (o *User) UserFeedItems(mods ...qm.QueryMod) userFeedItemQuery {
 	var queryMods []qm.QueryMod
@@ -677,6 +955,20 @@ func (o *User) UserFeedItems(mods ...qm.QueryMod) userFeedItemQuery {
 	return UserFeedItems(queryMods...)
 }
 
+// UserFeedRules retrieves all the user_feed_rule's UserFeedRules with an executor.
+func (o *User) UserFeedRules(mods ...qm.QueryMod) userFeedRuleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_feed_rules\".\"user_id\"=?", o.ID),
+	)
+
+	return UserFeedRules(queryMods...)
+}
+
 // UserFeedSubscriptions retrieves all the user_feed_subscription's UserFeedSubscriptions with an executor.
 func (o *User) UserFeedSubscriptions(mods ...qm.QueryMod) userFeedSubscriptionQuery {
 	var queryMods []qm.QueryMod
@@ -691,6 +983,20 @@ func (o *User) UserFeedSubscriptions(mods ...qm.QueryMod) userFeedSubscriptionQu
 	return UserFeedSubscriptions(queryMods...)
 }
 
+// UserIdentities retrieves all the user_identity's UserIdentities with an executor.
+func (o *User) UserIdentities(mods ...qm.QueryMod) userIdentityQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_identities\".\"user_id\"=?", o.ID),
+	)
+
+	return UserIdentities(queryMods...)
+}
+
 // CreatedUserUserInvitations retrieves all the user_invitation's UserInvitations with an executor via created_user_id column.
 func (o *User) CreatedUserUserInvitations(mods ...qm.QueryMod) userInvitationQuery {
 	var queryMods []qm.QueryMod
@@ -719,6 +1025,62 @@ func (o *User) UserInvitations(mods ...qm.QueryMod) userInvitationQuery {
 	return UserInvitations(queryMods...)
 }
 
+// UserPasskeys retrieves all the user_passkey's UserPasskeys with an executor.
+func (o *User) UserPasskeys(mods ...qm.QueryMod) userPasskeyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_passkeys\".\"user_id\"=?", o.ID),
+	)
+
+	return UserPasskeys(queryMods...)
+}
+
+// UserRecoveryCodes retrieves all the user_recovery_code's UserRecoveryCodes with an executor.
+func (o *User) UserRecoveryCodes(mods ...qm.QueryMod) userRecoveryCodeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_recovery_codes\".\"user_id\"=?", o.ID),
+	)
+
+	return UserRecoveryCodes(queryMods...)
+}
+
+// UserRememberedDevices retrieves all the user_remembered_device's UserRememberedDevices with an executor.
+func (o *User) UserRememberedDevices(mods ...qm.QueryMod) userRememberedDeviceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_remembered_devices\".\"user_id\"=?", o.ID),
+	)
+
+	return UserRememberedDevices(queryMods...)
+}
+
+// UserSessions retrieves all the user_session's UserSessions with an executor.
+func (o *User) UserSessions(mods ...qm.QueryMod) userSessionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_sessions\".\"user_id\"=?", o.ID),
+	)
+
+	return UserSessions(queryMods...)
+}
+
 // CreatedUserUserSignupRequests retrieves all the user_signup_request's UserSignupRequests with an executor via created_user_id column.
 func (o *User) CreatedUserUserSignupRequests(mods ...qm.QueryMod) userSignupRequestQuery {
 	var queryMods []qm.QueryMod
@@ -979,9 +1341,9 @@ func (userL) LoadUserStyle(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// LoadMediaUploads allows an eager lookup of values, cached into the
+// LoadEmailChangeRequests allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadMediaUploads(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadEmailChangeRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1034,8 +1396,8 @@ func (userL) LoadMediaUploads(ctx context.Context, e boil.ContextExecutor, singu
 	}
 
 	query := NewQuery(
-		qm.From(`media_uploads`),
-		qm.WhereIn(`media_uploads.user_id in ?`, argsSlice...),
+		qm.From(`email_change_requests`),
+		qm.WhereIn(`email_change_requests.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1043,26 +1405,26 @@ func (userL) LoadMediaUploads(ctx context.Context, e boil.ContextExecutor, singu
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load media_uploads")
+		return errors.Wrap(err, "failed to eager load email_change_requests")
 	}
 
-	var resultSlice []*MediaUpload
+	var resultSlice []*EmailChangeRequest
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice media_uploads")
+		return errors.Wrap(err, "failed to bind eager loaded slice email_change_requests")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on media_uploads")
+		return errors.Wrap(err, "failed to close results in eager load on email_change_requests")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for media_uploads")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for email_change_requests")
 	}
 
 	if singular {
-		object.R.MediaUploads = resultSlice
+		object.R.EmailChangeRequests = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &mediaUploadR{}
+				foreign.R = &emailChangeRequestR{}
 			}
 			foreign.R.User = object
 		}
@@ -1071,10 +1433,10 @@ func (userL) LoadMediaUploads(ctx context.Context, e boil.ContextExecutor, singu
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.UserID) {
-				local.R.MediaUploads = append(local.R.MediaUploads, foreign)
+			if local.ID == foreign.UserID {
+				local.R.EmailChangeRequests = append(local.R.EmailChangeRequests, foreign)
 				if foreign.R == nil {
-					foreign.R = &mediaUploadR{}
+					foreign.R = &emailChangeRequestR{}
 				}
 				foreign.R.User = local
 				break
@@ -1085,9 +1447,9 @@ func (userL) LoadMediaUploads(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
-// LoadPostComments allows an eager lookup of values, cached into the
+// LoadLoginLinks allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadPostComments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadLoginLinks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1140,8 +1502,8 @@ func (userL) LoadPostComments(ctx context.Context, e boil.ContextExecutor, singu
 	}
 
 	query := NewQuery(
-		qm.From(`post_comments`),
-		qm.WhereIn(`post_comments.user_id in ?`, argsSlice...),
+		qm.From(`login_links`),
+		qm.WhereIn(`login_links.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1149,26 +1511,26 @@ func (userL) LoadPostComments(ctx context.Context, e boil.ContextExecutor, singu
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load post_comments")
+		return errors.Wrap(err, "failed to eager load login_links")
 	}
 
-	var resultSlice []*PostComment
+	var resultSlice []*LoginLink
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice post_comments")
+		return errors.Wrap(err, "failed to bind eager loaded slice login_links")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on post_comments")
+		return errors.Wrap(err, "failed to close results in eager load on login_links")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_comments")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for login_links")
 	}
 
 	if singular {
-		object.R.PostComments = resultSlice
+		object.R.LoginLinks = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &postCommentR{}
+				foreign.R = &loginLinkR{}
 			}
 			foreign.R.User = object
 		}
@@ -1178,9 +1540,9 @@ func (userL) LoadPostComments(ctx context.Context, e boil.ContextExecutor, singu
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.UserID {
-				local.R.PostComments = append(local.R.PostComments, foreign)
+				local.R.LoginLinks = append(local.R.LoginLinks, foreign)
 				if foreign.R == nil {
-					foreign.R = &postCommentR{}
+					foreign.R = &loginLinkR{}
 				}
 				foreign.R.User = local
 				break
@@ -1191,9 +1553,9 @@ func (userL) LoadPostComments(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
-// LoadAskerPostPrompts allows an eager lookup of values, cached into the
+// LoadMediaUploads allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadAskerPostPrompts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadMediaUploads(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1246,8 +1608,8 @@ func (userL) LoadAskerPostPrompts(ctx context.Context, e boil.ContextExecutor, s
 	}
 
 	query := NewQuery(
-		qm.From(`post_prompts`),
-		qm.WhereIn(`post_prompts.asker_id in ?`, argsSlice...),
+		qm.From(`media_uploads`),
+		qm.WhereIn(`media_uploads.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1255,40 +1617,40 @@ func (userL) LoadAskerPostPrompts(ctx context.Context, e boil.ContextExecutor, s
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load post_prompts")
+		return errors.Wrap(err, "failed to eager load media_uploads")
 	}
 
-	var resultSlice []*PostPrompt
+	var resultSlice []*MediaUpload
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice post_prompts")
+		return errors.Wrap(err, "failed to bind eager loaded slice media_uploads")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on post_prompts")
+		return errors.Wrap(err, "failed to close results in eager load on media_uploads")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_prompts")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for media_uploads")
 	}
 
 	if singular {
-		object.R.AskerPostPrompts = resultSlice
+		object.R.MediaUploads = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &postPromptR{}
+				foreign.R = &mediaUploadR{}
 			}
-			foreign.R.Asker = object
+			foreign.R.User = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.AskerID {
-				local.R.AskerPostPrompts = append(local.R.AskerPostPrompts, foreign)
-				if foreign.R == nil {
-					foreign.R = &postPromptR{}
+			if queries.Equal(local.ID, foreign.UserID) {
+				local.R.MediaUploads = append(local.R.MediaUploads, foreign)
+				if foreign.R == nil {
+					foreign.R = &mediaUploadR{}
 				}
-				foreign.R.Asker = local
+				foreign.R.User = local
 				break
 			}
 		}
@@ -1297,9 +1659,9 @@ func (userL) LoadAskerPostPrompts(ctx context.Context, e boil.ContextExecutor, s
 	return nil
 }
 
-// LoadRecipientPostPrompts allows an eager lookup of values, cached into the
+// LoadPasswordResetRequests allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadRecipientPostPrompts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadPasswordResetRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1352,8 +1714,8 @@ func (userL) LoadRecipientPostPrompts(ctx context.Context, e boil.ContextExecuto
 	}
 
 	query := NewQuery(
-		qm.From(`post_prompts`),
-		qm.WhereIn(`post_prompts.recipient_id in ?`, argsSlice...),
+		qm.From(`password_reset_requests`),
+		qm.WhereIn(`password_reset_requests.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1361,40 +1723,40 @@ func (userL) LoadRecipientPostPrompts(ctx context.Context, e boil.ContextExecuto
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load post_prompts")
+		return errors.Wrap(err, "failed to eager load password_reset_requests")
 	}
 
-	var resultSlice []*PostPrompt
+	var resultSlice []*PasswordResetRequest
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice post_prompts")
+		return errors.Wrap(err, "failed to bind eager loaded slice password_reset_requests")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on post_prompts")
+		return errors.Wrap(err, "failed to close results in eager load on password_reset_requests")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_prompts")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for password_reset_requests")
 	}
 
 	if singular {
-		object.R.RecipientPostPrompts = resultSlice
+		object.R.PasswordResetRequests = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &postPromptR{}
+				foreign.R = &passwordResetRequestR{}
 			}
-			foreign.R.Recipient = object
+			foreign.R.User = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.RecipientID {
-				local.R.RecipientPostPrompts = append(local.R.RecipientPostPrompts, foreign)
+			if queries.Equal(local.ID, foreign.UserID) {
+				local.R.PasswordResetRequests = append(local.R.PasswordResetRequests, foreign)
 				if foreign.R == nil {
-					foreign.R = &postPromptR{}
+					foreign.R = &passwordResetRequestR{}
 				}
-				foreign.R.Recipient = local
+				foreign.R.User = local
 				break
 			}
 		}
@@ -1403,9 +1765,9 @@ func (userL) LoadRecipientPostPrompts(ctx context.Context, e boil.ContextExecuto
 	return nil
 }
 
-// LoadPosts allows an eager lookup of values, cached into the
+// LoadPostComments allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadPosts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadPostComments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1458,8 +1820,8 @@ func (userL) LoadPosts(ctx context.Context, e boil.ContextExecutor, singular boo
 	}
 
 	query := NewQuery(
-		qm.From(`posts`),
-		qm.WhereIn(`posts.user_id in ?`, argsSlice...),
+		qm.From(`post_comments`),
+		qm.WhereIn(`post_comments.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1467,26 +1829,26 @@ func (userL) LoadPosts(ctx context.Context, e boil.ContextExecutor, singular boo
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load posts")
+		return errors.Wrap(err, "failed to eager load post_comments")
 	}
 
-	var resultSlice []*Post
+	var resultSlice []*PostComment
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice posts")
+		return errors.Wrap(err, "failed to bind eager loaded slice post_comments")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on posts")
+		return errors.Wrap(err, "failed to close results in eager load on post_comments")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_comments")
 	}
 
 	if singular {
-		object.R.Posts = resultSlice
+		object.R.PostComments = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &postR{}
+				foreign.R = &postCommentR{}
 			}
 			foreign.R.User = object
 		}
@@ -1495,10 +1857,10 @@ func (userL) LoadPosts(ctx context.Context, e boil.ContextExecutor, singular boo
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.Posts = append(local.R.Posts, foreign)
+			if queries.Equal(local.ID, foreign.UserID) {
+				local.R.PostComments = append(local.R.PostComments, foreign)
 				if foreign.R == nil {
-					foreign.R = &postR{}
+					foreign.R = &postCommentR{}
 				}
 				foreign.R.User = local
 				break
@@ -1509,9 +1871,9 @@ func (userL) LoadPosts(ctx context.Context, e boil.ContextExecutor, singular boo
 	return nil
 }
 
-// LoadTargetUserUserConnectionMediationRequests allows an eager lookup of values, cached into the
+// LoadAskerPostPrompts allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadTargetUserUserConnectionMediationRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadAskerPostPrompts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1564,8 +1926,8 @@ func (userL) LoadTargetUserUserConnectionMediationRequests(ctx context.Context,
 	}
 
 	query := NewQuery(
-		qm.From(`user_connection_mediation_requests`),
-		qm.WhereIn(`user_connection_mediation_requests.target_user_id in ?`, argsSlice...),
+		qm.From(`post_prompts`),
+		qm.WhereIn(`post_prompts.asker_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1573,40 +1935,40 @@ func (userL) LoadTargetUserUserConnectionMediationRequests(ctx context.Context,
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_connection_mediation_requests")
+		return errors.Wrap(err, "failed to eager load post_prompts")
 	}
 
-	var resultSlice []*UserConnectionMediationRequest
+	var resultSlice []*PostPrompt
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_connection_mediation_requests")
+		return errors.Wrap(err, "failed to bind eager loaded slice post_prompts")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_connection_mediation_requests")
+		return errors.Wrap(err, "failed to close results in eager load on post_prompts")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_connection_mediation_requests")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_prompts")
 	}
 
 	if singular {
-		object.R.TargetUserUserConnectionMediationRequests = resultSlice
+		object.R.AskerPostPrompts = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userConnectionMediationRequestR{}
+				foreign.R = &postPromptR{}
 			}
-			foreign.R.TargetUser = object
+			foreign.R.Asker = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.TargetUserID {
-				local.R.TargetUserUserConnectionMediationRequests = append(local.R.TargetUserUserConnectionMediationRequests, foreign)
+			if local.ID == foreign.AskerID {
+				local.R.AskerPostPrompts = append(local.R.AskerPostPrompts, foreign)
 				if foreign.R == nil {
-					foreign.R = &userConnectionMediationRequestR{}
+					foreign.R = &postPromptR{}
 				}
-				foreign.R.TargetUser = local
+				foreign.R.Asker = local
 				break
 			}
 		}
@@ -1615,9 +1977,9 @@ func (userL) LoadTargetUserUserConnectionMediationRequests(ctx context.Context,
 	return nil
 }
 
-// LoadWhoUserUserConnectionMediationRequests allows an eager lookup of values, cached into the
+// LoadRecipientPostPrompts allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadWhoUserUserConnectionMediationRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadRecipientPostPrompts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1670,8 +2032,8 @@ func (userL) LoadWhoUserUserConnectionMediationRequests(ctx context.Context, e b
 	}
 
 	query := NewQuery(
-		qm.From(`user_connection_mediation_requests`),
-		qm.WhereIn(`user_connection_mediation_requests.who_user_id in ?`, argsSlice...),
+		qm.From(`post_prompts`),
+		qm.WhereIn(`post_prompts.recipient_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1679,40 +2041,40 @@ func (userL) LoadWhoUserUserConnectionMediationRequests(ctx context.Context, e b
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_connection_mediation_requests")
+		return errors.Wrap(err, "failed to eager load post_prompts")
 	}
 
-	var resultSlice []*UserConnectionMediationRequest
+	var resultSlice []*PostPrompt
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_connection_mediation_requests")
+		return errors.Wrap(err, "failed to bind eager loaded slice post_prompts")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_connection_mediation_requests")
+		return errors.Wrap(err, "failed to close results in eager load on post_prompts")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_connection_mediation_requests")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_prompts")
 	}
 
 	if singular {
-		object.R.WhoUserUserConnectionMediationRequests = resultSlice
+		object.R.RecipientPostPrompts = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userConnectionMediationRequestR{}
+				foreign.R = &postPromptR{}
 			}
-			foreign.R.WhoUser = object
+			foreign.R.Recipient = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.WhoUserID {
-				local.R.WhoUserUserConnectionMediationRequests = append(local.R.WhoUserUserConnectionMediationRequests, foreign)
+			if local.ID == foreign.RecipientID {
+				local.R.RecipientPostPrompts = append(local.R.RecipientPostPrompts, foreign)
 				if foreign.R == nil {
-					foreign.R = &userConnectionMediationRequestR{}
+					foreign.R = &postPromptR{}
 				}
-				foreign.R.WhoUser = local
+				foreign.R.Recipient = local
 				break
 			}
 		}
@@ -1721,9 +2083,9 @@ func (userL) LoadWhoUserUserConnectionMediationRequests(ctx context.Context, e b
 	return nil
 }
 
-// LoadUserConnectionMediators allows an eager lookup of values, cached into the
+// LoadPosts allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadUserConnectionMediators(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadPosts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1776,8 +2138,8 @@ func (userL) LoadUserConnectionMediators(ctx context.Context, e boil.ContextExec
 	}
 
 	query := NewQuery(
-		qm.From(`user_connection_mediators`),
-		qm.WhereIn(`user_connection_mediators.user_id in ?`, argsSlice...),
+		qm.From(`posts`),
+		qm.WhereIn(`posts.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1785,26 +2147,26 @@ func (userL) LoadUserConnectionMediators(ctx context.Context, e boil.ContextExec
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_connection_mediators")
+		return errors.Wrap(err, "failed to eager load posts")
 	}
 
-	var resultSlice []*UserConnectionMediator
+	var resultSlice []*Post
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_connection_mediators")
+		return errors.Wrap(err, "failed to bind eager loaded slice posts")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_connection_mediators")
+		return errors.Wrap(err, "failed to close results in eager load on posts")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_connection_mediators")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
 	}
 
 	if singular {
-		object.R.UserConnectionMediators = resultSlice
+		object.R.Posts = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userConnectionMediatorR{}
+				foreign.R = &postR{}
 			}
 			foreign.R.User = object
 		}
@@ -1814,9 +2176,9 @@ func (userL) LoadUserConnectionMediators(ctx context.Context, e boil.ContextExec
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.UserID {
-				local.R.UserConnectionMediators = append(local.R.UserConnectionMediators, foreign)
+				local.R.Posts = append(local.R.Posts, foreign)
 				if foreign.R == nil {
-					foreign.R = &userConnectionMediatorR{}
+					foreign.R = &postR{}
 				}
 				foreign.R.User = local
 				break
@@ -1827,9 +2189,9 @@ func (userL) LoadUserConnectionMediators(ctx context.Context, e boil.ContextExec
 	return nil
 }
 
-// LoadUser1UserConnections allows an eager lookup of values, cached into the
+// LoadTargetUserUserConnectionMediationRequests allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadUser1UserConnections(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadTargetUserUserConnectionMediationRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1882,8 +2244,8 @@ func (userL) LoadUser1UserConnections(ctx context.Context, e boil.ContextExecuto
 	}
 
 	query := NewQuery(
-		qm.From(`user_connections`),
-		qm.WhereIn(`user_connections.user1_id in ?`, argsSlice...),
+		qm.From(`user_connection_mediation_requests`),
+		qm.WhereIn(`user_connection_mediation_requests.target_user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1891,40 +2253,40 @@ func (userL) LoadUser1UserConnections(ctx context.Context, e boil.ContextExecuto
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_connections")
+		return errors.Wrap(err, "failed to eager load user_connection_mediation_requests")
 	}
 
-	var resultSlice []*UserConnection
+	var resultSlice []*UserConnectionMediationRequest
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_connections")
+		return errors.Wrap(err, "failed to bind eager loaded slice user_connection_mediation_requests")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_connections")
+		return errors.Wrap(err, "failed to close results in eager load on user_connection_mediation_requests")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_connections")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_connection_mediation_requests")
 	}
 
 	if singular {
-		object.R.User1UserConnections = resultSlice
+		object.R.TargetUserUserConnectionMediationRequests = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userConnectionR{}
+				foreign.R = &userConnectionMediationRequestR{}
 			}
-			foreign.R.User1 = object
+			foreign.R.TargetUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.User1ID {
-				local.R.User1UserConnections = append(local.R.User1UserConnections, foreign)
+			if local.ID == foreign.TargetUserID {
+				local.R.TargetUserUserConnectionMediationRequests = append(local.R.TargetUserUserConnectionMediationRequests, foreign)
 				if foreign.R == nil {
-					foreign.R = &userConnectionR{}
+					foreign.R = &userConnectionMediationRequestR{}
 				}
-				foreign.R.User1 = local
+				foreign.R.TargetUser = local
 				break
 			}
 		}
@@ -1933,9 +2295,9 @@ func (userL) LoadUser1UserConnections(ctx context.Context, e boil.ContextExecuto
 	return nil
 }
 
-// LoadUser2UserConnections allows an eager lookup of values, cached into the
+// LoadWhoUserUserConnectionMediationRequests allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadUser2UserConnections(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadWhoUserUserConnectionMediationRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1988,8 +2350,8 @@ func (userL) LoadUser2UserConnections(ctx context.Context, e boil.ContextExecuto
 	}
 
 	query := NewQuery(
-		qm.From(`user_connections`),
-		qm.WhereIn(`user_connections.user2_id in ?`, argsSlice...),
+		qm.From(`user_connection_mediation_requests`),
+		qm.WhereIn(`user_connection_mediation_requests.who_user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1997,40 +2359,40 @@ func (userL) LoadUser2UserConnections(ctx context.Context, e boil.ContextExecuto
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_connections")
+		return errors.Wrap(err, "failed to eager load user_connection_mediation_requests")
 	}
 
-	var resultSlice []*UserConnection
+	var resultSlice []*UserConnectionMediationRequest
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_connections")
+		return errors.Wrap(err, "failed to bind eager loaded slice user_connection_mediation_requests")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_connections")
+		return errors.Wrap(err, "failed to close results in eager load on user_connection_mediation_requests")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_connections")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_connection_mediation_requests")
 	}
 
 	if singular {
-		object.R.User2UserConnections = resultSlice
+		object.R.WhoUserUserConnectionMediationRequests = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userConnectionR{}
+				foreign.R = &userConnectionMediationRequestR{}
 			}
-			foreign.R.User2 = object
+			foreign.R.WhoUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.User2ID {
-				local.R.User2UserConnections = append(local.R.User2UserConnections, foreign)
+			if local.ID == foreign.WhoUserID {
+				local.R.WhoUserUserConnectionMediationRequests = append(local.R.WhoUserUserConnectionMediationRequests, foreign)
 				if foreign.R == nil {
-					foreign.R = &userConnectionR{}
+					foreign.R = &userConnectionMediationRequestR{}
 				}
-				foreign.R.User2 = local
+				foreign.R.WhoUser = local
 				break
 			}
 		}
@@ -2039,9 +2401,9 @@ func (userL) LoadUser2UserConnections(ctx context.Context, e boil.ContextExecuto
 	return nil
 }
 
-// LoadUserFeedItems allows an eager lookup of values, cached into the
+// LoadUserConnectionMediators allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadUserFeedItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadUserConnectionMediators(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -2094,8 +2456,8 @@ func (userL) LoadUserFeedItems(ctx context.Context, e boil.ContextExecutor, sing
 	}
 
 	query := NewQuery(
-		qm.From(`user_feed_items`),
-		qm.WhereIn(`user_feed_items.user_id in ?`, argsSlice...),
+		qm.From(`user_connection_mediators`),
+		qm.WhereIn(`user_connection_mediators.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2103,26 +2465,26 @@ func (userL) LoadUserFeedItems(ctx context.Context, e boil.ContextExecutor, sing
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_feed_items")
+		return errors.Wrap(err, "failed to eager load user_connection_mediators")
 	}
 
-	var resultSlice []*UserFeedItem
+	var resultSlice []*UserConnectionMediator
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_feed_items")
+		return errors.Wrap(err, "failed to bind eager loaded slice user_connection_mediators")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_feed_items")
+		return errors.Wrap(err, "failed to close results in eager load on user_connection_mediators")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_feed_items")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_connection_mediators")
 	}
 
 	if singular {
-		object.R.UserFeedItems = resultSlice
+		object.R.UserConnectionMediators = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userFeedItemR{}
+				foreign.R = &userConnectionMediatorR{}
 			}
 			foreign.R.User = object
 		}
@@ -2132,9 +2494,9 @@ func (userL) LoadUserFeedItems(ctx context.Context, e boil.ContextExecutor, sing
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.UserID {
-				local.R.UserFeedItems = append(local.R.UserFeedItems, foreign)
+				local.R.UserConnectionMediators = append(local.R.UserConnectionMediators, foreign)
 				if foreign.R == nil {
-					foreign.R = &userFeedItemR{}
+					foreign.R = &userConnectionMediatorR{}
 				}
 				foreign.R.User = local
 				break
@@ -2145,9 +2507,9 @@ func (userL) LoadUserFeedItems(ctx context.Context, e boil.ContextExecutor, sing
 	return nil
 }
 
-// LoadUserFeedSubscriptions allows an eager lookup of values, cached into the
+// LoadUser1UserConnections allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadUserFeedSubscriptions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadUser1UserConnections(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -2200,8 +2562,8 @@ func (userL) LoadUserFeedSubscriptions(ctx context.Context, e boil.ContextExecut
 	}
 
 	query := NewQuery(
-		qm.From(`user_feed_subscriptions`),
-		qm.WhereIn(`user_feed_subscriptions.user_id in ?`, argsSlice...),
+		qm.From(`user_connections`),
+		qm.WhereIn(`user_connections.user1_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2209,40 +2571,40 @@ func (userL) LoadUserFeedSubscriptions(ctx context.Context, e boil.ContextExecut
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_feed_subscriptions")
+		return errors.Wrap(err, "failed to eager load user_connections")
 	}
 
-	var resultSlice []*UserFeedSubscription
+	var resultSlice []*UserConnection
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_feed_subscriptions")
+		return errors.Wrap(err, "failed to bind eager loaded slice user_connections")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_feed_subscriptions")
+		return errors.Wrap(err, "failed to close results in eager load on user_connections")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_feed_subscriptions")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_connections")
 	}
 
 	if singular {
-		object.R.UserFeedSubscriptions = resultSlice
+		object.R.User1UserConnections = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userFeedSubscriptionR{}
+				foreign.R = &userConnectionR{}
 			}
-			foreign.R.User = object
+			foreign.R.User1 = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.UserFeedSubscriptions = append(local.R.UserFeedSubscriptions, foreign)
+			if local.ID == foreign.User1ID {
+				local.R.User1UserConnections = append(local.R.User1UserConnections, foreign)
 				if foreign.R == nil {
-					foreign.R = &userFeedSubscriptionR{}
+					foreign.R = &userConnectionR{}
 				}
-				foreign.R.User = local
+				foreign.R.User1 = local
 				break
 			}
 		}
@@ -2251,9 +2613,9 @@ func (userL) LoadUserFeedSubscriptions(ctx context.Context, e boil.ContextExecut
 	return nil
 }
 
-// LoadCreatedUserUserInvitations allows an eager lookup of values, cached into the
+// LoadUser2UserConnections allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadCreatedUserUserInvitations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadUser2UserConnections(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -2306,8 +2668,8 @@ func (userL) LoadCreatedUserUserInvitations(ctx context.Context, e boil.ContextE
 	}
 
 	query := NewQuery(
-		qm.From(`user_invitations`),
-		qm.WhereIn(`user_invitations.created_user_id in ?`, argsSlice...),
+		qm.From(`user_connections`),
+		qm.WhereIn(`user_connections.user2_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2315,40 +2677,40 @@ func (userL) LoadCreatedUserUserInvitations(ctx context.Context, e boil.ContextE
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_invitations")
+		return errors.Wrap(err, "failed to eager load user_connections")
 	}
 
-	var resultSlice []*UserInvitation
+	var resultSlice []*UserConnection
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_invitations")
+		return errors.Wrap(err, "failed to bind eager loaded slice user_connections")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_invitations")
+		return errors.Wrap(err, "failed to close results in eager load on user_connections")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_invitations")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_connections")
 	}
 
 	if singular {
-		object.R.CreatedUserUserInvitations = resultSlice
+		object.R.User2UserConnections = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userInvitationR{}
+				foreign.R = &userConnectionR{}
 			}
-			foreign.R.CreatedUser = object
+			foreign.R.User2 = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.CreatedUserID) {
-				local.R.CreatedUserUserInvitations = append(local.R.CreatedUserUserInvitations, foreign)
+			if local.ID == foreign.User2ID {
+				local.R.User2UserConnections = append(local.R.User2UserConnections, foreign)
 				if foreign.R == nil {
-					foreign.R = &userInvitationR{}
+					foreign.R = &userConnectionR{}
 				}
-				foreign.R.CreatedUser = local
+				foreign.R.User2 = local
 				break
 			}
 		}
@@ -2357,9 +2719,9 @@ func (userL) LoadCreatedUserUserInvitations(ctx context.Context, e boil.ContextE
 	return nil
 }
 
-// LoadUserInvitations allows an eager lookup of values, cached into the
+// LoadUserFeedFolders allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadUserInvitations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadUserFeedFolders(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -2412,8 +2774,8 @@ func (userL) LoadUserInvitations(ctx context.Context, e boil.ContextExecutor, si
 	}
 
 	query := NewQuery(
-		qm.From(`user_invitations`),
-		qm.WhereIn(`user_invitations.user_id in ?`, argsSlice...),
+		qm.From(`user_feed_folders`),
+		qm.WhereIn(`user_feed_folders.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2421,26 +2783,26 @@ func (userL) LoadUserInvitations(ctx context.Context, e boil.ContextExecutor, si
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_invitations")
+		return errors.Wrap(err, "failed to eager load user_feed_folders")
 	}
 
-	var resultSlice []*UserInvitation
+	var resultSlice []*UserFeedFolder
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_invitations")
+		return errors.Wrap(err, "failed to bind eager loaded slice user_feed_folders")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_invitations")
+		return errors.Wrap(err, "failed to close results in eager load on user_feed_folders")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_invitations")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_feed_folders")
 	}
 
 	if singular {
-		object.R.UserInvitations = resultSlice
+		object.R.UserFeedFolders = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userInvitationR{}
+				foreign.R = &userFeedFolderR{}
 			}
 			foreign.R.User = object
 		}
@@ -2450,9 +2812,9 @@ func (userL) LoadUserInvitations(ctx context.Context, e boil.ContextExecutor, si
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.UserID {
-				local.R.UserInvitations = append(local.R.UserInvitations, foreign)
+				local.R.UserFeedFolders = append(local.R.UserFeedFolders, foreign)
 				if foreign.R == nil {
-					foreign.R = &userInvitationR{}
+					foreign.R = &userFeedFolderR{}
 				}
 				foreign.R.User = local
 				break
@@ -2463,9 +2825,9 @@ func (userL) LoadUserInvitations(ctx context.Context, e boil.ContextExecutor, si
 	return nil
 }
 
-// LoadCreatedUserUserSignupRequests allows an eager lookup of values, cached into the
+// LoadUserFeedItems allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadCreatedUserUserSignupRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadUserFeedItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -2518,8 +2880,8 @@ func (userL) LoadCreatedUserUserSignupRequests(ctx context.Context, e boil.Conte
 	}
 
 	query := NewQuery(
-		qm.From(`user_signup_requests`),
-		qm.WhereIn(`user_signup_requests.created_user_id in ?`, argsSlice...),
+		qm.From(`user_feed_items`),
+		qm.WhereIn(`user_feed_items.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2527,40 +2889,40 @@ func (userL) LoadCreatedUserUserSignupRequests(ctx context.Context, e boil.Conte
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_signup_requests")
+		return errors.Wrap(err, "failed to eager load user_feed_items")
 	}
 
-	var resultSlice []*UserSignupRequest
+	var resultSlice []*UserFeedItem
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_signup_requests")
+		return errors.Wrap(err, "failed to bind eager loaded slice user_feed_items")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_signup_requests")
+		return errors.Wrap(err, "failed to close results in eager load on user_feed_items")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_signup_requests")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_feed_items")
 	}
 
 	if singular {
-		object.R.CreatedUserUserSignupRequests = resultSlice
+		object.R.UserFeedItems = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userSignupRequestR{}
+				foreign.R = &userFeedItemR{}
 			}
-			foreign.R.CreatedUser = object
+			foreign.R.User = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.CreatedUserID) {
-				local.R.CreatedUserUserSignupRequests = append(local.R.CreatedUserUserSignupRequests, foreign)
+			if local.ID == foreign.UserID {
+				local.R.UserFeedItems = append(local.R.UserFeedItems, foreign)
 				if foreign.R == nil {
-					foreign.R = &userSignupRequestR{}
+					foreign.R = &userFeedItemR{}
 				}
-				foreign.R.CreatedUser = local
+				foreign.R.User = local
 				break
 			}
 		}
@@ -2569,9 +2931,9 @@ func (userL) LoadCreatedUserUserSignupRequests(ctx context.Context, e boil.Conte
 	return nil
 }
 
-// LoadAllowsWhoWhitelistedConnections allows an eager lookup of values, cached into the
+// LoadUserFeedRules allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadAllowsWhoWhitelistedConnections(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadUserFeedRules(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -2624,8 +2986,8 @@ func (userL) LoadAllowsWhoWhitelistedConnections(ctx context.Context, e boil.Con
 	}
 
 	query := NewQuery(
-		qm.From(`whitelisted_connections`),
-		qm.WhereIn(`whitelisted_connections.allows_who_id in ?`, argsSlice...),
+		qm.From(`user_feed_rules`),
+		qm.WhereIn(`user_feed_rules.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2633,40 +2995,40 @@ func (userL) LoadAllowsWhoWhitelistedConnections(ctx context.Context, e boil.Con
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load whitelisted_connections")
+		return errors.Wrap(err, "failed to eager load user_feed_rules")
 	}
 
-	var resultSlice []*WhitelistedConnection
+	var resultSlice []*UserFeedRule
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice whitelisted_connections")
+		return errors.Wrap(err, "failed to bind eager loaded slice user_feed_rules")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on whitelisted_connections")
+		return errors.Wrap(err, "failed to close results in eager load on user_feed_rules")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for whitelisted_connections")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_feed_rules")
 	}
 
 	if singular {
-		object.R.AllowsWhoWhitelistedConnections = resultSlice
+		object.R.UserFeedRules = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &whitelistedConnectionR{}
+				foreign.R = &userFeedRuleR{}
 			}
-			foreign.R.AllowsWho = object
+			foreign.R.User = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.AllowsWhoID {
-				local.R.AllowsWhoWhitelistedConnections = append(local.R.AllowsWhoWhitelistedConnections, foreign)
+			if local.ID == foreign.UserID {
+				local.R.UserFeedRules = append(local.R.UserFeedRules, foreign)
 				if foreign.R == nil {
-					foreign.R = &whitelistedConnectionR{}
+					foreign.R = &userFeedRuleR{}
 				}
-				foreign.R.AllowsWho = local
+				foreign.R.User = local
 				break
 			}
 		}
@@ -2675,9 +3037,9 @@ func (userL) LoadAllowsWhoWhitelistedConnections(ctx context.Context, e boil.Con
 	return nil
 }
 
-// LoadWhoWhitelistedConnections allows an eager lookup of values, cached into the
+// LoadUserFeedSubscriptions allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadWhoWhitelistedConnections(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadUserFeedSubscriptions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -2730,8 +3092,8 @@ func (userL) LoadWhoWhitelistedConnections(ctx context.Context, e boil.ContextEx
 	}
 
 	query := NewQuery(
-		qm.From(`whitelisted_connections`),
-		qm.WhereIn(`whitelisted_connections.who_id in ?`, argsSlice...),
+		qm.From(`user_feed_subscriptions`),
+		qm.WhereIn(`user_feed_subscriptions.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2739,40 +3101,40 @@ func (userL) LoadWhoWhitelistedConnections(ctx context.Context, e boil.ContextEx
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load whitelisted_connections")
+		return errors.Wrap(err, "failed to eager load user_feed_subscriptions")
 	}
 
-	var resultSlice []*WhitelistedConnection
+	var resultSlice []*UserFeedSubscription
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice whitelisted_connections")
+		return errors.Wrap(err, "failed to bind eager loaded slice user_feed_subscriptions")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on whitelisted_connections")
+		return errors.Wrap(err, "failed to close results in eager load on user_feed_subscriptions")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for whitelisted_connections")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_feed_subscriptions")
 	}
 
 	if singular {
-		object.R.WhoWhitelistedConnections = resultSlice
+		object.R.UserFeedSubscriptions = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &whitelistedConnectionR{}
+				foreign.R = &userFeedSubscriptionR{}
 			}
-			foreign.R.Who = object
+			foreign.R.User = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.WhoID {
-				local.R.WhoWhitelistedConnections = append(local.R.WhoWhitelistedConnections, foreign)
+			if local.ID == foreign.UserID {
+				local.R.UserFeedSubscriptions = append(local.R.UserFeedSubscriptions, foreign)
 				if foreign.R == nil {
-					foreign.R = &whitelistedConnectionR{}
+					foreign.R = &userFeedSubscriptionR{}
 				}
-				foreign.R.Who = local
+				foreign.R.User = local
 				break
 			}
 		}
@@ -2781,88 +3143,1148 @@ func (userL) LoadWhoWhitelistedConnections(ctx context.Context, e boil.ContextEx
 	return nil
 }
 
-// SetUserAPIKeyP of the user to the related item.
-// Sets o.R.UserAPIKey to related.
-// Adds o to related.R.User.
-// Panics on error.
-func (o *User) SetUserAPIKeyP(ctx context.Context, exec boil.ContextExecutor, insert bool, related *UserAPIKey) {
-	if err := o.SetUserAPIKey(ctx, exec, insert, related); err != nil {
-		panic(boil.WrapErr(err))
-	}
-}
-
-// SetUserAPIKey of the user to the related item.
-// Sets o.R.UserAPIKey to related.
-// Adds o to related.R.User.
-func (o *User) SetUserAPIKey(ctx context.Context, exec boil.ContextExecutor, insert bool, related *UserAPIKey) error {
-	var err error
-
-	if insert {
-		related.UserID = o.ID
+// LoadUserIdentities allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserIdentities(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
 
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
 		}
 	} else {
-		updateQuery := fmt.Sprintf(
-			"UPDATE \"user_api_keys\" SET %s WHERE %s",
-			strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-			strmangle.WhereClause("\"", "\"", 2, userAPIKeyPrimaryKeyColumns),
-		)
-		values := []interface{}{o.ID, related.ID}
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
 
-		if boil.IsDebug(ctx) {
-			writer := boil.DebugWriterFrom(ctx)
-			fmt.Fprintln(writer, updateQuery)
-			fmt.Fprintln(writer, values)
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
 		}
-		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-			return errors.Wrap(err, "failed to update foreign table")
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
 		}
+	}
 
-		related.UserID = o.ID
+	if len(args) == 0 {
+		return nil
 	}
 
-	if o.R == nil {
-		o.R = &userR{
-			UserAPIKey: related,
-		}
-	} else {
-		o.R.UserAPIKey = related
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
 	}
 
-	if related.R == nil {
-		related.R = &userAPIKeyR{
-			User: o,
-		}
-	} else {
-		related.R.User = o
+	query := NewQuery(
+		qm.From(`user_identities`),
+		qm.WhereIn(`user_identities.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
 	}
-	return nil
-}
 
-// SetUserStyleP of the user to the related item.
-// Sets o.R.UserStyle to related.
-// Adds o to related.R.User.
-// Panics on error.
-func (o *User) SetUserStyleP(ctx context.Context, exec boil.ContextExecutor, insert bool, related *UserStyle) {
-	if err := o.SetUserStyle(ctx, exec, insert, related); err != nil {
-		panic(boil.WrapErr(err))
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_identities")
 	}
-}
 
-// SetUserStyle of the user to the related item.
-// Sets o.R.UserStyle to related.
-// Adds o to related.R.User.
-func (o *User) SetUserStyle(ctx context.Context, exec boil.ContextExecutor, insert bool, related *UserStyle) error {
-	var err error
+	var resultSlice []*UserIdentity
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_identities")
+	}
 
-	if insert {
-		related.UserID = o.ID
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_identities")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_identities")
+	}
 
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
+	if singular {
+		object.R.UserIdentities = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userIdentityR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserIdentities = append(local.R.UserIdentities, foreign)
+				if foreign.R == nil {
+					foreign.R = &userIdentityR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedUserUserInvitations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedUserUserInvitations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_invitations`),
+		qm.WhereIn(`user_invitations.created_user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_invitations")
+	}
+
+	var resultSlice []*UserInvitation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_invitations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_invitations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_invitations")
+	}
+
+	if singular {
+		object.R.CreatedUserUserInvitations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userInvitationR{}
+			}
+			foreign.R.CreatedUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CreatedUserID) {
+				local.R.CreatedUserUserInvitations = append(local.R.CreatedUserUserInvitations, foreign)
+				if foreign.R == nil {
+					foreign.R = &userInvitationR{}
+				}
+				foreign.R.CreatedUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserInvitations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserInvitations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_invitations`),
+		qm.WhereIn(`user_invitations.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_invitations")
+	}
+
+	var resultSlice []*UserInvitation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_invitations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_invitations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_invitations")
+	}
+
+	if singular {
+		object.R.UserInvitations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userInvitationR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserInvitations = append(local.R.UserInvitations, foreign)
+				if foreign.R == nil {
+					foreign.R = &userInvitationR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserPasskeys allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserPasskeys(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_passkeys`),
+		qm.WhereIn(`user_passkeys.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_passkeys")
+	}
+
+	var resultSlice []*UserPasskey
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_passkeys")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_passkeys")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_passkeys")
+	}
+
+	if singular {
+		object.R.UserPasskeys = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userPasskeyR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserPasskeys = append(local.R.UserPasskeys, foreign)
+				if foreign.R == nil {
+					foreign.R = &userPasskeyR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserRecoveryCodes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserRecoveryCodes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_recovery_codes`),
+		qm.WhereIn(`user_recovery_codes.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_recovery_codes")
+	}
+
+	var resultSlice []*UserRecoveryCode
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_recovery_codes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_recovery_codes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_recovery_codes")
+	}
+
+	if singular {
+		object.R.UserRecoveryCodes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userRecoveryCodeR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserRecoveryCodes = append(local.R.UserRecoveryCodes, foreign)
+				if foreign.R == nil {
+					foreign.R = &userRecoveryCodeR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserRememberedDevices allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserRememberedDevices(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_remembered_devices`),
+		qm.WhereIn(`user_remembered_devices.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_remembered_devices")
+	}
+
+	var resultSlice []*UserRememberedDevice
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_remembered_devices")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_remembered_devices")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_remembered_devices")
+	}
+
+	if singular {
+		object.R.UserRememberedDevices = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userRememberedDeviceR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserRememberedDevices = append(local.R.UserRememberedDevices, foreign)
+				if foreign.R == nil {
+					foreign.R = &userRememberedDeviceR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserSessions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserSessions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_sessions`),
+		qm.WhereIn(`user_sessions.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_sessions")
+	}
+
+	var resultSlice []*UserSession
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_sessions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_sessions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_sessions")
+	}
+
+	if singular {
+		object.R.UserSessions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userSessionR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserSessions = append(local.R.UserSessions, foreign)
+				if foreign.R == nil {
+					foreign.R = &userSessionR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedUserUserSignupRequests allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedUserUserSignupRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_signup_requests`),
+		qm.WhereIn(`user_signup_requests.created_user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_signup_requests")
+	}
+
+	var resultSlice []*UserSignupRequest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_signup_requests")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_signup_requests")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_signup_requests")
+	}
+
+	if singular {
+		object.R.CreatedUserUserSignupRequests = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userSignupRequestR{}
+			}
+			foreign.R.CreatedUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CreatedUserID) {
+				local.R.CreatedUserUserSignupRequests = append(local.R.CreatedUserUserSignupRequests, foreign)
+				if foreign.R == nil {
+					foreign.R = &userSignupRequestR{}
+				}
+				foreign.R.CreatedUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAllowsWhoWhitelistedConnections allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAllowsWhoWhitelistedConnections(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`whitelisted_connections`),
+		qm.WhereIn(`whitelisted_connections.allows_who_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load whitelisted_connections")
+	}
+
+	var resultSlice []*WhitelistedConnection
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice whitelisted_connections")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on whitelisted_connections")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for whitelisted_connections")
+	}
+
+	if singular {
+		object.R.AllowsWhoWhitelistedConnections = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &whitelistedConnectionR{}
+			}
+			foreign.R.AllowsWho = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AllowsWhoID {
+				local.R.AllowsWhoWhitelistedConnections = append(local.R.AllowsWhoWhitelistedConnections, foreign)
+				if foreign.R == nil {
+					foreign.R = &whitelistedConnectionR{}
+				}
+				foreign.R.AllowsWho = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWhoWhitelistedConnections allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadWhoWhitelistedConnections(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`whitelisted_connections`),
+		qm.WhereIn(`whitelisted_connections.who_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load whitelisted_connections")
+	}
+
+	var resultSlice []*WhitelistedConnection
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice whitelisted_connections")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on whitelisted_connections")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for whitelisted_connections")
+	}
+
+	if singular {
+		object.R.WhoWhitelistedConnections = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &whitelistedConnectionR{}
+			}
+			foreign.R.Who = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.WhoID {
+				local.R.WhoWhitelistedConnections = append(local.R.WhoWhitelistedConnections, foreign)
+				if foreign.R == nil {
+					foreign.R = &whitelistedConnectionR{}
+				}
+				foreign.R.Who = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUserAPIKeyP of the user to the related item.
+// Sets o.R.UserAPIKey to related.
+// Adds o to related.R.User.
+// Panics on error.
+func (o *User) SetUserAPIKeyP(ctx context.Context, exec boil.ContextExecutor, insert bool, related *UserAPIKey) {
+	if err := o.SetUserAPIKey(ctx, exec, insert, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetUserAPIKey of the user to the related item.
+// Sets o.R.UserAPIKey to related.
+// Adds o to related.R.User.
+func (o *User) SetUserAPIKey(ctx context.Context, exec boil.ContextExecutor, insert bool, related *UserAPIKey) error {
+	var err error
+
+	if insert {
+		related.UserID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"user_api_keys\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+			strmangle.WhereClause("\"", "\"", 2, userAPIKeyPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.UserID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UserAPIKey: related,
+		}
+	} else {
+		o.R.UserAPIKey = related
+	}
+
+	if related.R == nil {
+		related.R = &userAPIKeyR{
+			User: o,
+		}
+	} else {
+		related.R.User = o
+	}
+	return nil
+}
+
+// SetUserStyleP of the user to the related item.
+// Sets o.R.UserStyle to related.
+// Adds o to related.R.User.
+// Panics on error.
+func (o *User) SetUserStyleP(ctx context.Context, exec boil.ContextExecutor, insert bool, related *UserStyle) {
+	if err := o.SetUserStyle(ctx, exec, insert, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetUserStyle of the user to the related item.
+// Sets o.R.UserStyle to related.
+// Adds o to related.R.User.
+func (o *User) SetUserStyle(ctx context.Context, exec boil.ContextExecutor, insert bool, related *UserStyle) error {
+	var err error
+
+	if insert {
+		related.UserID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
 	} else {
 		updateQuery := fmt.Sprintf(
 			"UPDATE \"user_styles\" SET %s WHERE %s",
@@ -2901,6 +4323,134 @@ func (o *User) SetUserStyle(ctx context.Context, exec boil.ContextExecutor, inse
 	return nil
 }
 
+// AddEmailChangeRequestsP adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.EmailChangeRequests.
+// Sets related.R.User appropriately.
+// Panics on error.
+func (o *User) AddEmailChangeRequestsP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*EmailChangeRequest) {
+	if err := o.AddEmailChangeRequests(ctx, exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddEmailChangeRequests adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.EmailChangeRequests.
+// Sets related.R.User appropriately.
+func (o *User) AddEmailChangeRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*EmailChangeRequest) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"email_change_requests\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, emailChangeRequestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			EmailChangeRequests: related,
+		}
+	} else {
+		o.R.EmailChangeRequests = append(o.R.EmailChangeRequests, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &emailChangeRequestR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddLoginLinksP adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.LoginLinks.
+// Sets related.R.User appropriately.
+// Panics on error.
+func (o *User) AddLoginLinksP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*LoginLink) {
+	if err := o.AddLoginLinks(ctx, exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddLoginLinks adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.LoginLinks.
+// Sets related.R.User appropriately.
+func (o *User) AddLoginLinks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*LoginLink) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"login_links\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, loginLinkPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			LoginLinks: related,
+		}
+	} else {
+		o.R.LoginLinks = append(o.R.LoginLinks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &loginLinkR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // AddMediaUploadsP adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
 // Appends related to o.R.MediaUploads.
@@ -3006,26 +4556,187 @@ func (o *User) SetMediaUploads(ctx context.Context, exec boil.ContextExecutor, i
 
 			rel.R.User = nil
 		}
-		o.R.MediaUploads = nil
+		o.R.MediaUploads = nil
+	}
+
+	return o.AddMediaUploads(ctx, exec, insert, related...)
+}
+
+// RemoveMediaUploadsP relationships from objects passed in.
+// Removes related items from R.MediaUploads (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+// Panics on error.
+func (o *User) RemoveMediaUploadsP(ctx context.Context, exec boil.ContextExecutor, related ...*MediaUpload) {
+	if err := o.RemoveMediaUploads(ctx, exec, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// RemoveMediaUploads relationships from objects passed in.
+// Removes related items from R.MediaUploads (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+func (o *User) RemoveMediaUploads(ctx context.Context, exec boil.ContextExecutor, related ...*MediaUpload) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserID, nil)
+		if rel.R != nil {
+			rel.R.User = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.MediaUploads {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.MediaUploads)
+			if ln > 1 && i < ln-1 {
+				o.R.MediaUploads[i] = o.R.MediaUploads[ln-1]
+			}
+			o.R.MediaUploads = o.R.MediaUploads[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddPasswordResetRequestsP adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PasswordResetRequests.
+// Sets related.R.User appropriately.
+// Panics on error.
+func (o *User) AddPasswordResetRequestsP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PasswordResetRequest) {
+	if err := o.AddPasswordResetRequests(ctx, exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddPasswordResetRequests adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PasswordResetRequests.
+// Sets related.R.User appropriately.
+func (o *User) AddPasswordResetRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PasswordResetRequest) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"password_reset_requests\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, passwordResetRequestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.UserID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			PasswordResetRequests: related,
+		}
+	} else {
+		o.R.PasswordResetRequests = append(o.R.PasswordResetRequests, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &passwordResetRequestR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// SetPasswordResetRequestsP removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.User's PasswordResetRequests accordingly.
+// Replaces o.R.PasswordResetRequests with related.
+// Sets related.R.User's PasswordResetRequests accordingly.
+// Panics on error.
+func (o *User) SetPasswordResetRequestsP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PasswordResetRequest) {
+	if err := o.SetPasswordResetRequests(ctx, exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetPasswordResetRequests removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.User's PasswordResetRequests accordingly.
+// Replaces o.R.PasswordResetRequests with related.
+// Sets related.R.User's PasswordResetRequests accordingly.
+func (o *User) SetPasswordResetRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PasswordResetRequest) error {
+	query := "update \"password_reset_requests\" set \"user_id\" = null where \"user_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.PasswordResetRequests {
+			queries.SetScanner(&rel.UserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.User = nil
+		}
+		o.R.PasswordResetRequests = nil
 	}
 
-	return o.AddMediaUploads(ctx, exec, insert, related...)
+	return o.AddPasswordResetRequests(ctx, exec, insert, related...)
 }
 
-// RemoveMediaUploadsP relationships from objects passed in.
-// Removes related items from R.MediaUploads (uses pointer comparison, removal does not keep order)
+// RemovePasswordResetRequestsP relationships from objects passed in.
+// Removes related items from R.PasswordResetRequests (uses pointer comparison, removal does not keep order)
 // Sets related.R.User.
 // Panics on error.
-func (o *User) RemoveMediaUploadsP(ctx context.Context, exec boil.ContextExecutor, related ...*MediaUpload) {
-	if err := o.RemoveMediaUploads(ctx, exec, related...); err != nil {
+func (o *User) RemovePasswordResetRequestsP(ctx context.Context, exec boil.ContextExecutor, related ...*PasswordResetRequest) {
+	if err := o.RemovePasswordResetRequests(ctx, exec, related...); err != nil {
 		panic(boil.WrapErr(err))
 	}
 }
 
-// RemoveMediaUploads relationships from objects passed in.
-// Removes related items from R.MediaUploads (uses pointer comparison, removal does not keep order)
+// RemovePasswordResetRequests relationships from objects passed in.
+// Removes related items from R.PasswordResetRequests (uses pointer comparison, removal does not keep order)
 // Sets related.R.User.
-func (o *User) RemoveMediaUploads(ctx context.Context, exec boil.ContextExecutor, related ...*MediaUpload) error {
+func (o *User) RemovePasswordResetRequests(ctx context.Context, exec boil.ContextExecutor, related ...*PasswordResetRequest) error {
 	if len(related) == 0 {
 		return nil
 	}
@@ -3045,16 +4756,16 @@ func (o *User) RemoveMediaUploads(ctx context.Context, exec boil.ContextExecutor
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.MediaUploads {
+		for i, ri := range o.R.PasswordResetRequests {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.MediaUploads)
+			ln := len(o.R.PasswordResetRequests)
 			if ln > 1 && i < ln-1 {
-				o.R.MediaUploads[i] = o.R.MediaUploads[ln-1]
+				o.R.PasswordResetRequests[i] = o.R.PasswordResetRequests[ln-1]
 			}
-			o.R.MediaUploads = o.R.MediaUploads[:ln-1]
+			o.R.PasswordResetRequests = o.R.PasswordResetRequests[:ln-1]
 			break
 		}
 	}
@@ -3081,7 +4792,7 @@ func (o *User) AddPostComments(ctx context.Context, exec boil.ContextExecutor, i
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.UserID = o.ID
+			queries.Assign(&rel.UserID, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
@@ -3102,7 +4813,7 @@ func (o *User) AddPostComments(ctx context.Context, exec boil.ContextExecutor, i
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.UserID = o.ID
+			queries.Assign(&rel.UserID, o.ID)
 		}
 	}
 
@@ -3126,6 +4837,103 @@ func (o *User) AddPostComments(ctx context.Context, exec boil.ContextExecutor, i
 	return nil
 }
 
+// SetPostCommentsP removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.User's PostComments accordingly.
+// Replaces o.R.PostComments with related.
+// Sets related.R.User's PostComments accordingly.
+// Panics on error.
+func (o *User) SetPostCommentsP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PostComment) {
+	if err := o.SetPostComments(ctx, exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetPostComments removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.User's PostComments accordingly.
+// Replaces o.R.PostComments with related.
+// Sets related.R.User's PostComments accordingly.
+func (o *User) SetPostComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PostComment) error {
+	query := "update \"post_comments\" set \"user_id\" = null where \"user_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.PostComments {
+			queries.SetScanner(&rel.UserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.User = nil
+		}
+		o.R.PostComments = nil
+	}
+
+	return o.AddPostComments(ctx, exec, insert, related...)
+}
+
+// RemovePostCommentsP relationships from objects passed in.
+// Removes related items from R.PostComments (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+// Panics on error.
+func (o *User) RemovePostCommentsP(ctx context.Context, exec boil.ContextExecutor, related ...*PostComment) {
+	if err := o.RemovePostComments(ctx, exec, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// RemovePostComments relationships from objects passed in.
+// Removes related items from R.PostComments (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+func (o *User) RemovePostComments(ctx context.Context, exec boil.ContextExecutor, related ...*PostComment) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserID, nil)
+		if rel.R != nil {
+			rel.R.User = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.PostComments {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.PostComments)
+			if ln > 1 && i < ln-1 {
+				o.R.PostComments[i] = o.R.PostComments[ln-1]
+			}
+			o.R.PostComments = o.R.PostComments[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // AddAskerPostPromptsP adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
 // Appends related to o.R.AskerPostPrompts.
@@ -3614,46 +5422,238 @@ func (o *User) AddUser2UserConnections(ctx context.Context, exec boil.ContextExe
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.User2ID = o.ID
+			rel.User2ID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			User2UserConnections: related,
+		}
+	} else {
+		o.R.User2UserConnections = append(o.R.User2UserConnections, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userConnectionR{
+				User2: o,
+			}
+		} else {
+			rel.R.User2 = o
+		}
+	}
+	return nil
+}
+
+// AddUserFeedFoldersP adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserFeedFolders.
+// Sets related.R.User appropriately.
+// Panics on error.
+func (o *User) AddUserFeedFoldersP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserFeedFolder) {
+	if err := o.AddUserFeedFolders(ctx, exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddUserFeedFolders adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserFeedFolders.
+// Sets related.R.User appropriately.
+func (o *User) AddUserFeedFolders(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserFeedFolder) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_feed_folders\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userFeedFolderPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UserFeedFolders: related,
+		}
+	} else {
+		o.R.UserFeedFolders = append(o.R.UserFeedFolders, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userFeedFolderR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddUserFeedItemsP adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserFeedItems.
+// Sets related.R.User appropriately.
+// Panics on error.
+func (o *User) AddUserFeedItemsP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserFeedItem) {
+	if err := o.AddUserFeedItems(ctx, exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddUserFeedItems adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserFeedItems.
+// Sets related.R.User appropriately.
+func (o *User) AddUserFeedItems(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserFeedItem) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_feed_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userFeedItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UserFeedItems: related,
+		}
+	} else {
+		o.R.UserFeedItems = append(o.R.UserFeedItems, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userFeedItemR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddUserFeedRulesP adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserFeedRules.
+// Sets related.R.User appropriately.
+// Panics on error.
+func (o *User) AddUserFeedRulesP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserFeedRule) {
+	if err := o.AddUserFeedRules(ctx, exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddUserFeedRules adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserFeedRules.
+// Sets related.R.User appropriately.
+func (o *User) AddUserFeedRules(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserFeedRule) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_feed_rules\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userFeedRulePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			User2UserConnections: related,
+			UserFeedRules: related,
 		}
 	} else {
-		o.R.User2UserConnections = append(o.R.User2UserConnections, related...)
+		o.R.UserFeedRules = append(o.R.UserFeedRules, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &userConnectionR{
-				User2: o,
+			rel.R = &userFeedRuleR{
+				User: o,
 			}
 		} else {
-			rel.R.User2 = o
+			rel.R.User = o
 		}
 	}
 	return nil
 }
 
-// AddUserFeedItemsP adds the given related objects to the existing relationships
+// AddUserFeedSubscriptionsP adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.UserFeedItems.
+// Appends related to o.R.UserFeedSubscriptions.
 // Sets related.R.User appropriately.
 // Panics on error.
-func (o *User) AddUserFeedItemsP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserFeedItem) {
-	if err := o.AddUserFeedItems(ctx, exec, insert, related...); err != nil {
+func (o *User) AddUserFeedSubscriptionsP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserFeedSubscription) {
+	if err := o.AddUserFeedSubscriptions(ctx, exec, insert, related...); err != nil {
 		panic(boil.WrapErr(err))
 	}
 }
 
-// AddUserFeedItems adds the given related objects to the existing relationships
+// AddUserFeedSubscriptions adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.UserFeedItems.
+// Appends related to o.R.UserFeedSubscriptions.
 // Sets related.R.User appropriately.
-func (o *User) AddUserFeedItems(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserFeedItem) error {
+func (o *User) AddUserFeedSubscriptions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserFeedSubscription) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -3663,9 +5663,9 @@ func (o *User) AddUserFeedItems(ctx context.Context, exec boil.ContextExecutor,
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"user_feed_items\" SET %s WHERE %s",
+				"UPDATE \"user_feed_subscriptions\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, userFeedItemPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, userFeedSubscriptionPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -3684,15 +5684,15 @@ func (o *User) AddUserFeedItems(ctx context.Context, exec boil.ContextExecutor,
 
 	if o.R == nil {
 		o.R = &userR{
-			UserFeedItems: related,
+			UserFeedSubscriptions: related,
 		}
 	} else {
-		o.R.UserFeedItems = append(o.R.UserFeedItems, related...)
+		o.R.UserFeedSubscriptions = append(o.R.UserFeedSubscriptions, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &userFeedItemR{
+			rel.R = &userFeedSubscriptionR{
 				User: o,
 			}
 		} else {
@@ -3702,22 +5702,22 @@ func (o *User) AddUserFeedItems(ctx context.Context, exec boil.ContextExecutor,
 	return nil
 }
 
-// AddUserFeedSubscriptionsP adds the given related objects to the existing relationships
+// AddUserIdentitiesP adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.UserFeedSubscriptions.
+// Appends related to o.R.UserIdentities.
 // Sets related.R.User appropriately.
 // Panics on error.
-func (o *User) AddUserFeedSubscriptionsP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserFeedSubscription) {
-	if err := o.AddUserFeedSubscriptions(ctx, exec, insert, related...); err != nil {
+func (o *User) AddUserIdentitiesP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserIdentity) {
+	if err := o.AddUserIdentities(ctx, exec, insert, related...); err != nil {
 		panic(boil.WrapErr(err))
 	}
 }
 
-// AddUserFeedSubscriptions adds the given related objects to the existing relationships
+// AddUserIdentities adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.UserFeedSubscriptions.
+// Appends related to o.R.UserIdentities.
 // Sets related.R.User appropriately.
-func (o *User) AddUserFeedSubscriptions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserFeedSubscription) error {
+func (o *User) AddUserIdentities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserIdentity) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -3727,9 +5727,9 @@ func (o *User) AddUserFeedSubscriptions(ctx context.Context, exec boil.ContextEx
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"user_feed_subscriptions\" SET %s WHERE %s",
+				"UPDATE \"user_identities\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, userFeedSubscriptionPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, userIdentityPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -3748,15 +5748,15 @@ func (o *User) AddUserFeedSubscriptions(ctx context.Context, exec boil.ContextEx
 
 	if o.R == nil {
 		o.R = &userR{
-			UserFeedSubscriptions: related,
+			UserIdentities: related,
 		}
 	} else {
-		o.R.UserFeedSubscriptions = append(o.R.UserFeedSubscriptions, related...)
+		o.R.UserIdentities = append(o.R.UserIdentities, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &userFeedSubscriptionR{
+			rel.R = &userIdentityR{
 				User: o,
 			}
 		} else {
@@ -3991,6 +5991,262 @@ func (o *User) AddUserInvitations(ctx context.Context, exec boil.ContextExecutor
 	return nil
 }
 
+// AddUserPasskeysP adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserPasskeys.
+// Sets related.R.User appropriately.
+// Panics on error.
+func (o *User) AddUserPasskeysP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserPasskey) {
+	if err := o.AddUserPasskeys(ctx, exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddUserPasskeys adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserPasskeys.
+// Sets related.R.User appropriately.
+func (o *User) AddUserPasskeys(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserPasskey) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_passkeys\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userPasskeyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UserPasskeys: related,
+		}
+	} else {
+		o.R.UserPasskeys = append(o.R.UserPasskeys, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userPasskeyR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddUserRecoveryCodesP adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserRecoveryCodes.
+// Sets related.R.User appropriately.
+// Panics on error.
+func (o *User) AddUserRecoveryCodesP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserRecoveryCode) {
+	if err := o.AddUserRecoveryCodes(ctx, exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddUserRecoveryCodes adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserRecoveryCodes.
+// Sets related.R.User appropriately.
+func (o *User) AddUserRecoveryCodes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserRecoveryCode) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_recovery_codes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userRecoveryCodePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UserRecoveryCodes: related,
+		}
+	} else {
+		o.R.UserRecoveryCodes = append(o.R.UserRecoveryCodes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userRecoveryCodeR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddUserRememberedDevicesP adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserRememberedDevices.
+// Sets related.R.User appropriately.
+// Panics on error.
+func (o *User) AddUserRememberedDevicesP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserRememberedDevice) {
+	if err := o.AddUserRememberedDevices(ctx, exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddUserRememberedDevices adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserRememberedDevices.
+// Sets related.R.User appropriately.
+func (o *User) AddUserRememberedDevices(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserRememberedDevice) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_remembered_devices\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userRememberedDevicePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UserRememberedDevices: related,
+		}
+	} else {
+		o.R.UserRememberedDevices = append(o.R.UserRememberedDevices, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userRememberedDeviceR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddUserSessionsP adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserSessions.
+// Sets related.R.User appropriately.
+// Panics on error.
+func (o *User) AddUserSessionsP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserSession) {
+	if err := o.AddUserSessions(ctx, exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddUserSessions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserSessions.
+// Sets related.R.User appropriately.
+func (o *User) AddUserSessions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserSession) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_sessions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userSessionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UserSessions: related,
+		}
+	} else {
+		o.R.UserSessions = append(o.R.UserSessions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userSessionR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // AddCreatedUserUserSignupRequestsP adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
 // Appends related to o.R.CreatedUserUserSignupRequests.
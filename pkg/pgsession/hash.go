@@ -1,13 +1,161 @@
 package pgsession
 
 import (
+	"crypto/rand"
 	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
 	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/argon2"
+)
+
+// argon2id parameters, the hashes made with anything else
+// are upgraded on the next successful login
+const (
+	argonVersion = argon2.Version
+	argonTime    = 3
+	argonMemory  = 64 * 1024
+	argonThreads = 2
+	argonKeyLen  = 32
+	argonSaltLen = 16
 )
 
-func HashUserPwd(email, password string) string {
+const argonPrefix = "$argon2id$"
+
+// LegacyHashCutoff is the moment unsalted sha256 hashes stop being accepted,
+// the users who haven't logged in since the upgrade have to reset the password
+var LegacyHashCutoff = time.Date(2027, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+var ErrPasswordExpired = errors.New("password has expired")
+
+type argonParams struct {
+	version uint32
+	time    uint32
+	memory  uint32
+	threads uint8
+}
+
+var currentParams = argonParams{
+	version: argonVersion,
+	time:    argonTime,
+	memory:  argonMemory,
+	threads: argonThreads,
+}
+
+// HashPassword returns the argon2id hash of the password in the PHC
+// string format, the salt and the parameters are stored along with it
+func HashPassword(password string) (string, error) {
+	salt := make([]byte, argonSaltLen)
+
+	if _, err := rand.Read(salt); err != nil {
+		return "", errors.Wrap(err, "failed to generate salt")
+	}
+
+	return encodeHash(currentParams, salt, deriveKey(password, currentParams, salt, argonKeyLen)), nil
+}
+
+// CheckPassword compares the password with the stored hash in constant time.
+// The second returned value tells whether the hash should be replaced
+// with the fresh one. Legacy hashes are salted with the email
+func CheckPassword(email string, password string, hash string) (bool, bool, error) {
+	if !strings.HasPrefix(hash, argonPrefix) {
+		if hash == "" {
+			return false, false, nil
+		}
+
+		matches := subtle.ConstantTimeCompare([]byte(legacyHash(email, password)), []byte(hash)) == 1
+
+		// only the owner of the password learns that it has expired,
+		// anybody else should not be able to tell the account apart
+		if matches && time.Now().After(LegacyHashCutoff) {
+			return false, false, ErrPasswordExpired
+		}
+
+		return matches, matches, nil
+	}
+
+	params, salt, key, err := decodeHash(hash)
+
+	if err != nil {
+		return false, false, err
+	}
+
+	computed := deriveKey(password, params, salt, uint32(len(key)))
+
+	if subtle.ConstantTimeCompare(computed, key) != 1 {
+		return false, false, nil
+	}
+
+	return true, params != currentParams || len(key) != argonKeyLen, nil
+}
+
+func deriveKey(password string, params argonParams, salt []byte, keyLen uint32) []byte {
+	return argon2.IDKey([]byte(password), salt, params.time, params.memory, params.threads, keyLen)
+}
+
+func encodeHash(params argonParams, salt []byte, key []byte) string {
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argonPrefix,
+		params.version,
+		params.memory,
+		params.time,
+		params.threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	)
+}
+
+func decodeHash(hash string) (argonParams, []byte, []byte, error) {
+	var params argonParams
+
+	// "", "argon2id", version, params, salt, key
+	parts := strings.Split(hash, "$")
+
+	if len(parts) != 6 {
+		return params, nil, nil, errors.Errorf("malformed password hash")
+	}
+
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &params.version); err != nil {
+		return params, nil, nil, errors.Wrap(err, "malformed password hash version")
+	}
+
+	if params.version != argon2.Version {
+		return params, nil, nil, errors.Errorf("unsupported argon2 version %d", params.version)
+	}
+
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.time, &params.threads); err != nil {
+		return params, nil, nil, errors.Wrap(err, "malformed password hash parameters")
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+
+	if err != nil {
+		return params, nil, nil, errors.Wrap(err, "malformed password hash salt")
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+
+	if err != nil || len(key) == 0 {
+		return params, nil, nil, errors.Errorf("malformed password hash key")
+	}
+
+	return params, salt, key, nil
+}
+
+// legacyHash is how the passwords used to be stored, it's
+// only used to check them before the upgrade
+func legacyHash(email, password string) string {
 	data := []byte(email + ":" + password)
 	hash := sha256.Sum256(data)
 
 	return fmt.Sprintf("%x", hash)
 }
+
+// IsLegacyHash tells whether the hash depends on the email of the user
+func IsLegacyHash(hash string) bool {
+	return hash != "" && !strings.HasPrefix(hash, argonPrefix)
+}
@@ -0,0 +1,74 @@
+package pgsession
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckPassword(t *testing.T) {
+	hash, err := HashPassword("secret")
+	require.NoError(t, err)
+
+	other, err := HashPassword("secret")
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, other, "every hash gets its own salt")
+
+	ok, rehash, err := CheckPassword("user@example.com", "secret", hash)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.False(t, rehash)
+
+	ok, _, err = CheckPassword("user@example.com", "wrong", hash)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, _, err = CheckPassword("user@example.com", "secret", "")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, _, err = CheckPassword("user@example.com", "secret", "$argon2id$broken")
+	assert.Error(t, err)
+}
+
+func TestCheckPasswordOutdatedParams(t *testing.T) {
+	params := argonParams{version: argonVersion, time: 1, memory: 1024, threads: 1}
+	salt := []byte("0123456789abcdef")
+
+	hash := encodeHash(params, salt, deriveKey("secret", params, salt, argonKeyLen))
+
+	ok, rehash, err := CheckPassword("user@example.com", "secret", hash)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, rehash)
+	assert.False(t, IsLegacyHash(hash))
+}
+
+func TestCheckPasswordLegacy(t *testing.T) {
+	hash := legacyHash("user@example.com", "secret")
+	assert.True(t, IsLegacyHash(hash))
+
+	ok, rehash, err := CheckPassword("user@example.com", "secret", hash)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, rehash)
+
+	ok, rehash, err = CheckPassword("other@example.com", "secret", hash)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.False(t, rehash)
+
+	cutoff := LegacyHashCutoff
+	LegacyHashCutoff = time.Now().Add(-time.Hour)
+	defer func() { LegacyHashCutoff = cutoff }()
+
+	_, _, err = CheckPassword("user@example.com", "secret", hash)
+	assert.ErrorIs(t, err, ErrPasswordExpired)
+
+	ok, rehash, err = CheckPassword("user@example.com", "wrong", hash)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.False(t, rehash)
+}
@@ -1,13 +1,31 @@
 package pgsession
 
 import (
+	"context"
+	"time"
+
 	"github.com/antonlindstrom/pgstore"
 	"github.com/gin-contrib/sessions"
+	"github.com/gorilla/securecookie"
 	"github.com/jmoiron/sqlx"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
+// SessionName is the name of the cookie and of the encoded
+// session values in the store
+const SessionName = "sess"
+
 type Store interface {
 	sessions.Store
+	Sessions(ctx context.Context, exec boil.ContextExecutor) ([]*StoredSession, error)
+	DeleteSessions(ctx context.Context, exec boil.ContextExecutor, keys []string) error
+}
+
+// StoredSession is the decoded session the way the store keeps it
+type StoredSession struct {
+	Key        string
+	Values     map[any]any
+	ModifiedOn time.Time
 }
 
 func NewStore(db *sqlx.DB, keyPairs ...[]byte) Store {
@@ -27,3 +45,52 @@ type store struct {
 func (c *store) Options(options sessions.Options) {
 	c.PGStore.Options = options.ToGorillaOptions()
 }
+
+// Sessions returns all the active sessions. Values are encrypted, which leaves
+// no other option but to go through all of them, it's not meant for the requests
+func (c *store) Sessions(ctx context.Context, exec boil.ContextExecutor) ([]*StoredSession, error) {
+	rows, err := exec.QueryContext(ctx, "select key, data, coalesce(modified_on, created_on) from http_sessions where expires_on > now()")
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var out []*StoredSession
+
+	for rows.Next() {
+		var key, data string
+		var modifiedOn time.Time
+
+		if err := rows.Scan(&key, &data, &modifiedOn); err != nil {
+			return nil, err
+		}
+
+		values := map[any]any{}
+
+		// the sessions encoded with the old keys are unusable anyway
+		if err := securecookie.DecodeMulti(SessionName, data, &values, c.Codecs...); err != nil {
+			continue
+		}
+
+		out = append(out, &StoredSession{
+			Key:        key,
+			Values:     values,
+			ModifiedOn: modifiedOn,
+		})
+	}
+
+	return out, rows.Err()
+}
+
+// DeleteSessions removes the sessions with the given ids
+func (c *store) DeleteSessions(ctx context.Context, exec boil.ContextExecutor, keys []string) error {
+	for _, key := range keys {
+		if _, err := exec.ExecContext(ctx, "delete from http_sessions where key = convert_to($1, 'UTF8')", key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package postops
+
+import (
+	"context"
+
+	"github.com/can3p/pcom/pkg/markdown"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/samber/lo"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// ImagesMissingAltText lists the images in the markdown that have no alt text,
+// neither inline nor as a default one in the media library
+func ImagesMissingAltText(ctx context.Context, exec boil.ContextExecutor, body string) ([]string, error) {
+	urls := lo.Uniq(markdown.ExtractImageUrlsWithoutAltText(body))
+
+	if len(urls) == 0 {
+		return nil, nil
+	}
+
+	described, err := core.MediaUploads(
+		core.MediaUploadWhere.UploadedFname.IN(urls),
+		core.MediaUploadWhere.AltText.IsNotNull(),
+	).All(ctx, exec)
+
+	if err != nil {
+		return nil, err
+	}
+
+	describedFnames := lo.SliceToMap(described, func(u *core.MediaUpload) (string, struct{}) {
+		return u.UploadedFname, struct{}{}
+	})
+
+	return lo.Filter(urls, func(url string, idx int) bool {
+		_, ok := describedFnames[url]
+		return !ok
+	}), nil
+}
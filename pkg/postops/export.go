@@ -26,6 +26,7 @@ const (
 	OriginalID  ExportField = "original_id"
 	Subject     ExportField = "subject"
 	Url         ExportField = "url"
+	RSSItem     ExportField = "rss_item"
 	Visibility  ExportField = "visibility"
 	PublishDate ExportField = "published"
 )
@@ -41,6 +42,9 @@ func SerializePost(post *core.Post) []byte {
 	if post.URLID.Valid {
 		fmt.Fprintf(&buf, "%s: %s\n", Url, post.R.URL.URL)
 	}
+	if post.RSSItemID.Valid {
+		fmt.Fprintf(&buf, "%s: %s\n", RSSItem, post.RSSItemID.String)
+	}
 	fmt.Fprintf(&buf, "%s: %s\n", Visibility, post.VisibilityRadius.String())
 	if post.PublishedAt.Valid {
 		fmt.Fprintf(&buf, "%s: %s\n", PublishDate, post.PublishedAt.Time.Format(time.RFC3339))
@@ -102,7 +106,7 @@ func SerializeBlogSlice(ctx context.Context, posts []*core.Post, mediaStorage se
 			return nil, err
 		}
 
-		parsed := markdown.Parse(p.Body, types.ViewSinglePost, nil, nil)
+		parsed := markdown.Parse(p.Body, types.ViewSinglePost, nil, nil, nil, nil)
 
 		extracted := parsed.ExtractImageUrls()
 
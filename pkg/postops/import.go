@@ -6,7 +6,6 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"net/http"
 	"regexp"
 	"strings"
 	"time"
@@ -67,7 +66,8 @@ func parseExportedPost(b []byte) (map[string]string, string, error) {
 }
 
 type AdditionalFields struct {
-	URL string
+	URL       string
+	RSSItemID string
 }
 
 type PostWithMeta struct {
@@ -88,6 +88,14 @@ func DeserializePost(b []byte) (*core.Post, *AdditionalFields, error) {
 
 	var additionalFields *AdditionalFields
 
+	additional := func() *AdditionalFields {
+		if additionalFields == nil {
+			additionalFields = &AdditionalFields{}
+		}
+
+		return additionalFields
+	}
+
 	for name, value := range headers {
 		switch name {
 		case string(OriginalID):
@@ -101,7 +109,13 @@ func DeserializePost(b []byte) (*core.Post, *AdditionalFields, error) {
 		case string(Subject):
 			post.Subject = null.NewString(value, value != "")
 		case string(Url):
-			additionalFields = &AdditionalFields{URL: value}
+			additional().URL = value
+		case string(RSSItem):
+			if _, err := uuid.Parse(value); err != nil {
+				return nil, nil, errors.Errorf("Invalid rss item")
+			}
+
+			additional().RSSItemID = value
 		case string(Visibility):
 			vis := core.PostVisibility(value)
 
@@ -178,9 +192,7 @@ func DeserializeArchive(b []byte) ([]*PostWithMeta, map[string][]byte, error) {
 			})
 		}
 
-		ftype := http.DetectContentType(b)
-
-		if ftype == "image/png" || ftype == "image/jpeg" {
+		if _, err := media.ValidateImageType(media.DetectContentType(b)); err == nil {
 			images[fname] = b
 		}
 	}
@@ -223,7 +235,7 @@ func InjectPostsInDB(ctx context.Context, exec boil.ContextExecutor, mediaStorag
 	}
 
 	for name, b := range images {
-		fname, err := media.HandleUpload(ctx, exec, mediaStorage, &userID, nil, bytes.NewReader(b))
+		fname, err := media.HandleUpload(ctx, exec, mediaStorage, &userID, nil, nil, bytes.NewReader(b))
 
 		if err != nil {
 			return nil, err
@@ -281,6 +293,20 @@ func InjectPostsInDB(ctx context.Context, exec boil.ContextExecutor, mediaStorag
 			p.URLID = null.StringFrom(url.ID)
 		}
 
+		// rss items are shared between the users, the link
+		// survives only in case the item is still there
+		if postWithMeta.Additional != nil && postWithMeta.Additional.RSSItemID != "" {
+			exists, err := core.RSSItemExists(ctx, exec, postWithMeta.Additional.RSSItemID)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if exists {
+				p.RSSItemID = null.StringFrom(postWithMeta.Additional.RSSItemID)
+			}
+		}
+
 		if insertPost {
 			if err := p.Insert(ctx, exec, boil.Infer()); err != nil {
 				return nil, err
@@ -292,6 +318,14 @@ func InjectPostsInDB(ctx context.Context, exec boil.ContextExecutor, mediaStorag
 			}
 			stats.PostsUpdated++
 		}
+
+		if err := RequestLinkPreviews(ctx, exec, p); err != nil {
+			return nil, err
+		}
+
+		if err := SyncPostMediaReferences(ctx, exec, p); err != nil {
+			return nil, err
+		}
 	}
 
 	return stats, nil
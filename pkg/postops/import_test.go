@@ -86,6 +86,38 @@ with some *markdown* in it`,
 				URL: "https://test.url",
 			},
 		},
+		{
+			name: "post with rss item",
+			content: `---
+original_id: 018f45ef-b63a-7426-a444-3957146ca700
+rss_item: 018f45ef-b63a-7426-a444-3957146ca701
+url: https://test.url
+published: 2024-05-04T23:28:08Z
+visibility: direct_only
+---
+
+This is a test *post*`,
+			post: &core.Post{
+				ID:               "018f45ef-b63a-7426-a444-3957146ca700",
+				Body:             `This is a test *post*`,
+				PublishedAt:      null.TimeFrom(time.Date(2024, time.May, 4, 23, 28, 8, 0, time.UTC)),
+				VisibilityRadius: core.PostVisibilityDirectOnly,
+			},
+			additionalFields: &AdditionalFields{
+				URL:       "https://test.url",
+				RSSItemID: "018f45ef-b63a-7426-a444-3957146ca701",
+			},
+		},
+		{
+			name: "post with invalid rss item",
+			content: `---
+original_id: 018f45ef-b63a-7426-a444-3957146ca700
+rss_item: not an id
+---
+
+This is a test *post*`,
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -157,6 +189,31 @@ with some *markdown* in it`,
 				URL: "https://example.com",
 			},
 		},
+		{
+			name: "post about rss item",
+			post: func() *core.Post {
+				p := &core.Post{
+					ID:               uuid.NewString(),
+					Subject:          null.StringFrom("test subject with rss item"),
+					Body:             `This is a test *post* about rss item`,
+					PublishedAt:      null.TimeFrom(time.Date(2025, time.January, 3, 1, 46, 49, 0, time.UTC)),
+					VisibilityRadius: core.PostVisibilityDirectOnly,
+					URLID:            null.StringFrom("test-url-id"),
+					RSSItemID:        null.StringFrom("018f45ef-b63a-7426-a444-3957146ca701"),
+				}
+
+				p.R = p.R.NewStruct()
+				p.R.URL = &core.NormalizedURL{
+					URL: "https://example.com",
+				}
+
+				return p
+			}(),
+			additionalFields: &AdditionalFields{
+				URL:       "https://example.com",
+				RSSItemID: "018f45ef-b63a-7426-a444-3957146ca701",
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -171,8 +228,9 @@ with some *markdown* in it`,
 
 			assert.NoError(t, err)
 			tc.post.R = nil
-			// url id is never filled in in imported post
+			// url id and rss item id are never filled in in imported post
 			tc.post.URLID = null.String{}
+			tc.post.RSSItemID = null.String{}
 			assert.Equal(t, tc.post, imported)
 			assert.Equal(t, tc.additionalFields, additionalFields)
 		})
@@ -0,0 +1,63 @@
+package postops
+
+import (
+	"context"
+
+	"github.com/can3p/pcom/pkg/markdown"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/pkg/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// RequestLinkPreviews queues the previews for the url of the post and for
+// the links that take the whole paragraph, since these are shown as cards.
+// Every url is fetched only once, no matter how many posts link it
+func RequestLinkPreviews(ctx context.Context, exec boil.ContextExecutor, post *core.Post) error {
+	var urlIDs []string
+
+	if post.URLID.Valid {
+		urlIDs = append(urlIDs, post.URLID.String)
+	}
+
+	for _, link := range lo.Uniq(markdown.ExtractStandaloneLinks(post.Body)) {
+		// whatever we cannot normalize cannot be previewed either
+		if _, err := util.NormalizeURL(link); err != nil {
+			continue
+		}
+
+		stored, err := StoreURL(ctx, exec, link)
+
+		if err != nil {
+			return err
+		}
+
+		urlIDs = append(urlIDs, stored.ID)
+	}
+
+	for _, urlID := range lo.Uniq(urlIDs) {
+		if err := RequestLinkPreview(ctx, exec, urlID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RequestLinkPreview queues the preview unless it's there already
+func RequestLinkPreview(ctx context.Context, exec boil.ContextExecutor, urlID string) error {
+	id, err := uuid.NewV7()
+
+	if err != nil {
+		return err
+	}
+
+	preview := &core.LinkPreview{
+		ID:     id.String(),
+		URLID:  urlID,
+		Status: core.LinkPreviewStatusPending,
+	}
+
+	return preview.Upsert(ctx, exec, false, []string{core.LinkPreviewColumns.URLID}, boil.None(), boil.Infer())
+}
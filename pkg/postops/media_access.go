@@ -0,0 +1,168 @@
+package postops
+
+import (
+	"context"
+
+	"github.com/can3p/pcom/pkg/markdown"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/pkg/userops"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type MediaAccess int
+
+const (
+	MediaAccessDenied MediaAccess = iota
+	// the viewer is allowed to see the file, but not everybody is
+	MediaAccessPrivate
+	// the file is referenced by at least one public post
+	MediaAccessPublic
+)
+
+// GetMediaAccess decides whether the viewer is allowed to see the uploaded file.
+// The file is visible in case the viewer can see at least one post or comment
+// that references it. Empty viewer id means an anonymous visitor.
+// sql.ErrNoRows is returned for unknown files
+func GetMediaAccess(ctx context.Context, exec boil.ContextExecutor, viewerID string, fname string) (MediaAccess, error) {
+	upload, err := core.MediaUploads(
+		core.MediaUploadWhere.UploadedFname.EQ(fname),
+	).One(ctx, exec)
+
+	if err != nil {
+		return MediaAccessDenied, err
+	}
+
+	// link previews show what the page itself shows to anyone
+	if upload.URLID.Valid {
+		return MediaAccessPublic, nil
+	}
+
+	refs, err := core.MediaReferences(
+		core.MediaReferenceWhere.Fname.EQ(fname),
+		qm.Load(core.MediaReferenceRels.Post),
+	).All(ctx, exec)
+
+	if err != nil {
+		return MediaAccessDenied, err
+	}
+
+	// comments are shown to the direct connections only,
+	// even under the public posts
+	for _, ref := range refs {
+		p := ref.R.Post
+
+		if !ref.CommentID.Valid && p.PublishedAt.Valid && p.VisibilityRadius == core.PostVisibilityPublic {
+			return MediaAccessPublic, nil
+		}
+	}
+
+	if viewerID == "" {
+		return MediaAccessDenied, nil
+	}
+
+	if upload.UserID.String == viewerID {
+		return MediaAccessPrivate, nil
+	}
+
+	if upload.RSSFeedID.Valid {
+		subscribed, err := core.UserFeedSubscriptions(
+			core.UserFeedSubscriptionWhere.UserID.EQ(viewerID),
+			core.UserFeedSubscriptionWhere.FeedID.EQ(upload.RSSFeedID.String),
+		).Exists(ctx, exec)
+
+		if err != nil {
+			return MediaAccessDenied, err
+		}
+
+		if subscribed {
+			return MediaAccessPrivate, nil
+		}
+	}
+
+	radiusCache := map[string]userops.ConnectionRadius{}
+
+	getRadius := func(authorID string) (userops.ConnectionRadius, error) {
+		if radius, ok := radiusCache[authorID]; ok {
+			return radius, nil
+		}
+
+		radius, err := userops.GetConnectionRadius(ctx, exec, viewerID, authorID)
+
+		if err != nil {
+			return userops.ConnectionRadiusUnknown, err
+		}
+
+		radiusCache[authorID] = radius
+
+		return radius, nil
+	}
+
+	for _, ref := range refs {
+		post := ref.R.Post
+
+		if !post.PublishedAt.Valid {
+			continue
+		}
+
+		radius, err := getRadius(post.UserID)
+
+		if err != nil {
+			return MediaAccessDenied, err
+		}
+
+		if !CanSeePost(post, radius) {
+			continue
+		}
+
+		if !ref.CommentID.Valid || GetPostCapabilities(radius).CanViewComments {
+			return MediaAccessPrivate, nil
+		}
+	}
+
+	return MediaAccessDenied, nil
+}
+
+// SyncPostMediaReferences stores the files the body of the post refers to,
+// it has to be called every time the body changes
+func SyncPostMediaReferences(ctx context.Context, exec boil.ContextExecutor, post *core.Post) error {
+	if _, err := core.MediaReferences(
+		core.MediaReferenceWhere.PostID.EQ(post.ID),
+		core.MediaReferenceWhere.CommentID.IsNull(),
+	).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+
+	return insertMediaReferences(ctx, exec, post.ID, null.String{}, post.Body)
+}
+
+// SyncCommentMediaReferences does the same as SyncPostMediaReferences for the comment
+func SyncCommentMediaReferences(ctx context.Context, exec boil.ContextExecutor, comment *core.PostComment) error {
+	if _, err := core.MediaReferences(
+		core.MediaReferenceWhere.CommentID.EQ(null.StringFrom(comment.ID)),
+	).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+
+	return insertMediaReferences(ctx, exec, comment.PostID, null.StringFrom(comment.ID), comment.Body)
+}
+
+func insertMediaReferences(ctx context.Context, exec boil.ContextExecutor, postID string, commentID null.String, body string) error {
+	for _, fname := range lo.Uniq(markdown.ExtractMediaUrls(body)) {
+		ref := &core.MediaReference{
+			ID:        uuid.NewString(),
+			Fname:     fname,
+			PostID:    postID,
+			CommentID: commentID,
+		}
+
+		if err := ref.Insert(ctx, exec, boil.Infer()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package postops
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/can3p/pcom/pkg/feedops/testutil"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/testcontainers/postgres"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestGetMediaAccess(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+	exec := testDB.DB
+
+	author, err := testutil.CreateUser(ctx, exec, "author@example.com")
+	require.NoError(t, err)
+	friend, err := testutil.CreateUser(ctx, exec, "friend@example.com")
+	require.NoError(t, err)
+	stranger, err := testutil.CreateUser(ctx, exec, "stranger@example.com")
+	require.NoError(t, err)
+
+	for _, pair := range [][2]string{{author.ID, friend.ID}, {friend.ID, author.ID}} {
+		conn := &core.UserConnection{ID: uuid.NewString(), User1ID: pair[0], User2ID: pair[1]}
+		require.NoError(t, conn.Insert(ctx, exec, boil.Infer()))
+	}
+
+	upload := func(ownerID null.String, feedID null.String) string {
+		u := &core.MediaUpload{
+			ID:            uuid.NewString(),
+			UserID:        ownerID,
+			RSSFeedID:     feedID,
+			UploadedFname: uuid.NewString() + ".jpg",
+			ContentType:   "image/jpeg",
+			Kind:          core.MediaKindImage,
+		}
+		require.NoError(t, u.Insert(ctx, exec, boil.Infer()))
+
+		return u.UploadedFname
+	}
+
+	createPost := func(visibility core.PostVisibility, published bool, body string) *core.Post {
+		p := &core.Post{
+			ID:               uuid.NewString(),
+			Subject:          null.StringFrom("test"),
+			Body:             body,
+			UserID:           author.ID,
+			VisibilityRadius: visibility,
+			PublishedAt:      null.NewTime(time.Now(), published),
+		}
+		require.NoError(t, p.Insert(ctx, exec, boil.Infer()))
+		require.NoError(t, SyncPostMediaReferences(ctx, exec, p))
+
+		return p
+	}
+
+	checkAccess := func(fname string, expected map[string]MediaAccess) {
+		t.Helper()
+
+		for viewerID, access := range expected {
+			got, err := GetMediaAccess(ctx, exec, viewerID, fname)
+			require.NoError(t, err)
+			assert.Equal(t, access, got, "viewer %q", viewerID)
+		}
+	}
+
+	t.Run("draft", func(t *testing.T) {
+		fname := upload(null.StringFrom(author.ID), null.String{})
+		createPost(core.PostVisibilityPublic, false, "![]("+fname+")")
+
+		checkAccess(fname, map[string]MediaAccess{
+			"":          MediaAccessDenied,
+			friend.ID:   MediaAccessDenied,
+			stranger.ID: MediaAccessDenied,
+			author.ID:   MediaAccessPrivate,
+		})
+	})
+
+	t.Run("direct only", func(t *testing.T) {
+		fname := upload(null.StringFrom(author.ID), null.String{})
+		post := createPost(core.PostVisibilityDirectOnly, true, "![]("+fname+")")
+
+		checkAccess(fname, map[string]MediaAccess{
+			"":          MediaAccessDenied,
+			friend.ID:   MediaAccessPrivate,
+			stranger.ID: MediaAccessDenied,
+		})
+
+		// the reference goes away together with the file in the body
+		post.Body = "nothing to see"
+		_, err := post.Update(ctx, exec, boil.Infer())
+		require.NoError(t, err)
+		require.NoError(t, SyncPostMediaReferences(ctx, exec, post))
+
+		checkAccess(fname, map[string]MediaAccess{
+			friend.ID: MediaAccessDenied,
+		})
+	})
+
+	t.Run("public", func(t *testing.T) {
+		fname := upload(null.StringFrom(author.ID), null.String{})
+		createPost(core.PostVisibilityPublic, true, "![]("+fname+")")
+
+		checkAccess(fname, map[string]MediaAccess{
+			"":          MediaAccessPublic,
+			stranger.ID: MediaAccessPublic,
+		})
+	})
+
+	t.Run("comment", func(t *testing.T) {
+		fname := upload(null.StringFrom(friend.ID), null.String{})
+		post := createPost(core.PostVisibilityPublic, true, "no files here")
+
+		commentID := uuid.NewString()
+		comment := &core.PostComment{
+			ID:           commentID,
+			UserID:       null.StringFrom(friend.ID),
+			PostID:       post.ID,
+			Body:         "![](" + fname + ")",
+			TopCommentID: commentID,
+		}
+		require.NoError(t, comment.Insert(ctx, exec, boil.Infer()))
+		require.NoError(t, SyncCommentMediaReferences(ctx, exec, comment))
+
+		// everybody can see the post, but only the connections see the comments
+		checkAccess(fname, map[string]MediaAccess{
+			"":          MediaAccessDenied,
+			stranger.ID: MediaAccessDenied,
+			author.ID:   MediaAccessPrivate,
+		})
+	})
+
+	t.Run("rss subscriber", func(t *testing.T) {
+		feed, err := testutil.CreateRSSFeed(ctx, exec, "https://example.com/feed", "Feed")
+		require.NoError(t, err)
+
+		_, err = testutil.CreateUserFeedSubscription(ctx, exec, stranger.ID, feed.ID)
+		require.NoError(t, err)
+
+		fname := upload(null.String{}, null.StringFrom(feed.ID))
+
+		checkAccess(fname, map[string]MediaAccess{
+			"":          MediaAccessDenied,
+			stranger.ID: MediaAccessPrivate,
+			friend.ID:   MediaAccessDenied,
+		})
+	})
+}
@@ -0,0 +1,189 @@
+package postops
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	"github.com/can3p/gogo/util/transact"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/lo"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// MediaOrphanGracePeriod gives users time to finish the post they
+// upload the media for before unreferenced uploads get collected
+const MediaOrphanGracePeriod = 7 * 24 * time.Hour
+
+const collectMediaEvery = time.Hour
+
+// MediaDeleter removes the file from the storage along with
+// everything derived from it
+type MediaDeleter func(ctx context.Context, fname string) error
+
+type MediaReference struct {
+	PostID    string
+	Subject   string
+	CommentID string
+}
+
+type MediaLibraryItem struct {
+	Upload     *core.MediaUpload
+	References []*MediaReference
+}
+
+// GetMediaLibrary lists all the uploads of the user, newest first, along
+// with the posts and comments of the user that reference them
+func GetMediaLibrary(ctx context.Context, exec boil.ContextExecutor, userID string) ([]*MediaLibraryItem, error) {
+	uploads, err := core.MediaUploads(
+		core.MediaUploadWhere.UserID.EQ(null.StringFrom(userID)),
+		qm.OrderBy(fmt.Sprintf("%s DESC", core.MediaUploadColumns.CreatedAt)),
+	).All(ctx, exec)
+
+	if err != nil {
+		return nil, err
+	}
+
+	references, err := getMediaReferences(ctx, exec, userID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(uploads, func(u *core.MediaUpload, idx int) *MediaLibraryItem {
+		return &MediaLibraryItem{
+			Upload:     u,
+			References: references[u.UploadedFname],
+		}
+	}), nil
+}
+
+// ownMediaReferenceClause keeps the references from the posts and comments
+// of the uploader, the others should not keep the file around
+const ownMediaReferenceClause = `(media_references.comment_id is null and p.user_id = %[1]s) or c.user_id = %[1]s`
+
+// getMediaReferences returns the references to the media from the
+// posts and comments of the user keyed by the file name
+func getMediaReferences(ctx context.Context, exec boil.ContextExecutor, userID string) (map[string][]*MediaReference, error) {
+	refs, err := core.MediaReferences(
+		qm.InnerJoin("posts p on p.id = media_references.post_id"),
+		qm.LeftOuterJoin("post_comments c on c.id = media_references.comment_id"),
+		qm.Where(fmt.Sprintf(ownMediaReferenceClause, "?"), userID, userID),
+		qm.Load(core.MediaReferenceRels.Post),
+		qm.OrderBy("media_references.post_id DESC, media_references.comment_id DESC"),
+	).All(ctx, exec)
+
+	if err != nil {
+		return nil, err
+	}
+
+	out := map[string][]*MediaReference{}
+
+	for _, ref := range refs {
+		out[ref.Fname] = append(out[ref.Fname], &MediaReference{
+			PostID:    ref.PostID,
+			Subject:   PostSubject(ref.R.Post.Subject),
+			CommentID: ref.CommentID.String,
+		})
+	}
+
+	return out, nil
+}
+
+// DeleteMedia removes the upload of the user. The row goes first,
+// since a file without the row is harmless, it cannot be served anyway
+func DeleteMedia(ctx context.Context, db *sqlx.DB, deleteFile MediaDeleter, userID string, fname string) error {
+	var upload *core.MediaUpload
+
+	err := transact.Transact(db, func(tx *sql.Tx) error {
+		var err error
+
+		upload, err = core.MediaUploads(
+			core.MediaUploadWhere.UserID.EQ(null.StringFrom(userID)),
+			core.MediaUploadWhere.UploadedFname.EQ(fname),
+		).One(ctx, tx)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = upload.Delete(ctx, tx)
+
+		return err
+	})
+
+	if err != nil {
+		return err
+	}
+
+	// clips come with the files made by the transcoder
+	for _, derived := range []null.String{upload.RenditionFname, upload.PosterFname} {
+		if !derived.Valid {
+			continue
+		}
+
+		if err := deleteFile(ctx, derived.String); err != nil {
+			return err
+		}
+	}
+
+	return deleteFile(ctx, fname)
+}
+
+// CollectOrphanMedia deletes uploads that are not referenced by any post
+// or comment of the uploader once the grace period is over
+func CollectOrphanMedia(ctx context.Context, db *sqlx.DB, deleteFile MediaDeleter, now time.Time) (err error) {
+	// a single broken file should never crash the scheduler
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = fmt.Errorf("CollectOrphanMedia panicked: %v - %s", panicErr, string(debug.Stack()))
+		}
+	}()
+
+	uploads, err := core.MediaUploads(
+		core.MediaUploadWhere.UserID.IsNotNull(),
+		core.MediaUploadWhere.CreatedAt.LT(now.Add(-MediaOrphanGracePeriod)),
+		qm.Where(fmt.Sprintf(`not exists (
+			select 1 from media_references
+			join posts p on p.id = media_references.post_id
+			left join post_comments c on c.id = media_references.comment_id
+			where media_references.fname = media_uploads.uploaded_fname and (%s)
+		)`, fmt.Sprintf(ownMediaReferenceClause, "media_uploads.user_id"))),
+	).All(ctx, db)
+
+	if err != nil {
+		return err
+	}
+
+	for _, upload := range uploads {
+		if err := DeleteMedia(ctx, db, deleteFile, upload.UserID.String, upload.UploadedFname); err != nil {
+			slog.Warn("Failed to delete orphan media", "fname", upload.UploadedFname, "err", err.Error())
+			continue
+		}
+
+		slog.Info("Deleted orphan media", "fname", upload.UploadedFname, "user_id", upload.UserID.String)
+	}
+
+	return nil
+}
+
+func RunMediaCollector(ctx context.Context, db *sqlx.DB, deleteFile MediaDeleter) {
+	ticker := time.NewTicker(collectMediaEvery)
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := CollectOrphanMedia(ctx, db, deleteFile, time.Now()); err != nil {
+				slog.Warn("Failed to CollectOrphanMedia", "err", err.Error())
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
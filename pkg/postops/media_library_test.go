@@ -0,0 +1,151 @@
+package postops
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/can3p/pcom/pkg/feedops/testutil"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/testcontainers/postgres"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestMediaLibrary(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+	db := testDB.DB
+
+	author, err := testutil.CreateUser(ctx, db, "author@example.com")
+	require.NoError(t, err)
+	other, err := testutil.CreateUser(ctx, db, "other@example.com")
+	require.NoError(t, err)
+
+	var deleted []string
+
+	deleteFile := func(ctx context.Context, fname string) error {
+		deleted = append(deleted, fname)
+		return nil
+	}
+
+	upload := func(ownerID string, createdAt time.Time) *core.MediaUpload {
+		u := &core.MediaUpload{
+			ID:            uuid.NewString(),
+			UserID:        null.StringFrom(ownerID),
+			UploadedFname: uuid.NewString() + ".jpg",
+			ContentType:   "image/jpeg",
+			Kind:          core.MediaKindImage,
+			CreatedAt:     createdAt,
+		}
+		require.NoError(t, u.Insert(ctx, db, boil.Infer()))
+
+		return u
+	}
+
+	createPost := func(userID string, body string) *core.Post {
+		p := &core.Post{
+			ID:               uuid.NewString(),
+			Subject:          null.StringFrom("test"),
+			Body:             body,
+			UserID:           userID,
+			VisibilityRadius: core.PostVisibilityDirectOnly,
+		}
+		require.NoError(t, p.Insert(ctx, db, boil.Infer()))
+		require.NoError(t, SyncPostMediaReferences(ctx, db, p))
+
+		return p
+	}
+
+	createComment := func(userID string, post *core.Post, body string) *core.PostComment {
+		commentID := uuid.NewString()
+		comment := &core.PostComment{
+			ID:           commentID,
+			UserID:       null.StringFrom(userID),
+			PostID:       post.ID,
+			Body:         body,
+			TopCommentID: commentID,
+		}
+		require.NoError(t, comment.Insert(ctx, db, boil.Infer()))
+		require.NoError(t, SyncCommentMediaReferences(ctx, db, comment))
+
+		return comment
+	}
+
+	exists := func(u *core.MediaUpload) bool {
+		ok, err := core.MediaUploadExists(ctx, db, u.ID)
+		require.NoError(t, err)
+
+		return ok
+	}
+
+	now := time.Now()
+	old := now.Add(-MediaOrphanGracePeriod - time.Hour)
+
+	inPost := upload(author.ID, old)
+	inComment := upload(author.ID, old)
+	inOthersPost := upload(author.ID, old)
+	orphan := upload(author.ID, old)
+	fresh := upload(author.ID, now)
+
+	post := createPost(author.ID, "![]("+inPost.UploadedFname+")")
+	otherPost := createPost(other.ID, "![]("+inOthersPost.UploadedFname+")")
+	comment := createComment(author.ID, otherPost, "![]("+inComment.UploadedFname+")")
+
+	t.Run("library", func(t *testing.T) {
+		items, err := GetMediaLibrary(ctx, db, author.ID)
+		require.NoError(t, err)
+
+		references := map[string][]*MediaReference{}
+
+		for _, item := range items {
+			references[item.Upload.UploadedFname] = item.References
+		}
+
+		assert.Len(t, references, 5)
+		assert.Equal(t, []*MediaReference{{PostID: post.ID, Subject: "test"}}, references[inPost.UploadedFname])
+		assert.Equal(t, []*MediaReference{{PostID: otherPost.ID, Subject: "test", CommentID: comment.ID}}, references[inComment.UploadedFname])
+		assert.Empty(t, references[inOthersPost.UploadedFname], "only the posts of the uploader count")
+		assert.Empty(t, references[orphan.UploadedFname])
+	})
+
+	t.Run("collect orphans", func(t *testing.T) {
+		deleted = nil
+
+		require.NoError(t, CollectOrphanMedia(ctx, db, deleteFile, now))
+
+		assert.True(t, exists(inPost))
+		assert.True(t, exists(inComment))
+		assert.True(t, exists(fresh), "the upload is within the grace period")
+		assert.False(t, exists(orphan))
+		assert.False(t, exists(inOthersPost), "the posts of the others do not keep the file")
+		assert.ElementsMatch(t, []string{orphan.UploadedFname, inOthersPost.UploadedFname}, deleted)
+	})
+
+	t.Run("delete referenced", func(t *testing.T) {
+		deleted = nil
+
+		err := DeleteMedia(ctx, db, deleteFile, other.ID, inPost.UploadedFname)
+		assert.ErrorIs(t, err, sql.ErrNoRows, "only the owner can delete the file")
+		assert.True(t, exists(inPost))
+
+		inPost.RenditionFname = null.StringFrom(uuid.NewString() + ".mp4")
+		_, err = inPost.Update(ctx, db, boil.Infer())
+		require.NoError(t, err)
+
+		// the posts keep the markup, the file is just not served anymore
+		require.NoError(t, DeleteMedia(ctx, db, deleteFile, author.ID, inPost.UploadedFname))
+		assert.False(t, exists(inPost))
+		assert.ElementsMatch(t, []string{inPost.UploadedFname, inPost.RenditionFname.String}, deleted)
+
+		_, err = GetMediaAccess(ctx, db, author.ID, inPost.UploadedFname)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
@@ -24,8 +24,20 @@ type Comment struct {
 }
 
 func (c *Comment) String() string {
+	username := ""
+
+	if c.Author != nil {
+		username = c.Author.Username
+	}
+
 	return fmt.Sprintf("Comment{id:%s, parent_id: %s, date: %s, username: %s}",
-		c.ID, c.ParentCommentID.String, c.CreatedAt.Format(time.ANSIC), c.Author.Username)
+		c.ID, c.ParentCommentID.String, c.CreatedAt.Format(time.ANSIC), username)
+}
+
+// IsDeleted tells if only the tombstone of the comment is left,
+// it's kept to keep the replies of others in place
+func (c *Comment) IsDeleted() bool {
+	return c.DeletedAt.Valid
 }
 
 func CanSeePost(p *core.Post, radius userops.ConnectionRadius) bool {
@@ -126,7 +138,7 @@ func ConstructComments(comments core.PostCommentSlice, radius userops.Connection
 			PostComment: dbComment,
 			Author:      dbComment.R.User,
 			Capabilities: &CommentCapabilities{
-				CanRespond: radius.IsSameUser() || radius.IsDirect(),
+				CanRespond: (radius.IsSameUser() || radius.IsDirect()) && !dbComment.DeletedAt.Valid,
 			},
 			Level: 0,
 		}
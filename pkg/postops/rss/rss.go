@@ -9,7 +9,7 @@ import (
 	"github.com/gorilla/feeds"
 )
 
-func ToFeed(title string, link string, author *core.User, posts []*postops.Post) *feeds.Feed {
+func ToFeed(title string, link string, author *core.User, posts []*postops.Post, mediaMeta types.MediaMetaGetter, linkPreview types.LinkPreviewGetter) *feeds.Feed {
 	feed := &feeds.Feed{
 		Title: title,
 		Link:  &feeds.Link{Href: link},
@@ -21,7 +21,7 @@ func ToFeed(title string, link string, author *core.User, posts []*postops.Post)
 		content := "Post is not public, follow the link to read the text"
 
 		if post.VisibilityRadius == core.PostVisibilityPublic {
-			content = string(markdown.ToEnrichedTemplate(post.Body, types.ViewRSS, links.MediaReplacer, func(in string, add2 ...string) string {
+			content = string(markdown.ToEnrichedTemplate(post.Body, types.ViewRSS, links.MediaReplacer, mediaMeta, linkPreview, func(in string, add2 ...string) string {
 				return links.AbsLink(in, add2...)
 			}))
 		}
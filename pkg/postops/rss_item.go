@@ -0,0 +1,80 @@
+package postops
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// GetFeedRSSItem returns the rss item only in case it has
+// been delivered to the feed of the user
+func GetFeedRSSItem(ctx context.Context, db boil.ContextExecutor, userID string, rssItemID string) (*core.RSSItem, error) {
+	feedItem, err := core.UserFeedItems(
+		core.UserFeedItemWhere.UserID.EQ(userID),
+		core.UserFeedItemWhere.RSSItemID.EQ(rssItemID),
+		qm.Load(core.UserFeedItemRels.RSSItem),
+		qm.Load(core.UserFeedItemRels.URL),
+	).One(ctx, db)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	rssItem := feedItem.R.RSSItem
+	rssItem.R = rssItem.R.NewStruct()
+	rssItem.R.URL = feedItem.R.URL
+
+	return rssItem, nil
+}
+
+// RSSItemPostBody quotes the description of the item to
+// give the user something to start with
+func RSSItemPostBody(item *core.RSSItem) string {
+	description := strings.TrimSpace(item.SanitizedDescription)
+
+	if description == "" {
+		return ""
+	}
+
+	lines := strings.Split(description, "\n")
+
+	for idx, line := range lines {
+		lines[idx] = strings.TrimRight("> "+line, " ")
+	}
+
+	return strings.Join(lines, "\n") + "\n\n"
+}
+
+// GetRSSItemPosts returns ids of the posts the user has written
+// about the given rss items, keyed by rss item id
+func GetRSSItemPosts(ctx context.Context, db boil.ContextExecutor, userID string, rssItemIDs []string) (map[string]string, error) {
+	out := map[string]string{}
+
+	if len(rssItemIDs) == 0 {
+		return out, nil
+	}
+
+	posts, err := core.Posts(
+		core.PostWhere.UserID.EQ(userID),
+		core.PostWhere.RSSItemID.IN(rssItemIDs),
+		qm.OrderBy(core.PostColumns.ID),
+	).All(ctx, db)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range posts {
+		if _, ok := out[p.RSSItemID.String]; !ok {
+			out[p.RSSItemID.String] = p.ID
+		}
+	}
+
+	return out, nil
+}
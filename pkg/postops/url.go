@@ -2,11 +2,16 @@ package postops
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/pkg/userops"
 	"github.com/can3p/pcom/pkg/util"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 // StoreURL normalizes the given URL and stores it in the normalized_urls table,
@@ -41,3 +46,86 @@ func StoreURL(ctx context.Context, exec boil.ContextExecutor, rawURL string) (*c
 
 	return newURL, nil
 }
+
+// PostsByURLLimit caps the list of the posts shown next to the post linking the same url
+const PostsByURLLimit = 10
+
+// GetPostsByURL returns the latest published posts linking the url that the user
+// is allowed to see, more means there are older ones. Nil user means an anonymous visitor
+func GetPostsByURL(ctx context.Context, exec boil.ContextExecutor, user *core.User, urlID string, excludePostID string) (posts []*Post, more bool, err error) {
+	visible := core.PostWhere.VisibilityRadius.EQ(core.PostVisibilityPublic)
+	radius := func(authorID string) userops.ConnectionRadius { return userops.ConnectionRadiusUnknown }
+
+	if user != nil {
+		directUserIDs, secondDegreeUserIDs, _, err := userops.GetDirectAndSecondDegreeUserIDs(ctx, exec, user.ID)
+
+		if err != nil {
+			return nil, false, err
+		}
+
+		// the same rules as in CanSeePost, the limit has to apply to the visible posts only
+		visible = qm.Expr(
+			core.PostWhere.UserID.IN(append([]string{user.ID}, directUserIDs...)),
+			qm.Or2(qm.Expr(
+				core.PostWhere.UserID.IN(secondDegreeUserIDs),
+				core.PostWhere.VisibilityRadius.EQ(core.PostVisibilitySecondDegree),
+			)),
+			qm.Or2(core.PostWhere.VisibilityRadius.EQ(core.PostVisibilityPublic)),
+		)
+
+		directMap := lo.KeyBy(directUserIDs, func(u string) string { return u })
+		secondDegreeMap := lo.KeyBy(secondDegreeUserIDs, func(u string) string { return u })
+
+		radius = func(authorID string) userops.ConnectionRadius {
+			if authorID == user.ID {
+				return userops.ConnectionRadiusSameUser
+			}
+
+			if _, ok := directMap[authorID]; ok {
+				return userops.ConnectionRadiusDirect
+			}
+
+			if _, ok := secondDegreeMap[authorID]; ok {
+				return userops.ConnectionRadiusSecondDegree
+			}
+
+			return userops.ConnectionRadiusUnrelated
+		}
+	}
+
+	dbPosts, err := core.Posts(
+		core.PostWhere.URLID.EQ(null.StringFrom(urlID)),
+		core.PostWhere.ID.NEQ(excludePostID),
+		core.PostWhere.PublishedAt.IsNotNull(),
+		visible,
+		qm.Load(core.PostRels.User),
+		qm.Load(core.PostRels.PostStat),
+		qm.Load(core.PostRels.URL),
+		qm.OrderBy(fmt.Sprintf("%s DESC", core.PostColumns.PublishedAt)),
+		// +1 tells whether there are more posts, the last one is discarded
+		qm.Limit(PostsByURLLimit+1),
+	).All(ctx, exec)
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	if len(dbPosts) > PostsByURLLimit {
+		dbPosts = dbPosts[:PostsByURLLimit]
+		more = true
+	}
+
+	posts = []*Post{}
+
+	for _, p := range dbPosts {
+		r := radius(p.UserID)
+
+		if !CanSeePost(p, r) {
+			continue
+		}
+
+		posts = append(posts, ConstructPost(user, p, r, nil, false))
+	}
+
+	return posts, more, nil
+}
@@ -0,0 +1,93 @@
+package postops
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/can3p/pcom/pkg/feedops/testutil"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/testcontainers/postgres"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestGetPostsByURL(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+	exec := testDB.DB
+
+	author, err := testutil.CreateUser(ctx, exec, "author@example.com")
+	require.NoError(t, err)
+	friend, err := testutil.CreateUser(ctx, exec, "friend@example.com")
+	require.NoError(t, err)
+	stranger, err := testutil.CreateUser(ctx, exec, "stranger@example.com")
+	require.NoError(t, err)
+
+	for _, pair := range [][2]string{{author.ID, friend.ID}, {friend.ID, author.ID}} {
+		conn := &core.UserConnection{ID: uuid.NewString(), User1ID: pair[0], User2ID: pair[1]}
+		require.NoError(t, conn.Insert(ctx, exec, boil.Infer()))
+	}
+
+	url, err := StoreURL(ctx, exec, "https://example.com/item")
+	require.NoError(t, err)
+
+	otherURL, err := StoreURL(ctx, exec, "https://example.com/other")
+	require.NoError(t, err)
+
+	createPost := func(userID string, urlID string, visibility core.PostVisibility, published bool) *core.Post {
+		p := &core.Post{
+			ID:               uuid.NewString(),
+			Subject:          null.StringFrom("test"),
+			UserID:           userID,
+			URLID:            null.StringFrom(urlID),
+			VisibilityRadius: visibility,
+			PublishedAt:      null.NewTime(time.Now(), published),
+		}
+		require.NoError(t, p.Insert(ctx, exec, boil.Infer()))
+
+		return p
+	}
+
+	current := createPost(friend.ID, url.ID, core.PostVisibilityPublic, true)
+	public := createPost(stranger.ID, url.ID, core.PostVisibilityPublic, true)
+	directOnly := createPost(author.ID, url.ID, core.PostVisibilityDirectOnly, true)
+	_ = createPost(author.ID, url.ID, core.PostVisibilityPublic, false)
+	_ = createPost(stranger.ID, otherURL.ID, core.PostVisibilityPublic, true)
+
+	postIDs := func(viewer *core.User) []string {
+		posts, more, err := GetPostsByURL(ctx, exec, viewer, url.ID, current.ID)
+		require.NoError(t, err)
+		assert.False(t, more)
+
+		return lo.Map(posts, func(p *Post, idx int) string { return p.ID })
+	}
+
+	assert.ElementsMatch(t, []string{public.ID, directOnly.ID}, postIDs(friend), "the connections see the posts for them")
+	assert.ElementsMatch(t, []string{public.ID}, postIDs(stranger))
+	assert.ElementsMatch(t, []string{public.ID}, postIDs(nil), "anonymous visitors see the public posts")
+
+	t.Run("limit", func(t *testing.T) {
+		// the hidden posts do not take the place of the visible ones
+		for range PostsByURLLimit {
+			createPost(author.ID, url.ID, core.PostVisibilityDirectOnly, true)
+		}
+
+		posts, more, err := GetPostsByURL(ctx, exec, stranger, url.ID, current.ID)
+		require.NoError(t, err)
+		assert.False(t, more)
+		assert.Len(t, posts, 1)
+
+		posts, more, err = GetPostsByURL(ctx, exec, friend, url.ID, current.ID)
+		require.NoError(t, err)
+		assert.True(t, more)
+		assert.Len(t, posts, PostsByURLLimit)
+	})
+}
@@ -15,3 +15,47 @@ const (
 	ViewEmail       HTMLView = "post_notification_email"
 	ViewRSS         HTMLView = "rss_feed"
 )
+
+type MediaKind string
+
+const (
+	MediaKindImage MediaKind = "image"
+	MediaKindVideo MediaKind = "video"
+	MediaKindAudio MediaKind = "audio"
+)
+
+// MediaMeta describes the uploaded image to let browsers reserve
+// the space for it and show something while it's loading
+type MediaMeta struct {
+	Kind MediaKind
+	// dimensions are zero for the uploads made before they were stored
+	Width  int
+	Height int
+	// Placeholder is a data uri with a blurred preview of the image
+	Placeholder string
+	Color       string
+	// AltText and Caption are used when markdown does not have them
+	AltText string
+	Caption string
+	// video and audio are playable only once the transcoder is done with them
+	Processing bool
+	Failed     bool
+	HasPoster  bool
+}
+
+type MediaMetaGetter func(fname string) (MediaMeta, bool)
+
+// LinkPreview is what the page behind the link says about itself
+type LinkPreview struct {
+	URL         string
+	Title       string
+	Description string
+	SiteName    string
+	// Image is the file name of the rehosted preview image
+	Image string
+	// PlayerURL is the embeddable player the page advertises,
+	// it's up to the renderer to decide whether it can be trusted
+	PlayerURL string
+}
+
+type LinkPreviewGetter func(url string) (LinkPreview, bool)
@@ -4,6 +4,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/can3p/pcom/pkg/links/media"
 	"github.com/can3p/pcom/pkg/util"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -48,13 +49,27 @@ var cspParts = strings.Join(
 		"default-src 'self' " + os.Getenv("STATIC_CDN"),
 		// forbid embedding the pages anywhere
 		"frame-ancestors 'none';",
-		"frame-src  www.youtube-nocookie.com",
+		// the players of the supported embeds, peertube
+		// instances have to be listed by the admins
+		"frame-src www.youtube-nocookie.com player.vimeo.com w.soundcloud.com bandcamp.com" + peertubeFrameSrc(),
 		// allow data: as a source for images
 		"img-src data: w3.org/svg/2000 'self' " + os.Getenv("STATIC_CDN") + " " + os.Getenv("USER_MEDIA_CDN") + " i.ytimg.com",
+		// uploaded video and audio
+		"media-src 'self' " + os.Getenv("USER_MEDIA_CDN"),
 		"script-src 'self' " + os.Getenv("STATIC_CDN") + " 'nonce-SCRIPT_NONCE'",
 		"style-src 'self' " + os.Getenv("STATIC_CDN") + " 'nonce-STYLE_NONCE'",
 	}, "; ")
 
+func peertubeFrameSrc() string {
+	var out string
+
+	for _, host := range media.PeerTubeHosts() {
+		out += " " + host
+	}
+
+	return out
+}
+
 func Csp(c *gin.Context) {
 	styleNonce := uuid.NewString()
 	scriptNonce := uuid.NewString()
@@ -2,11 +2,13 @@ package web
 
 import (
 	"database/sql"
+	"strings"
 	"time"
 
 	"github.com/can3p/gogo/sender"
 	"github.com/can3p/gogo/util/transact"
 	"github.com/can3p/pcom/pkg/forms"
+	"github.com/can3p/pcom/pkg/forms/validation"
 	"github.com/can3p/pcom/pkg/links"
 	"github.com/can3p/pcom/pkg/media"
 	"github.com/can3p/pcom/pkg/media/server"
@@ -117,8 +119,21 @@ func ApiGetPosts(c *gin.Context, db *sqlx.DB, userID string) mo.Result[*ApiGetPo
 }
 
 type ApiNewPostResponse struct {
-	ID        string `json:"id"`
-	PublicURL string `json:"public_url"`
+	ID        string   `json:"id"`
+	PublicURL string   `json:"public_url"`
+	Warnings  []string `json:"warnings,omitempty"`
+}
+
+// apiPostWarnings lets api clients know about the problems
+// that are shown to the users of the web form before publishing
+func apiPostWarnings(form *forms.PostForm) []string {
+	if len(form.MissingAltText) == 0 {
+		return nil
+	}
+
+	return lo.Map(form.MissingAltText, func(url string, idx int) string {
+		return "image has no alt text: " + url
+	})
 }
 
 func ApiNewPost(c *gin.Context, db *sqlx.DB, sender sender.Sender, dbUser *core.User, mediaReplacer types.Replacer[string]) mo.Result[*ApiNewPostResponse] {
@@ -136,7 +151,7 @@ func ApiNewPost(c *gin.Context, db *sqlx.DB, sender sender.Sender, dbUser *core.
 		action = forms.PostFormActionPublish
 	}
 
-	form, err := forms.NewPostFormNew(c, db, sender, dbUser, mediaReplacer, "")
+	form, err := forms.NewPostFormNew(c, db, sender, dbUser, mediaReplacer, "", "")
 	if err != nil {
 		return mo.Err[*ApiNewPostResponse](err)
 	}
@@ -146,6 +161,8 @@ func ApiNewPost(c *gin.Context, db *sqlx.DB, sender sender.Sender, dbUser *core.
 		Body:       input.MdBody,
 		Visibility: input.Visibility,
 		SaveAction: action,
+		// there is no way to confirm publishing, clients get warnings in the response instead
+		IgnoreAltTextWarning: true,
 	}
 
 	if err := form.Validate(c, db); err != nil {
@@ -167,6 +184,7 @@ func ApiNewPost(c *gin.Context, db *sqlx.DB, sender sender.Sender, dbUser *core.
 		// and this only means that forms were not made with apis in mind
 		ID:        postID,
 		PublicURL: links.AbsLink("post", postID),
+		Warnings:  apiPostWarnings(form),
 	})
 }
 
@@ -196,6 +214,8 @@ func ApiEditPost(c *gin.Context, db *sqlx.DB, sender sender.Sender, dbUser *core
 		Body:       input.MdBody,
 		Visibility: input.Visibility,
 		SaveAction: action,
+		// there is no way to confirm publishing, clients get warnings in the response instead
+		IgnoreAltTextWarning: true,
 	}
 
 	if err := form.Validate(c, db); err != nil {
@@ -215,6 +235,7 @@ func ApiEditPost(c *gin.Context, db *sqlx.DB, sender sender.Sender, dbUser *core
 		// and this only means that forms were not made with apis in mind
 		ID:        postID,
 		PublicURL: links.AbsLink("post", postID),
+		Warnings:  apiPostWarnings(form),
 	})
 }
 
@@ -239,6 +260,18 @@ func ApiUploadImage(c *gin.Context, db *sqlx.DB, dbUser *core.User, mediaStorage
 		return mo.Err[*ApiUploadImageResponse](err)
 	}
 
+	// both are optional, the uploader is asked for them but can skip the question
+	altText := strings.TrimSpace(c.PostForm("alt_text"))
+	caption := strings.TrimSpace(c.PostForm("caption"))
+
+	if err := validation.ValidateMinMax("alt text", altText, 0, media.MaxDescriptionLength); err != nil {
+		return mo.Err[*ApiUploadImageResponse](err)
+	}
+
+	if err := validation.ValidateMinMax("caption", caption, 0, media.MaxDescriptionLength); err != nil {
+		return mo.Err[*ApiUploadImageResponse](err)
+	}
+
 	f, err := file.Open()
 
 	if err != nil {
@@ -248,8 +281,17 @@ func ApiUploadImage(c *gin.Context, db *sqlx.DB, dbUser *core.User, mediaStorage
 	var fname string
 
 	err = transact.Transact(db, func(tx *sql.Tx) error {
-		fname, err = media.HandleUpload(c, db, mediaStorage, &dbUser.ID, nil, f)
-		return err
+		fname, err = media.HandleUpload(c, tx, mediaStorage, &dbUser.ID, nil, nil, f)
+
+		if err != nil {
+			return err
+		}
+
+		if altText == "" && caption == "" {
+			return nil
+		}
+
+		return media.SetDescription(c, tx, dbUser.ID, fname, altText, caption)
 	})
 
 	if err != nil {
@@ -4,13 +4,17 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"html/template"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/can3p/pcom/pkg/auth"
 	"github.com/can3p/pcom/pkg/feedops"
 	"github.com/can3p/pcom/pkg/forms"
+	"github.com/can3p/pcom/pkg/forms/validation"
 	"github.com/can3p/pcom/pkg/links"
+	"github.com/can3p/pcom/pkg/media"
 	"github.com/can3p/pcom/pkg/model/core"
 	"github.com/can3p/pcom/pkg/postops"
 	"github.com/can3p/pcom/pkg/userops"
@@ -202,7 +206,9 @@ func Controls(ctx *gin.Context, db boil.ContextExecutor, userData *auth.UserData
 
 type WritePage struct {
 	*BasePage
-	Prompt *postops.PostPrompt
+	Prompt  *postops.PostPrompt
+	RSSItem *core.RSSItem
+	Input   *forms.PostFormInput
 }
 
 func Write(c *gin.Context, db boil.ContextExecutor, userData *auth.UserData) mo.Result[*WritePage] {
@@ -226,9 +232,76 @@ func Write(c *gin.Context, db boil.ContextExecutor, userData *auth.UserData) mo.
 		Prompt:   prompt,
 	}
 
+	// the draft is only saved once the form is submitted
+	writePage.Input = WritePrefill(c)
+
+	if rssItemID := c.Query("rss_item"); rssItemID != "" {
+		rssItem, err := postops.GetFeedRSSItem(c, db, dbUser.ID, rssItemID)
+
+		if err != nil {
+			return mo.Err[*WritePage](err)
+		}
+
+		if rssItem == nil {
+			return mo.Err[*WritePage](ginhelpers.ErrNotFound)
+		}
+
+		writePage.RSSItem = rssItem
+		writePage.Input = &forms.PostFormInput{
+			Subject:    rssItem.Title,
+			URL:        rssItem.R.URL.URL,
+			Body:       postops.RSSItemPostBody(rssItem),
+			Visibility: core.PostVisibilitySecondDegree,
+		}
+	}
+
 	return mo.Ok(writePage)
 }
 
+// WritePrefill collects post fields from the query parameters, which is what
+// the bookmarklet and the share target use. Nil means there was nothing to prefill
+func WritePrefill(c *gin.Context) *forms.PostFormInput {
+	title := strings.TrimSpace(c.Query("title"))
+	url := strings.TrimSpace(c.Query("url"))
+	body := strings.TrimSpace(c.Query("body"))
+	visibility := core.PostVisibility(c.Query("visibility"))
+
+	if title == "" && url == "" && body == "" {
+		return nil
+	}
+
+	// share targets on mobile tend to put the link into the text
+	if url == "" && isSharedLink(body) {
+		url = body
+		body = ""
+	}
+
+	if visibility == "" {
+		visibility = core.PostVisibilitySecondDegree
+	}
+
+	return &forms.PostFormInput{
+		Subject:    title,
+		URL:        url,
+		Body:       body,
+		Visibility: visibility,
+		SaveAction: forms.PostFormActionAutosave,
+		Prefilled:  true,
+	}
+}
+
+func isSharedLink(s string) bool {
+	if strings.ContainsAny(s, " \t\n") {
+		return false
+	}
+
+	if !strings.HasPrefix(s, "http://") && !strings.HasPrefix(s, "https://") {
+		return false
+	}
+
+	return validation.ValidateURL(s) == nil
+}
+
 type SettingsPage struct {
 	*BasePage
 	AvailableInvites int64
@@ -237,6 +310,16 @@ type SettingsPage struct {
 	GeneralSettings  *forms.SettingsGeneralForm
 	UserStyles       *forms.SettingsUserStyles
 	Feeds            []*feedops.RssFeed
+	FeedFolders      []*feedops.FeedFolder
+	AddFeedForm      *forms.AddFeedForm
+	FeedRules        []*feedops.FeedRule
+	Bookmarklet      template.URL
+	FeedRuleForm     *forms.AddFeedRuleForm
+	// PendingEmailChange is the change waiting for the confirmations, if any
+	PendingEmailChange       *core.EmailChangeRequest
+	AccountDeletionGraceDays int
+	// NoPassword is set for the accounts that confirm the changes with a recent login
+	NoPassword bool
 }
 
 func Settings(c *gin.Context, db boil.ContextExecutor, userData *auth.UserData) mo.Result[*SettingsPage] {
@@ -283,6 +366,24 @@ func Settings(c *gin.Context, db boil.ContextExecutor, userData *auth.UserData)
 		return mo.Err[*SettingsPage](err)
 	}
 
+	feedFolders, err := feedops.GetFeedFolders(c, db, userData.DBUser.ID)
+
+	if err != nil {
+		return mo.Err[*SettingsPage](err)
+	}
+
+	feedRules, err := feedops.GetFeedRules(c, db, userData.DBUser.ID)
+
+	if err != nil {
+		return mo.Err[*SettingsPage](err)
+	}
+
+	pendingEmailChange, err := auth.PendingEmailChange(c, db, userData.DBUser)
+
+	if err != nil {
+		return mo.Err[*SettingsPage](err)
+	}
+
 	settingsPage := &SettingsPage{
 		BasePage:         getBasePage(c, "Settings", userData),
 		AvailableInvites: totalInvites - int64(len(usedInvites)),
@@ -291,22 +392,69 @@ func Settings(c *gin.Context, db boil.ContextExecutor, userData *auth.UserData)
 		GeneralSettings:  forms.SettingsGeneralFormNew(userData.DBUser),
 		UserStyles:       formUserStyles,
 		Feeds:            feeds,
+		FeedFolders:      feedFolders,
+		AddFeedForm:      forms.NewAddFeedForm(userData.DBUser, feedFolders),
+		FeedRules:        feedRules,
+		FeedRuleForm:     forms.NewAddFeedRuleForm(userData.DBUser, feeds),
+		Bookmarklet:      links.Bookmarklet(),
+
+		PendingEmailChange:       pendingEmailChange,
+		AccountDeletionGraceDays: int(auth.AccountDeletionGracePeriod.Hours() / 24),
+		NoPassword:               !auth.HasPassword(userData.DBUser),
 	}
 
 	return mo.Ok(settingsPage)
 }
 
+type MediaLibraryPageItem struct {
+	*postops.MediaLibraryItem
+	DescriptionForm *forms.MediaDescriptionForm
+}
+
+type MediaLibraryPage struct {
+	*BasePage
+	Items []*MediaLibraryPageItem
+	Quota media.Quota
+}
+
+func MediaLibrary(c *gin.Context, db boil.ContextExecutor, userData *auth.UserData) mo.Result[*MediaLibraryPage] {
+	items, err := postops.GetMediaLibrary(c, db, userData.DBUser.ID)
+
+	if err != nil {
+		return mo.Err[*MediaLibraryPage](err)
+	}
+
+	quota, err := media.GetUserQuota(c, db, userData.DBUser.ID)
+
+	if err != nil {
+		return mo.Err[*MediaLibraryPage](err)
+	}
+
+	return mo.Ok(&MediaLibraryPage{
+		BasePage: getBasePage(c, "Media Library", userData),
+		Items: lo.Map(items, func(item *postops.MediaLibraryItem, idx int) *MediaLibraryPageItem {
+			return &MediaLibraryPageItem{
+				MediaLibraryItem: item,
+				DescriptionForm:  forms.MediaDescriptionFormNew(userData.DBUser, item.Upload),
+			}
+		}),
+		Quota: quota,
+	})
+}
+
 type InvitePage struct {
 	*BasePage
-	Invite  *core.UserInvitation
-	Inviter *core.User
+	Invite   *core.UserInvitation
+	Inviter  *core.User
+	OIDCName string
 }
 
-func Invite(c *gin.Context, db boil.ContextExecutor, invite *core.UserInvitation, userData *auth.UserData) *InvitePage {
+func Invite(c *gin.Context, db boil.ContextExecutor, invite *core.UserInvitation, userData *auth.UserData, oidcName string) *InvitePage {
 	invitePage := &InvitePage{
 		BasePage: getBasePage(c, "Accept Invitation", userData),
 		Invite:   invite,
 		Inviter:  invite.User().OneP(c, db),
+		OIDCName: oidcName,
 	}
 
 	return invitePage
@@ -360,6 +508,10 @@ type SinglePostPage struct {
 	Post      *postops.Post
 	PostShare *core.PostShare
 	Comments  []*postops.Comment
+	// other posts linking the same url
+	DiscussedElsewhere []*postops.Post
+	// only the latest posts are shown
+	DiscussedElsewhereMore bool
 }
 
 func SinglePost(c *gin.Context, db boil.ContextExecutor, userData *auth.UserData, postID string, editPreview bool) mo.Result[*SinglePostPage] {
@@ -431,6 +583,17 @@ func SinglePost(c *gin.Context, db boil.ContextExecutor, userData *auth.UserData
 		singlePostPage.PostShare = postShare
 	}
 
+	if post.URLID.Valid && !editPreview {
+		discussedElsewhere, more, err := postops.GetPostsByURL(c, db, userData.DBUser, post.URLID.String, post.ID)
+
+		if err != nil {
+			return mo.Err[*SinglePostPage](err)
+		}
+
+		singlePostPage.DiscussedElsewhere = discussedElsewhere
+		singlePostPage.DiscussedElsewhereMore = more
+	}
+
 	return mo.Ok(singlePostPage)
 }
 
@@ -615,18 +778,28 @@ type FeedItem struct {
 	Post     *postops.Post
 	FeedItem *feedops.RssFeedItem
 	Comment  *postops.Comment
+	// rss item from the user's subscriptions that links
+	// the same url as the post
+	AlsoInFeed *feedops.RssFeedItem
 }
 
 type FeedPageCapabilities struct {
 	ShowPromptForm bool
 }
 
+type FeedFilter struct {
+	Folders      []*feedops.FeedFolder
+	ActiveFolder *feedops.FeedFolder
+	OnlyPeople   bool
+}
+
 type FeedPage struct {
 	*BasePage
 	DirectConnections []*core.User
 	OpenPrompts       []*postops.PostPrompt
 	Items             []*FeedItem
 	Capabilities      FeedPageCapabilities
+	Filter            *FeedFilter
 }
 
 func (i *FeedItem) AddedToFeedAt() time.Time {
@@ -641,6 +814,34 @@ func (i *FeedItem) AddedToFeedAt() time.Time {
 	return i.Comment.CreatedAt
 }
 
+// mergeRssFeedItems adds rss items to the feed. Items linking the same url
+// as one of the posts are not shown separately, the post gets a remark instead
+func mergeRssFeedItems(items []*FeedItem, rssFeedItems []*feedops.RssFeedItem) []*FeedItem {
+	postsByURL := map[string][]*FeedItem{}
+
+	for _, item := range items {
+		if item.Post != nil && item.Post.URLID.Valid {
+			postsByURL[item.Post.URLID.String] = append(postsByURL[item.Post.URLID.String], item)
+		}
+	}
+
+	for _, rssItem := range rssFeedItems {
+		if posts, ok := postsByURL[rssItem.URLID]; ok {
+			for _, p := range posts {
+				p.AlsoInFeed = rssItem
+			}
+
+			continue
+		}
+
+		items = append(items, &FeedItem{
+			FeedItem: rssItem,
+		})
+	}
+
+	return items
+}
+
 func Feed(ctx *gin.Context, db boil.ContextExecutor, userData *auth.UserData, onlyPosts bool) mo.Result[*FeedPage] {
 	user := userData.DBUser
 	title := "Your Feed"
@@ -717,27 +918,60 @@ func Feed(ctx *gin.Context, db boil.ContextExecutor, userData *auth.UserData, on
 		return mo.Ok(feedPage)
 	}
 
-	rssFeedItems, err := feedops.GetRssFeedItems(ctx, db, user.ID)
+	folders, err := feedops.GetFeedFolders(ctx, db, user.ID)
 
 	if err != nil {
 		return mo.Err[*FeedPage](err)
 	}
 
-	rssFeedItemsMapped := lo.Map(rssFeedItems, func(p *feedops.RssFeedItem, idx int) *FeedItem {
-		return &FeedItem{
-			FeedItem: p,
-		}
-	})
+	filter := &FeedFilter{
+		Folders:    folders,
+		OnlyPeople: ctx.Query("only") == "people",
+	}
 
-	items = append(items, rssFeedItemsMapped...)
+	if folderID := ctx.Query("folder"); folderID != "" {
+		folder, ok := lo.Find(folders, func(f *feedops.FeedFolder) bool { return f.ID == folderID })
 
-	comments, err := getComments(ctx, db, user.ID)
+		if !ok {
+			return mo.Err[*FeedPage](ginhelpers.ErrNotFound)
+		}
 
-	if err != nil {
-		return mo.Err[*FeedPage](err)
+		filter.ActiveFolder = folder
 	}
 
-	items = append(items, comments...)
+	if filter.ActiveFolder != nil {
+		// folder view is all about the feeds in the folder,
+		// posts and comments of people are not there
+		rssFeedItems, err := feedops.GetFolderRssFeedItems(ctx, db, user.ID, filter.ActiveFolder.ID)
+
+		if err != nil {
+			return mo.Err[*FeedPage](err)
+		}
+
+		items = lo.Map(rssFeedItems, func(p *feedops.RssFeedItem, idx int) *FeedItem {
+			return &FeedItem{
+				FeedItem: p,
+			}
+		})
+	} else {
+		if !filter.OnlyPeople {
+			rssFeedItems, err := feedops.GetRssFeedItems(ctx, db, user.ID)
+
+			if err != nil {
+				return mo.Err[*FeedPage](err)
+			}
+
+			items = mergeRssFeedItems(items, rssFeedItems)
+		}
+
+		comments, err := getComments(ctx, db, user.ID)
+
+		if err != nil {
+			return mo.Err[*FeedPage](err)
+		}
+
+		items = append(items, comments...)
+	}
 
 	// newest items first
 	slices.SortFunc(items, func(a, b *FeedItem) int {
@@ -790,6 +1024,7 @@ func Feed(ctx *gin.Context, db boil.ContextExecutor, userData *auth.UserData, on
 		OpenPrompts:       prompts,
 		Items:             items,
 		Capabilities:      FeedPageCapabilities{ShowPromptForm: true},
+		Filter:            filter,
 	}
 
 	return mo.Ok(feedPage)
@@ -842,7 +1077,7 @@ func getComments(ctx context.Context, db boil.ContextExecutor, userID string) ([
 	// we want to add the comments from the posts
 	// where the user has participated
 	ownComments, err := core.PostComments(
-		core.PostCommentWhere.UserID.EQ(userID),
+		core.PostCommentWhere.UserID.EQ(null.StringFrom(userID)),
 		qm.Distinct(core.PostCommentColumns.PostID),
 	).All(ctx, db)
 
@@ -881,7 +1116,7 @@ func getComments(ctx context.Context, db boil.ContextExecutor, userID string) ([
 	postMap := lo.KeyBy(posts, func(p *core.Post) string { return p.ID })
 
 	comments, err := core.PostComments(
-		core.PostCommentWhere.UserID.NEQ(userID),
+		core.PostCommentWhere.UserID.NEQ(null.StringFrom(userID)),
 		core.PostCommentWhere.PostID.IN(lo.Map(posts, func(p *core.Post, idx int) string { return p.ID })),
 		qm.OrderBy(fmt.Sprintf("%s DESC", core.PostCommentColumns.CreatedAt)),
 		qm.Load(core.PostCommentRels.User),
@@ -911,14 +1146,205 @@ type LoginPage struct {
 	*BasePage
 	ReturnURL string
 	Sign      string
+	// OIDCName is the name of the identity provider, empty if there is none
+	OIDCName string
 }
 
-func Login(c *gin.Context, db boil.ContextExecutor, userData *auth.UserData, returnUrl string, sign string) *LoginPage {
+func Login(c *gin.Context, db boil.ContextExecutor, userData *auth.UserData, returnUrl string, sign string, oidcName string) *LoginPage {
 	invitePage := &LoginPage{
 		BasePage:  getBasePage(c, "Login", userData),
 		ReturnURL: returnUrl,
 		Sign:      sign,
+		OIDCName:  oidcName,
 	}
 
 	return invitePage
 }
+
+func LoginEmail(c *gin.Context, db boil.ContextExecutor, userData *auth.UserData, returnUrl string, sign string) *LoginPage {
+	return &LoginPage{
+		BasePage:  getBasePage(c, "Login by email", userData),
+		ReturnURL: returnUrl,
+		Sign:      sign,
+	}
+}
+
+type LoginLinkPage struct {
+	*BasePage
+	Token     string
+	ReturnURL string
+	Sign      string
+	// Valid is false for the links that are used or expired
+	Valid bool
+}
+
+// LoginLink only shows the confirmation, the link is used once the user submits it
+func LoginLink(c *gin.Context, db boil.ContextExecutor, userData *auth.UserData, token string, returnUrl string, sign string) *LoginLinkPage {
+	_, err := auth.FindLoginLink(c, db, token)
+
+	if err != nil && err != sql.ErrNoRows {
+		panic(err)
+	}
+
+	return &LoginLinkPage{
+		BasePage:  getBasePage(c, "Login", userData),
+		Token:     token,
+		ReturnURL: returnUrl,
+		Sign:      sign,
+		Valid:     err == nil,
+	}
+}
+
+type OIDCFinishPage struct {
+	*BasePage
+	OIDCName string
+	// Flow is nil if the login has failed or expired
+	Flow      *auth.OIDCFlow
+	CanSignup bool
+}
+
+// OIDCFinish lets the user with the unknown identity either link it
+// to the existing account or sign up, if that's allowed
+func OIDCFinish(c *gin.Context, db boil.ContextExecutor, userData *auth.UserData, oidcName string, flow *auth.OIDCFlow, registrationOpen bool) mo.Result[*OIDCFinishPage] {
+	page := &OIDCFinishPage{
+		BasePage: getBasePage(c, "Login with "+oidcName, userData),
+		OIDCName: oidcName,
+		Flow:     flow,
+	}
+
+	if flow == nil {
+		return mo.Ok(page)
+	}
+
+	invite, err := auth.OIDCSignupInvite(c, db, flow)
+
+	if err != nil {
+		return mo.Err[*OIDCFinishPage](err)
+	}
+
+	page.CanSignup = flow.Identity.EmailVerified && (registrationOpen || invite != nil)
+
+	return mo.Ok(page)
+}
+
+type LoginTwoFactorPage struct {
+	*BasePage
+	ReturnURL   string
+	Sign        string
+	HasTOTP     bool
+	HasPasskeys bool
+}
+
+func LoginTwoFactor(c *gin.Context, db boil.ContextExecutor, userData *auth.UserData, pendingUser *core.User, returnUrl string, sign string) mo.Result[*LoginTwoFactorPage] {
+	hasPasskeys, err := auth.HasPasskeys(c, db, pendingUser)
+
+	if err != nil {
+		return mo.Err[*LoginTwoFactorPage](err)
+	}
+
+	return mo.Ok(&LoginTwoFactorPage{
+		BasePage:    getBasePage(c, "Two-factor authentication", userData),
+		ReturnURL:   returnUrl,
+		Sign:        sign,
+		HasTOTP:     auth.HasTOTP(pendingUser),
+		HasPasskeys: hasPasskeys,
+	})
+}
+
+type SecurityPage struct {
+	*BasePage
+	TwoFactorEnabled  bool
+	TwoFactorRequired bool
+	RecoveryCodesLeft int64
+	Passkeys          core.UserPasskeySlice
+	Sessions          []*auth.SessionInfo
+	EnableTwoFactor   *forms.EnableTwoFactorForm
+	ManageTwoFactor   *forms.ManageTwoFactorForm
+}
+
+func Security(c *gin.Context, db boil.ContextExecutor, userData *auth.UserData) mo.Result[*SecurityPage] {
+	user := userData.DBUser
+
+	required, err := auth.TwoFactorRequired(c, db)
+
+	if err != nil {
+		return mo.Err[*SecurityPage](err)
+	}
+
+	page := &SecurityPage{
+		BasePage:          getBasePage(c, "Security", userData),
+		TwoFactorEnabled:  auth.HasTOTP(user),
+		TwoFactorRequired: required,
+	}
+
+	page.Passkeys, err = auth.UserPasskeys(c, db, user)
+
+	if err != nil {
+		return mo.Err[*SecurityPage](err)
+	}
+
+	page.Sessions, err = auth.UserSessions(c, db, user.ID)
+
+	if err != nil {
+		return mo.Err[*SecurityPage](err)
+	}
+
+	if page.TwoFactorEnabled {
+		page.ManageTwoFactor = forms.ManageTwoFactorFormNew(user)
+		page.RecoveryCodesLeft, err = auth.RecoveryCodesLeft(c, db, user)
+	} else {
+		page.EnableTwoFactor, err = forms.EnableTwoFactorFormNew(user).WithNewKey()
+	}
+
+	if err != nil {
+		return mo.Err[*SecurityPage](err)
+	}
+
+	return mo.Ok(page)
+}
+
+func ForgotPassword(c *gin.Context, db boil.ContextExecutor, userData *auth.UserData) *BasePage {
+	return getBasePage(c, "Forgot password", userData)
+}
+
+type ResetPasswordPage struct {
+	*BasePage
+	Token string
+	// Valid is false for the links that are used or expired
+	Valid bool
+}
+
+func ResetPassword(c *gin.Context, db boil.ContextExecutor, userData *auth.UserData, token string) *ResetPasswordPage {
+	_, err := auth.FindPasswordReset(c, db, token)
+
+	if err != nil && err != sql.ErrNoRows {
+		panic(err)
+	}
+
+	return &ResetPasswordPage{
+		BasePage: getBasePage(c, "Reset password", userData),
+		Token:    token,
+		Valid:    err == nil,
+	}
+}
+
+type ConfirmEmailChangePage struct {
+	*BasePage
+	Token   string
+	Request *core.EmailChangeRequest
+}
+
+// ConfirmEmailChange only shows the change, it's the form that confirms it
+func ConfirmEmailChange(c *gin.Context, db boil.ContextExecutor, userData *auth.UserData, token string) *ConfirmEmailChangePage {
+	request, err := auth.FindEmailChange(c, db, token)
+
+	if err != nil && err != sql.ErrNoRows {
+		panic(err)
+	}
+
+	return &ConfirmEmailChangePage{
+		BasePage: getBasePage(c, "Email change", userData),
+		Token:    token,
+		Request:  request,
+	}
+}
@@ -0,0 +1,198 @@
+package web
+
+import (
+	"context"
+	"database/sql"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	gogoForms "github.com/can3p/gogo/forms"
+	"github.com/can3p/gogo/util/transact"
+	"github.com/can3p/pcom/pkg/auth"
+	"github.com/can3p/pcom/pkg/feedops"
+	"github.com/can3p/pcom/pkg/feedops/testutil"
+	"github.com/can3p/pcom/pkg/forms"
+	"github.com/can3p/pcom/pkg/links"
+	"github.com/can3p/pcom/pkg/model/core"
+	"github.com/can3p/pcom/pkg/postops"
+	"github.com/can3p/pcom/testcontainers/postgres"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func writeContext(query url.Values) *gin.Context {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("GET", "/write?"+query.Encode(), nil)
+
+	return c
+}
+
+func TestWritePrefill(t *testing.T) {
+	testCases := []struct {
+		name     string
+		query    url.Values
+		expected *forms.PostFormInput
+	}{
+		{
+			name:     "nothing to prefill",
+			query:    url.Values{"visibility": {"public"}},
+			expected: nil,
+		},
+		{
+			name:  "bookmarklet",
+			query: url.Values{"title": {" Page title "}, "url": {"https://example.com/page"}},
+			expected: &forms.PostFormInput{
+				Subject:    "Page title",
+				URL:        "https://example.com/page",
+				Visibility: core.PostVisibilitySecondDegree,
+				SaveAction: forms.PostFormActionAutosave,
+				Prefilled:  true,
+			},
+		},
+		{
+			name:  "share target with the link in the text",
+			query: url.Values{"title": {"Page title"}, "body": {"https://example.com/page"}, "visibility": {"public"}},
+			expected: &forms.PostFormInput{
+				Subject:    "Page title",
+				URL:        "https://example.com/page",
+				Visibility: core.PostVisibilityPublic,
+				SaveAction: forms.PostFormActionAutosave,
+				Prefilled:  true,
+			},
+		},
+		{
+			name:  "share target with the text",
+			query: url.Values{"body": {"look at https://example.com/page"}},
+			expected: &forms.PostFormInput{
+				Body:       "look at https://example.com/page",
+				Visibility: core.PostVisibilitySecondDegree,
+				SaveAction: forms.PostFormActionAutosave,
+				Prefilled:  true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, WritePrefill(writeContext(tc.query)))
+		})
+	}
+}
+
+func TestPrefilledDraft(t *testing.T) {
+	testDB, err := postgres.NewTestDB()
+	require.NoError(t, err)
+	defer func() { _ = testDB.Close() }()
+
+	ctx := context.Background()
+	db := testDB.DB
+
+	user, err := testutil.CreateUser(ctx, db, "test@example.com")
+	require.NoError(t, err)
+
+	// writeDraft submits the prefilled form the way the first autosave does
+	// and returns the id of the draft the user ends up with
+	writeDraft := func(query url.Values) string {
+		c := writeContext(query)
+
+		page := Write(c, db, &auth.UserData{DBUser: user}).MustGet()
+		require.NotNil(t, page.Input)
+
+		form, err := forms.NewPostFormNew(c, db, nil, user, links.SignedMediaReplacer, "", "")
+		require.NoError(t, err)
+
+		form.Input = page.Input
+		require.NoError(t, form.Validate(c, db))
+
+		var action gogoForms.FormSaveAction
+
+		require.NoError(t, transact.Transact(db, func(tx *sql.Tx) error {
+			action, err = form.Save(c, tx)
+
+			return err
+		}))
+
+		if postID, ok := form.TemplateData()["PostID"].(string); ok {
+			return postID
+		}
+
+		w := httptest.NewRecorder()
+		rc, _ := gin.CreateTestContext(w)
+		action(rc, form)
+
+		redirect := w.Header().Get("HX-Redirect")
+		require.Regexp(t, `^/posts/[^/]+/edit$`, redirect)
+
+		return strings.TrimSuffix(strings.TrimPrefix(redirect, "/posts/"), "/edit")
+	}
+
+	countPosts := func() int64 {
+		count, err := core.Posts(core.PostWhere.UserID.EQ(user.ID)).Count(ctx, db)
+		require.NoError(t, err)
+
+		return count
+	}
+
+	bookmarklet := url.Values{"title": {"Page title"}, "url": {"https://example.com/page"}}
+
+	page := Write(writeContext(bookmarklet), db, &auth.UserData{DBUser: user}).MustGet()
+	assert.Equal(t, "Page title", page.Input.Subject)
+	assert.Equal(t, int64(0), countPosts(), "opening the page saves nothing")
+
+	draftID := writeDraft(bookmarklet)
+
+	draft, err := core.Posts(
+		core.PostWhere.ID.EQ(draftID),
+		qm.Load(core.PostRels.URL),
+	).One(ctx, db)
+	require.NoError(t, err)
+	assert.Equal(t, "Page title", draft.Subject.String)
+	assert.Equal(t, "https://example.com/page", draft.R.URL.URL)
+	assert.False(t, draft.PublishedAt.Valid)
+
+	assert.Equal(t, draftID, writeDraft(bookmarklet), "reloading the page keeps the draft")
+	assert.Equal(t, draftID, writeDraft(url.Values{"url": {"https://EXAMPLE.com/page/"}}), "the url is matched after normalization")
+
+	text := url.Values{"body": {"some thoughts"}}
+	textDraftID := writeDraft(text)
+	assert.NotEqual(t, draftID, textDraftID)
+	assert.Equal(t, textDraftID, writeDraft(text))
+
+	draft.PublishedAt = null.TimeFrom(time.Now())
+	_, err = draft.Update(ctx, db, boil.Infer())
+	require.NoError(t, err)
+
+	assert.NotEqual(t, draftID, writeDraft(bookmarklet), "published posts are not drafts anymore")
+	assert.Equal(t, int64(3), countPosts())
+}
+
+func TestMergeRssFeedItems(t *testing.T) {
+	postItem := func(id string, urlID null.String) *FeedItem {
+		return &FeedItem{Post: &postops.Post{Post: &core.Post{ID: id, URLID: urlID}}}
+	}
+
+	shared := postItem("shared", null.StringFrom("url1"))
+	sharedToo := postItem("shared too", null.StringFrom("url1"))
+	other := postItem("other", null.StringFrom("url2"))
+	noURL := postItem("no url", null.String{})
+
+	sameURL := &feedops.RssFeedItem{ID: "same url", URLID: "url1"}
+	newURL := &feedops.RssFeedItem{ID: "new url", URLID: "url3"}
+
+	items := mergeRssFeedItems([]*FeedItem{shared, sharedToo, other, noURL}, []*feedops.RssFeedItem{sameURL, newURL})
+
+	require.Len(t, items, 5, "the item with the url of the posts is not shown separately")
+	assert.Equal(t, newURL, items[4].FeedItem)
+
+	assert.Equal(t, sameURL, shared.AlsoInFeed)
+	assert.Equal(t, sameURL, sharedToo.AlsoInFeed)
+	assert.Nil(t, other.AlsoInFeed)
+	assert.Nil(t, noURL.AlsoInFeed)
+}